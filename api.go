@@ -2,9 +2,11 @@ package vk
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 
 	"net/http"
@@ -28,6 +30,51 @@ type API interface {
 	HTTPClient() *http.Client
 }
 
+// ContextAPI is API which can perform requests with context
+//
+// APIs provided by this package conform to ContextAPI,
+// use RequestContext to make requests with any API
+type ContextAPI interface {
+	API
+
+	// RequestContext performs an API request with ctx
+	//
+	// Request is expected to be the same as RequestContext
+	// called with context.Background()
+	RequestContext(ctx context.Context, method string, params interface{}) (json.RawMessage, error)
+}
+
+// RequestContext performs an API request with ctx using api
+//
+// If api doesn't conform to ContextAPI, ctx is only checked
+// before the request is performed, since api has no way of cancelling it
+func RequestContext(ctx context.Context, api API, method string, params interface{}) (json.RawMessage, error) {
+	if v, ok := api.(ContextAPI); ok {
+		return v.RequestContext(ctx, method, params)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return api.Request(method, params)
+}
+
+// WrapContextless adapts API to ContextAPI interface, see RequestContext
+func WrapContextless(api API) ContextAPI {
+	if v, ok := api.(ContextAPI); ok {
+		return v
+	}
+	return contextlessAdapter{api}
+}
+
+type contextlessAdapter struct {
+	API
+}
+
+func (a contextlessAdapter) RequestContext(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	return RequestContext(ctx, a.API, method, params)
+}
+
 // BaseAPI is a helper type used for making requests
 type BaseAPI struct {
 	BaseURL     string `url:"-"`
@@ -35,6 +82,13 @@ type BaseAPI struct {
 	Version     string `url:"v,omitempty"`
 	Language    string `url:"lang,omitempty"`
 
+	// CaptchaSolver is used to solve captcha when VK API asks for it
+	CaptchaSolver CaptchaSolver `url:"-"`
+	// Observer is notified about every HTTP request
+	Observer Observer `url:"-"`
+	// Tracer is used to start span for every HTTP request
+	Tracer Tracer `url:"-"`
+
 	client *http.Client
 }
 
@@ -46,6 +100,12 @@ type BaseAPIConfig struct {
 	Language string
 	// Optional: if nil, http.DefaultClient is used
 	Client *http.Client
+	// Optional: if nil, captcha errors are returned as is
+	CaptchaSolver CaptchaSolver
+	// Optional: if nil, requests are not observed
+	Observer Observer
+	// Optional: if nil, requests are not traced
+	Tracer Tracer
 }
 
 // NewBaseAPI creates and initializes a new BaseAPI instance
@@ -65,6 +125,10 @@ func NewBaseAPI(cfg BaseAPIConfig) (*BaseAPI, error) {
 		BaseURL:     apiBaseURL,
 		Language:    cfg.Language,
 
+		CaptchaSolver: cfg.CaptchaSolver,
+		Observer:      cfg.Observer,
+		Tracer:        cfg.Tracer,
+
 		client: client,
 	}, nil
 }
@@ -73,12 +137,22 @@ func NewBaseAPI(cfg BaseAPIConfig) (*BaseAPI, error) {
 //
 //easyjson:json
 type APIError struct {
-	Code          int    `json:"error_code"`
-	Message       string `json:"error_msg"`
+	Code    int    `json:"error_code"`
+	Message string `json:"error_msg"`
+	// Method is only set for errors returned in execute_errors
+	Method        string `json:"method,omitempty"`
 	RequestParams []struct {
 		Key   string `json:"key"`
 		Value string `json:"value"`
 	} `json:"request_params"`
+
+	// CaptchaSID and CaptchaImg are set for ErrorCodeCaptchaNeeded
+	CaptchaSID string `json:"captcha_sid,omitempty"`
+	CaptchaImg string `json:"captcha_img,omitempty"`
+	// RedirectURI is set for ErrorCodeValidationRequired
+	RedirectURI string `json:"redirect_uri,omitempty"`
+	// ConfirmationText is set for ErrorCodeConfirmationRequired
+	ConfirmationText string `json:"confirmation_text,omitempty"`
 }
 
 // Error implements error interface
@@ -97,12 +171,46 @@ func (e *HTTPError) Error() string {
 	return fmt.Sprintf("vk.HTTPError %d: %s", e.StatusCode, e.Status)
 }
 
+// ExecuteError is returned by execute method when some of API
+// calls made by it have failed
+//
+// BaseAPI returns it along with response, since execute itself didn't fail,
+// only when asked to with context returned by WithExecuteErrors (BatchAPI does so).
+// Otherwise execute_errors are dropped and only response is returned.
+type ExecuteError struct {
+	Response json.RawMessage
+	Errors   []APIError
+}
+
+// Error implements error interface
+func (e *ExecuteError) Error() string {
+	if len(e.Errors) == 1 {
+		return fmt.Sprintf("vk.ExecuteError: %v", &e.Errors[0])
+	}
+	return fmt.Sprintf("vk.ExecuteError: %d errors, first: %v", len(e.Errors), &e.Errors[0])
+}
+
+type executeErrorsKey struct{}
+
+// WithExecuteErrors returns context which makes BaseAPI return
+// *ExecuteError along with response of execute if some
+// of API calls made by it have failed
+func WithExecuteErrors(ctx context.Context) context.Context {
+	return context.WithValue(ctx, executeErrorsKey{}, true)
+}
+
+func wantsExecuteErrors(ctx context.Context) bool {
+	want, _ := ctx.Value(executeErrorsKey{}).(bool)
+	return want
+}
+
 // APIResponse is a type representing general response returned by VK API
 //
 //easyjson:json
 type APIResponse struct {
-	Error    *APIError
-	Response json.RawMessage
+	Error         *APIError
+	Response      json.RawMessage
+	ExecuteErrors []APIError
 }
 
 // HTTPClient conforms to API interface
@@ -112,27 +220,87 @@ func (vk *BaseAPI) HTTPClient() *http.Client {
 
 // Request conforms to API interface
 func (vk *BaseAPI) Request(method string, params interface{}) (json.RawMessage, error) {
+	return vk.RequestContext(context.Background(), method, params)
+}
+
+// RequestContext conforms to ContextAPI interface
+//
+// If CaptchaSolver is set, requests failed with ErrorCodeCaptchaNeeded
+// are repeated with captcha_sid and captcha_key
+func (vk *BaseAPI) RequestContext(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
 	u, err := url.Parse(vk.BaseURL + method)
 	if err != nil {
 		return nil, err
 	}
 
-	q, err := BuildRequestParams(params)
+	reqParams, err := BuildRequestParams(params)
 	if err != nil {
 		return nil, err
 	}
 
+	// reqParams might be owned by caller, so it's copied before modification
+	q := make(url.Values, len(reqParams)+4)
+	MergeURLValues(q, reqParams)
+
 	if baseParams, err := query.Values(vk); err == nil {
-		MergeURLValues(q, baseParams)
+		// params passed by caller take precedence over BaseAPI ones,
+		// i.e. access_token set by AccessTokenMiddleware
+		for k, v := range baseParams {
+			if _, ok := q[k]; !ok {
+				q[k] = v
+			}
+		}
 	} else {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", u.String(), bytes.NewBufferString(q.Encode()))
+	for attempt := 0; ; attempt++ {
+		resp, err := vk.observedDo(ctx, method, u, q)
+
+		var apiErr *APIError
+		if vk.CaptchaSolver == nil || attempt >= maxCaptchaAttempts ||
+			!errors.As(err, &apiErr) || apiErr.Code != ErrorCodeCaptchaNeeded {
+			return resp, err
+		}
+
+		key, err := vk.CaptchaSolver.SolveCaptcha(ctx, Captcha{
+			SID: apiErr.CaptchaSID,
+			Img: apiErr.CaptchaImg,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		q.Set("captcha_sid", apiErr.CaptchaSID)
+		q.Set("captcha_key", key)
+	}
+}
+
+func (vk *BaseAPI) observedDo(ctx context.Context, method string, u *url.URL, q url.Values) (json.RawMessage, error) {
+	if vk.Observer == nil && vk.Tracer == nil {
+		return vk.do(ctx, u, q, nil)
+	}
+
+	ctx, o := StartRequestObservation(ctx, vk.Observer, vk.Tracer, method)
+	resp, err := vk.do(ctx, u, q, o)
+	o.Finish(ctx, err)
+
+	return resp, err
+}
+
+// do performs request, o is filled with request info if not nil
+func (vk *BaseAPI) do(ctx context.Context, u *url.URL, q url.Values, o *RequestObservation) (json.RawMessage, error) {
+	body := q.Encode()
+	if o != nil {
+		o.Info.RequestSize = int64(len(body))
+	}
+
+	req, err := http.NewRequest("POST", u.String(), bytes.NewBufferString(body))
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; param=value")
+	req = req.WithContext(ctx)
 
 	r, err := vk.client.Do(req)
 	if err != nil {
@@ -140,6 +308,12 @@ func (vk *BaseAPI) Request(method string, params interface{}) (json.RawMessage,
 	}
 	defer r.Body.Close()
 
+	var respBody io.Reader = r.Body
+	if o != nil {
+		o.Info.StatusCode = r.StatusCode
+		respBody = o.CountingReader(respBody)
+	}
+
 	if r.StatusCode != http.StatusOK {
 		return nil, &HTTPError{
 			StatusCode: r.StatusCode,
@@ -149,8 +323,7 @@ func (vk *BaseAPI) Request(method string, params interface{}) (json.RawMessage,
 
 	resp := APIResponse{}
 
-	dec := json.NewDecoder(r.Body)
-	if err := dec.Decode(&resp); err != nil {
+	if err := decodeAPIResponse(respBody, &resp); err != nil {
 		return nil, err
 	}
 
@@ -158,5 +331,12 @@ func (vk *BaseAPI) Request(method string, params interface{}) (json.RawMessage,
 		return nil, resp.Error
 	}
 
+	if len(resp.ExecuteErrors) != 0 && wantsExecuteErrors(ctx) {
+		return resp.Response, &ExecuteError{
+			Response: resp.Response,
+			Errors:   resp.ExecuteErrors,
+		}
+	}
+
 	return resp.Response, nil
 }
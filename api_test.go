@@ -0,0 +1,166 @@
+package vk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// funcAPI is API which handles requests with a function
+type funcAPI func(ctx context.Context, method string, params interface{}) (json.RawMessage, error)
+
+func (f funcAPI) Request(method string, params interface{}) (json.RawMessage, error) {
+	return f(context.Background(), method, params)
+}
+
+func (f funcAPI) RequestContext(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	return f(ctx, method, params)
+}
+
+func (f funcAPI) HTTPClient() *http.Client {
+	return http.DefaultClient
+}
+
+// contextlessAPI is API without RequestContext, counting its requests
+type contextlessAPI struct {
+	calls int
+}
+
+func (a *contextlessAPI) Request(method string, params interface{}) (json.RawMessage, error) {
+	a.calls++
+	return json.RawMessage("1"), nil
+}
+
+func (a *contextlessAPI) HTTPClient() *http.Client {
+	return http.DefaultClient
+}
+
+func newTestBaseAPI(t *testing.T, handler http.HandlerFunc) *BaseAPI {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	api, err := NewBaseAPI(BaseAPIConfig{AccessToken: "test-token"})
+	if err != nil {
+		t.Fatalf("Cant create BaseAPI: %v", err)
+	}
+	api.BaseURL = srv.URL + "/method/"
+
+	return api
+}
+
+func TestBaseAPIRequestError(t *testing.T) {
+	api := newTestBaseAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"error":{"error_code":5,"error_msg":"User authorization failed"}}`))
+	})
+
+	_, err := api.Request("users.get", nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %T: %v", err, err)
+	}
+
+	if apiErr.Code != 5 {
+		t.Errorf("Expected error code 5, got %v", apiErr.Code)
+	}
+}
+
+func TestBaseAPIExecuteErrors(t *testing.T) {
+	api := newTestBaseAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":[false],"execute_errors":[{"method":"users.get","error_code":113,"error_msg":"Invalid user id"}]}`))
+	})
+
+	// partial results are returned as is by default
+	resp, err := api.Request("execute", nil)
+	if err != nil || string(resp) != `[false]` {
+		t.Errorf("Expected response without error, got %s, %v", resp, err)
+	}
+
+	resp, err = api.RequestContext(WithExecuteErrors(context.Background()), "execute", nil)
+
+	var execErr *ExecuteError
+	if !errors.As(err, &execErr) {
+		t.Fatalf("Expected *ExecuteError, got %T: %v", err, err)
+	}
+
+	if string(resp) != `[false]` || string(execErr.Response) != `[false]` {
+		t.Errorf("Expected response to be returned along with error, got %s", resp)
+	}
+
+	if len(execErr.Errors) != 1 || execErr.Errors[0].Method != "users.get" || execErr.Errors[0].Code != 113 {
+		t.Errorf("Unexpected execute errors: %+v", execErr.Errors)
+	}
+}
+
+func TestRequestContext(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "value")
+
+	var got interface{}
+	api := funcAPI(func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+		got = ctx.Value(key{})
+		return json.RawMessage("1"), nil
+	})
+
+	if _, err := RequestContext(ctx, api, "users.get", nil); err != nil || got != "value" {
+		t.Errorf("Expected ctx to be passed to ContextAPI, got %v, %v", got, err)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	contextless := &contextlessAPI{}
+	if resp, err := RequestContext(ctx, contextless, "users.get", nil); err != nil || string(resp) != "1" {
+		t.Errorf("Unexpected result: %s, %v", resp, err)
+	}
+	if _, err := RequestContext(cancelled, contextless, "users.get", nil); err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if contextless.calls != 1 {
+		t.Errorf("Expected 1 request, got %v", contextless.calls)
+	}
+}
+
+func TestWrapContextless(t *testing.T) {
+	contextless := &contextlessAPI{}
+	api := WrapContextless(contextless)
+
+	if resp, err := api.RequestContext(context.Background(), "users.get", nil); err != nil || string(resp) != "1" {
+		t.Errorf("Unexpected result: %s, %v", resp, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := api.RequestContext(ctx, "users.get", nil); err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if contextless.calls != 1 {
+		t.Errorf("Expected 1 request, got %v", contextless.calls)
+	}
+
+	base := &BaseAPI{}
+	if WrapContextless(base) != ContextAPI(base) {
+		t.Errorf("ContextAPI shouldn't be wrapped")
+	}
+}
+
+func TestBaseAPIRequestContext(t *testing.T) {
+	release := make(chan struct{})
+	api := newTestBaseAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte(`{"response":1}`))
+	})
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := api.RequestContext(ctx, "users.get", nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
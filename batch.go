@@ -0,0 +1,313 @@
+package vk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// MaxExecuteCalls is maximal number of API calls in one execute
+	MaxExecuteCalls = 25
+
+	defaultBatchWindow = 20 * time.Millisecond
+)
+
+// BatchAPIConfig represents configuration used for BatchAPI creation
+type BatchAPIConfig struct {
+	// Optional: time to wait for more requests before sending a batch,
+	// if 0, 20ms is used
+	Window time.Duration
+	// Optional: maximal number of requests in one batch,
+	// if 0 or more than MaxExecuteCalls, MaxExecuteCalls is used
+	MaxCalls int
+}
+
+// BatchAPI is an API which merges concurrent requests made within
+// short window into one call of execute method
+//
+// Every caller receives its own result, or *APIError if its call
+// has failed inside of execute. Failed calls are matched to errors
+// by method and order, if several calls of the same method return false
+// and VK reports fewer errors for it, they can't be told apart,
+// so each of them receives *ExecuteError with all errors of that method.
+// Requests to execute itself and stored procedures (execute.*)
+// are passed to underlying API as is.
+type BatchAPI struct {
+	api      API
+	window   time.Duration
+	maxCalls int
+
+	mu      sync.Mutex
+	pending []*batchCall
+	timer   *time.Timer
+}
+
+type batchResult struct {
+	resp json.RawMessage
+	err  error
+}
+
+type batchCall struct {
+	ctx    context.Context
+	method string
+	params url.Values
+	done   chan batchResult
+}
+
+// NewBatchAPI creates a new BatchAPI which performs requests using api
+func NewBatchAPI(api API, cfg BatchAPIConfig) *BatchAPI {
+	window := cfg.Window
+	if window <= 0 {
+		window = defaultBatchWindow
+	}
+
+	maxCalls := cfg.MaxCalls
+	if maxCalls <= 0 || maxCalls > MaxExecuteCalls {
+		maxCalls = MaxExecuteCalls
+	}
+
+	return &BatchAPI{
+		api:      api,
+		window:   window,
+		maxCalls: maxCalls,
+	}
+}
+
+// HTTPClient conforms to API interface
+func (b *BatchAPI) HTTPClient() *http.Client {
+	return b.api.HTTPClient()
+}
+
+// Request conforms to API interface
+func (b *BatchAPI) Request(method string, params interface{}) (json.RawMessage, error) {
+	return b.RequestContext(context.Background(), method, params)
+}
+
+var batchableMethodRegex = regexp.MustCompile(`^[a-zA-Z]+\.[a-zA-Z]+$`)
+
+// isBatchable checks if method can be called inside of execute,
+// which isn't the case for execute itself and stored procedures
+func isBatchable(method string) bool {
+	if !batchableMethodRegex.MatchString(method) {
+		return false
+	}
+
+	namespace := method[:strings.IndexByte(method, '.')]
+	return !strings.EqualFold(namespace, "execute")
+}
+
+// RequestContext conforms to ContextAPI interface
+//
+// If ctx is Done before batch is sent, request is removed from it
+func (b *BatchAPI) RequestContext(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	if !isBatchable(method) {
+		return RequestContext(ctx, b.api, method, params)
+	}
+
+	q, err := BuildRequestParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	call := &batchCall{
+		ctx:    ctx,
+		method: method,
+		params: q,
+		done:   make(chan batchResult, 1),
+	}
+
+	b.enqueue(call)
+
+	select {
+	case <-ctx.Done():
+		b.dequeue(call)
+		return nil, ctx.Err()
+	case res := <-call.done:
+		return res.resp, res.err
+	}
+}
+
+func (b *BatchAPI) enqueue(call *batchCall) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pending = append(b.pending, call)
+
+	if len(b.pending) >= b.maxCalls {
+		b.flushLocked()
+	} else if b.timer == nil {
+		b.timer = time.AfterFunc(b.window, b.flush)
+	}
+}
+
+func (b *BatchAPI) dequeue(call *batchCall) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i, c := range b.pending {
+		if c == call {
+			b.pending = append(b.pending[:i], b.pending[i+1:]...)
+			return
+		}
+	}
+}
+
+func (b *BatchAPI) flush() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.flushLocked()
+}
+
+func (b *BatchAPI) flushLocked() {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	if len(b.pending) == 0 {
+		return
+	}
+
+	calls := b.pending
+	b.pending = nil
+
+	go b.execute(calls)
+}
+
+func (b *BatchAPI) execute(calls []*batchCall) {
+	if len(calls) == 1 {
+		resp, err := RequestContext(calls[0].ctx, b.api, calls[0].method, calls[0].params)
+		calls[0].done <- batchResult{resp, err}
+		return
+	}
+
+	code, err := buildExecuteCode(calls)
+	if err != nil {
+		failBatch(calls, err)
+		return
+	}
+
+	// Request is not bound to any of callers contexts,
+	// since it has to be finished for all of them
+	ctx := WithExecuteErrors(context.Background())
+
+	resp, err := RequestContext(ctx, b.api, "execute", url.Values{"code": {code}})
+
+	var execErrors []APIError
+	var execErr *ExecuteError
+	if errors.As(err, &execErr) {
+		resp = execErr.Response
+		execErrors = execErr.Errors
+	} else if err != nil {
+		failBatch(calls, err)
+		return
+	}
+
+	var results []json.RawMessage
+	if err := json.Unmarshal(resp, &results); err != nil {
+		failBatch(calls, err)
+		return
+	}
+
+	if len(results) != len(calls) {
+		failBatch(calls, fmt.Errorf("vk.BatchAPI: execute returned %d results for %d calls", len(results), len(calls)))
+		return
+	}
+
+	errs := matchExecuteErrors(calls, results, execErrors)
+	for i, call := range calls {
+		if errs[i] != nil {
+			call.done <- batchResult{nil, errs[i]}
+		} else {
+			call.done <- batchResult{results[i], nil}
+		}
+	}
+}
+
+// matchExecuteErrors returns errors of failed calls
+//
+// Failed calls are returned as false, and their errors are listed
+// in execute_errors in order calls were made, so i-th failed call
+// of method gets i-th error of it, unless their counts differ
+func matchExecuteErrors(calls []*batchCall, results []json.RawMessage, execErrors []APIError) []error {
+	errs := make([]error, len(calls))
+	if len(execErrors) == 0 {
+		return errs
+	}
+
+	failed := make(map[string][]int)
+	for i, call := range calls {
+		if bytes.Equal(bytes.TrimSpace(results[i]), []byte("false")) {
+			method := strings.ToLower(call.method)
+			failed[method] = append(failed[method], i)
+		}
+	}
+
+	byMethod := make(map[string][]APIError)
+	for _, apiErr := range execErrors {
+		method := strings.ToLower(apiErr.Method)
+		byMethod[method] = append(byMethod[method], apiErr)
+	}
+
+	for method, indices := range failed {
+		methodErrs := byMethod[method]
+		if len(methodErrs) == 0 {
+			// false is actual result
+			continue
+		}
+
+		for j, i := range indices {
+			if len(methodErrs) == len(indices) {
+				apiErr := methodErrs[j]
+				errs[i] = &apiErr
+			} else {
+				errs[i] = &ExecuteError{Response: results[i], Errors: methodErrs}
+			}
+		}
+	}
+
+	return errs
+}
+
+func failBatch(calls []*batchCall, err error) {
+	for _, call := range calls {
+		call.done <- batchResult{nil, err}
+	}
+}
+
+// buildExecuteCode builds VKScript code returning an array
+// of results of calls
+func buildExecuteCode(calls []*batchCall) (string, error) {
+	var code strings.Builder
+
+	code.WriteString("return [")
+	for i, call := range calls {
+		args := make(map[string]string, len(call.params))
+		for k, v := range call.params {
+			args[k] = strings.Join(v, ",")
+		}
+
+		encoded, err := json.Marshal(args)
+		if err != nil {
+			return "", err
+		}
+
+		if i != 0 {
+			code.WriteByte(',')
+		}
+		fmt.Fprintf(&code, "API.%s(%s)", call.method, encoded)
+	}
+	code.WriteString("];")
+
+	return code.String(), nil
+}
@@ -0,0 +1,214 @@
+package vk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBuildExecuteCode(t *testing.T) {
+	calls := []*batchCall{
+		{method: "users.get", params: url.Values{"user_ids": {"1", "2"}}},
+		{method: "groups.isMember", params: url.Values{"group_id": {"1"}, "user_id": {"\"quoted\""}}},
+	}
+
+	code, err := buildExecuteCode(calls)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := `return [API.users.get({"user_ids":"1,2"}),API.groups.isMember({"group_id":"1","user_id":"\"quoted\""})];`
+	if code != expected {
+		t.Errorf("Expected `%v`, got `%v`", expected, code)
+	}
+}
+
+func TestIsBatchable(t *testing.T) {
+	tests := []struct {
+		method   string
+		expected bool
+	}{
+		{"users.get", true},
+		{"groups.isMember", true},
+		{"execute", false},
+		{"execute.myProcedure", false},
+		{"Execute.myProcedure", false},
+		{"users.get.more", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := isBatchable(tt.method); got != tt.expected {
+			t.Errorf("%q: expected %v, got %v", tt.method, tt.expected, got)
+		}
+	}
+}
+
+func TestBatchAPIStoredProcedure(t *testing.T) {
+	var methods []string
+	api := NewBatchAPI(funcAPI(func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+		methods = append(methods, method)
+		return json.RawMessage("1"), nil
+	}), BatchAPIConfig{Window: 10 * time.Millisecond})
+
+	if _, err := api.Request("execute.myProcedure", url.Values{"a": {"1"}}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if len(methods) != 1 || methods[0] != "execute.myProcedure" {
+		t.Errorf("Expected stored procedure to be passed as is, got %v", methods)
+	}
+}
+
+func TestBatchAPIMergesRequests(t *testing.T) {
+	var executes int32
+
+	api := NewBatchAPI(funcAPI(func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+		if method != "execute" {
+			t.Errorf("Expected only execute calls, got %v", method)
+		}
+		if !wantsExecuteErrors(ctx) {
+			t.Errorf("Expected execute to be requested with WithExecuteErrors")
+		}
+		atomic.AddInt32(&executes, 1)
+
+		code := params.(url.Values).Get("code")
+		n := strings.Count(code, "API.")
+
+		results := make([]string, n)
+		for i := range results {
+			results[i] = "1"
+		}
+		// fail last call
+		results[n-1] = "false"
+
+		return json.RawMessage("[" + strings.Join(results, ",") + "]"), &ExecuteError{
+			Response: json.RawMessage("[" + strings.Join(results, ",") + "]"),
+			Errors:   []APIError{{Code: 15, Message: "Access denied", Method: "groups.isMember"}},
+		}
+	}), BatchAPIConfig{Window: 50 * time.Millisecond, MaxCalls: 3})
+
+	var wg sync.WaitGroup
+	errs := make([]error, 3)
+	resps := make([]json.RawMessage, 3)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			method := "users.get"
+			if i == 2 {
+				// make sure it's the last one
+				time.Sleep(10 * time.Millisecond)
+				method = "groups.isMember"
+			}
+			resps[i], errs[i] = api.Request(method, nil)
+		}(i)
+	}
+	wg.Wait()
+
+	if executes != 1 {
+		t.Errorf("Expected 1 execute call, got %v", executes)
+	}
+
+	for i := 0; i < 2; i++ {
+		if errs[i] != nil || string(resps[i]) != "1" {
+			t.Errorf("Unexpected result for call %d: %s, %v", i, resps[i], errs[i])
+		}
+	}
+
+	var apiErr *APIError
+	if !errors.As(errs[2], &apiErr) || apiErr.Code != 15 {
+		t.Errorf("Expected APIError 15 for last call, got %v", errs[2])
+	}
+}
+
+func TestBatchAPIContextCancel(t *testing.T) {
+	api := NewBatchAPI(funcAPI(func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+		t.Errorf("Cancelled request should not be performed")
+		return nil, nil
+	}), BatchAPIConfig{Window: 50 * time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := api.RequestContext(ctx, "users.get", nil); err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+}
+
+func TestMatchExecuteErrors(t *testing.T) {
+	calls := []*batchCall{
+		{method: "groups.isMember"},
+		{method: "users.get"},
+		{method: "groups.isMember"},
+		{method: "wall.get"},
+		{method: "wall.get"},
+		{method: "utils.resolveScreenName"},
+	}
+	results := []json.RawMessage{
+		json.RawMessage("false"),
+		json.RawMessage("[]"),
+		json.RawMessage("false"),
+		json.RawMessage("false"),
+		json.RawMessage("false"),
+		json.RawMessage("false"),
+	}
+	execErrors := []APIError{
+		{Code: 15, Method: "groups.isMember"},
+		{Code: 203, Method: "groups.isMember"},
+		{Code: 18, Method: "wall.get"},
+	}
+
+	errs := matchExecuteErrors(calls, results, execErrors)
+
+	var apiErr *APIError
+	if !errors.As(errs[0], &apiErr) || apiErr.Code != 15 {
+		t.Errorf("Expected first groups.isMember to get error 15, got %v", errs[0])
+	}
+	if errs[1] != nil {
+		t.Errorf("Expected users.get to succeed, got %v", errs[1])
+	}
+	if !errors.As(errs[2], &apiErr) || apiErr.Code != 203 {
+		t.Errorf("Expected second groups.isMember to get error 203, got %v", errs[2])
+	}
+
+	// one error for two failed calls can't be matched
+	for _, err := range errs[3:5] {
+		var execErr *ExecuteError
+		if !errors.As(err, &execErr) || len(execErr.Errors) != 1 || execErr.Errors[0].Code != 18 {
+			t.Errorf("Expected ExecuteError for wall.get, got %v", err)
+		}
+	}
+
+	// false without errors is a valid result
+	if errs[5] != nil {
+		t.Errorf("Expected utils.resolveScreenName to succeed, got %v", errs[5])
+	}
+}
+
+func TestBatchAPISingleCallContext(t *testing.T) {
+	type key struct{}
+
+	api := NewBatchAPI(funcAPI(func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+		if method != "users.get" {
+			t.Errorf("Expected users.get, got %v", method)
+		}
+		if ctx.Value(key{}) != "value" {
+			t.Errorf("Expected caller context to be passed")
+		}
+		return json.RawMessage("1"), nil
+	}), BatchAPIConfig{Window: 10 * time.Millisecond})
+
+	ctx := context.WithValue(context.Background(), key{}, "value")
+	if resp, err := api.RequestContext(ctx, "users.get", nil); err != nil || string(resp) != "1" {
+		t.Errorf("Unexpected result: %s, %v", resp, err)
+	}
+}
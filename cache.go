@@ -0,0 +1,327 @@
+package vk
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCacheMethods are read-only methods cached by default
+var DefaultCacheMethods = []string{
+	"users.get",
+	"groups.getById",
+	"utils.resolveScreenName",
+	"database.*",
+}
+
+const (
+	defaultCacheTTL        = time.Minute
+	defaultCacheMaxEntries = 1000
+)
+
+// CacheConfig represents configuration used for Cache creation
+type CacheConfig struct {
+	// Optional: methods which should be cached, "namespace.*" matches
+	// all methods in namespace; if nil, DefaultCacheMethods are used
+	//
+	// Only read-only methods should be cached
+	Methods []string
+	// Optional: for how long responses are cached, if 0, 1 minute is used
+	TTL time.Duration
+	// Optional: TTL for specific methods, overrides TTL
+	MethodTTL map[string]time.Duration
+	// Optional: maximal number of cached responses, if 0, 1000 is used
+	//
+	// Least recently used responses are evicted first
+	MaxEntries int
+}
+
+// Cache caches successful responses of read-only methods
+//
+// Cache key is method name and request params, so it should be used
+// with one token only, or placed before AccessTokenMiddleware in Chain.
+// Concurrent identical requests are collapsed into one.
+type Cache struct {
+	methods    []string
+	ttl        time.Duration
+	methodTTL  map[string]time.Duration
+	maxEntries int
+
+	mu       sync.Mutex
+	lru      *list.List
+	entries  map[string]*list.Element
+	inflight map[string]*cacheFlight
+
+	// now is used instead of time.Now in tests
+	now func() time.Time
+}
+
+type cacheEntry struct {
+	key     string
+	method  string
+	resp    json.RawMessage
+	expires time.Time
+}
+
+type cacheFlight struct {
+	method string
+	done   chan struct{}
+	resp   json.RawMessage
+	err    error
+	// invalidated is set if cache was invalidated while request
+	// was in flight, so its response might be stale and isn't cached
+	invalidated bool
+}
+
+// NewCache creates a new empty Cache
+func NewCache(cfg CacheConfig) *Cache {
+	methods := cfg.Methods
+	if methods == nil {
+		methods = DefaultCacheMethods
+	}
+
+	ttl := cfg.TTL
+	if ttl <= 0 {
+		ttl = defaultCacheTTL
+	}
+
+	maxEntries := cfg.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = defaultCacheMaxEntries
+	}
+
+	methodTTL := make(map[string]time.Duration, len(cfg.MethodTTL))
+	for method, ttl := range cfg.MethodTTL {
+		methodTTL[strings.ToLower(method)] = ttl
+	}
+
+	normalized := make([]string, len(methods))
+	for i, method := range methods {
+		normalized[i] = strings.ToLower(method)
+	}
+
+	return &Cache{
+		methods:    normalized,
+		ttl:        ttl,
+		methodTTL:  methodTTL,
+		maxEntries: maxEntries,
+
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+		inflight: make(map[string]*cacheFlight),
+
+		now: time.Now,
+	}
+}
+
+// Middleware returns Middleware which serves cached responses
+func (c *Cache) Middleware() Middleware {
+	return Interceptor(c.intercept)
+}
+
+func (c *Cache) cacheable(method string) bool {
+	for _, m := range c.methods {
+		if m == method {
+			return true
+		}
+		if strings.HasSuffix(m, ".*") && strings.HasPrefix(method, m[:len(m)-1]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Cache) methodTTLFor(method string) time.Duration {
+	if ttl, ok := c.methodTTL[method]; ok {
+		return ttl
+	}
+	return c.ttl
+}
+
+func cacheKey(method string, params interface{}) (string, error) {
+	q, err := BuildRequestParams(params)
+	if err != nil {
+		return "", err
+	}
+	// Encode sorts params by key
+	return strings.ToLower(method) + "?" + q.Encode(), nil
+}
+
+func (c *Cache) intercept(ctx context.Context, call *Call, next CallHandler) {
+	method := strings.ToLower(call.Method)
+	if !c.cacheable(method) {
+		next(ctx, call)
+		return
+	}
+
+	key, _ := cacheKey(method, call.Params)
+
+	for {
+		c.mu.Lock()
+
+		if resp, ok := c.getLocked(key); ok {
+			c.mu.Unlock()
+			call.Response = resp
+			return
+		}
+
+		if flight, ok := c.inflight[key]; ok {
+			c.mu.Unlock()
+
+			select {
+			case <-ctx.Done():
+				call.Err = ctx.Err()
+				return
+			case <-flight.done:
+			}
+
+			// request might've been cancelled by its own ctx,
+			// in which case it's repeated with this one
+			if errors.Is(flight.err, context.Canceled) || errors.Is(flight.err, context.DeadlineExceeded) {
+				continue
+			}
+
+			call.Response, call.Err = flight.resp, flight.err
+			return
+		}
+
+		flight := &cacheFlight{method: method, done: make(chan struct{})}
+		c.inflight[key] = flight
+		c.mu.Unlock()
+
+		next(ctx, call)
+		flight.resp, flight.err = call.Response, call.Err
+
+		c.mu.Lock()
+		if !flight.invalidated {
+			delete(c.inflight, key)
+			if call.Err == nil {
+				c.putLocked(key, method, call.Response)
+			}
+		}
+		c.mu.Unlock()
+
+		close(flight.done)
+		return
+	}
+}
+
+func (c *Cache) getLocked(key string) (json.RawMessage, bool) {
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*cacheEntry)
+	if !c.now().Before(entry.expires) {
+		c.removeLocked(elem)
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+	return entry.resp, true
+}
+
+func (c *Cache) putLocked(key, method string, resp json.RawMessage) {
+	entry := &cacheEntry{
+		key:     key,
+		method:  method,
+		resp:    resp,
+		expires: c.now().Add(c.methodTTLFor(method)),
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(entry)
+
+	for c.lru.Len() > c.maxEntries {
+		c.removeLocked(c.lru.Back())
+	}
+}
+
+func (c *Cache) removeLocked(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*cacheEntry).key)
+}
+
+// invalidateFlightLocked prevents response of request in flight
+// from being cached, and following requests from waiting for it
+func (c *Cache) invalidateFlightLocked(key string, flight *cacheFlight) {
+	flight.invalidated = true
+	delete(c.inflight, key)
+}
+
+// Invalidate removes cached response for method called with params
+//
+// Response of identical request which is in flight isn't cached either.
+//
+// params can be anything accepted by BuildRequestParams
+func (c *Cache) Invalidate(method string, params interface{}) error {
+	key, err := cacheKey(method, params)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.removeLocked(elem)
+	}
+	if flight, ok := c.inflight[key]; ok {
+		c.invalidateFlightLocked(key, flight)
+	}
+
+	return nil
+}
+
+// InvalidateMethod removes all cached responses for method
+func (c *Cache) InvalidateMethod(method string) {
+	method = strings.ToLower(method)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		if elem.Value.(*cacheEntry).method == method {
+			c.removeLocked(elem)
+		}
+		elem = next
+	}
+
+	for key, flight := range c.inflight {
+		if flight.method == method {
+			c.invalidateFlightLocked(key, flight)
+		}
+	}
+}
+
+// Purge removes all cached responses
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lru.Init()
+	c.entries = make(map[string]*list.Element)
+
+	for key, flight := range c.inflight {
+		c.invalidateFlightLocked(key, flight)
+	}
+}
+
+// Len returns number of cached responses
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
@@ -0,0 +1,149 @@
+package vk
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestCacheAPI(cfg CacheConfig, calls *int32, delay time.Duration) (API, *Cache) {
+	cache := NewCache(cfg)
+	api := Chain(funcAPI(func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+		atomic.AddInt32(calls, 1)
+		time.Sleep(delay)
+		return json.RawMessage(`[{"id":1}]`), nil
+	}), cache.Middleware())
+
+	return api, cache
+}
+
+func TestCacheHitsAndInvalidation(t *testing.T) {
+	var calls int32
+	api, cache := newTestCacheAPI(CacheConfig{}, &calls, 0)
+
+	params := url.Values{"user_ids": {"1"}}
+	for i := 0; i < 3; i++ {
+		api.Request("users.get", params)
+	}
+	api.Request("messages.send", params)
+	api.Request("messages.send", params)
+	api.Request("database.getCountries", nil)
+	api.Request("database.getCountries", nil)
+
+	if calls != 4 {
+		t.Errorf("Expected 4 calls (users.get, 2x messages.send, database.getCountries), got %v", calls)
+	}
+
+	cache.Invalidate("users.get", url.Values{"user_ids": {"1"}})
+	api.Request("users.get", params)
+	if calls != 5 {
+		t.Errorf("Expected request after Invalidate to be performed, got %v calls", calls)
+	}
+
+	cache.InvalidateMethod("database.getCountries")
+	if cache.Len() != 1 {
+		t.Errorf("Expected 1 cached response after InvalidateMethod, got %v", cache.Len())
+	}
+}
+
+func TestCacheTTLAndEviction(t *testing.T) {
+	var calls int32
+	api, cache := newTestCacheAPI(CacheConfig{
+		MaxEntries: 2,
+		MethodTTL:  map[string]time.Duration{"groups.getById": time.Hour},
+	}, &calls, 0)
+
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	api.Request("users.get", url.Values{"user_ids": {"1"}})
+	api.Request("groups.getById", url.Values{"group_id": {"1"}})
+
+	now = now.Add(2 * time.Minute)
+
+	api.Request("groups.getById", url.Values{"group_id": {"1"}})
+	if calls != 2 {
+		t.Errorf("Expected groups.getById to be cached for an hour, got %v calls", calls)
+	}
+
+	api.Request("users.get", url.Values{"user_ids": {"1"}})
+	if calls != 3 {
+		t.Errorf("Expected users.get to expire after a minute, got %v calls", calls)
+	}
+
+	api.Request("users.get", url.Values{"user_ids": {"2"}})
+	if cache.Len() != 2 {
+		t.Errorf("Expected cache to be bounded by 2 entries, got %v", cache.Len())
+	}
+}
+
+func TestCacheCollapsesRequests(t *testing.T) {
+	var calls int32
+	api, _ := newTestCacheAPI(CacheConfig{}, &calls, 20*time.Millisecond)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := api.Request("users.get", url.Values{"user_ids": {"1"}}); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("Expected concurrent requests to be collapsed, got %v calls", calls)
+	}
+}
+
+func TestCacheInvalidateInFlight(t *testing.T) {
+	params := url.Values{"user_ids": {"1"}}
+
+	tests := map[string]func(c *Cache){
+		"Invalidate":       func(c *Cache) { c.Invalidate("users.get", params) },
+		"InvalidateMethod": func(c *Cache) { c.InvalidateMethod("Users.get") },
+		"Purge":            func(c *Cache) { c.Purge() },
+	}
+
+	for name, invalidate := range tests {
+		var calls int32
+		started, release := make(chan struct{}), make(chan struct{})
+
+		cache := NewCache(CacheConfig{})
+		api := Chain(funcAPI(func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				close(started)
+				<-release
+				return json.RawMessage(`[{"id":1,"first_name":"Stale"}]`), nil
+			}
+			return json.RawMessage(`[{"id":1}]`), nil
+		}), cache.Middleware())
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			api.Request("users.get", params)
+		}()
+
+		<-started
+		invalidate(cache)
+
+		// request made after invalidation doesn't wait for stale one
+		if resp, _ := api.Request("users.get", params); string(resp) != `[{"id":1}]` {
+			t.Errorf("%v: expected fresh response, got %s", name, resp)
+		}
+
+		close(release)
+		<-done
+
+		if resp, _ := api.Request("users.get", params); string(resp) != `[{"id":1}]` || calls != 2 {
+			t.Errorf("%v: expected stale response not to be cached, got %s after %v calls", name, resp, calls)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package vk
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// maxCaptchaAttempts is maximal number of captchas solved for one request
+const maxCaptchaAttempts = 3
+
+// Captcha is a captcha challenge sent by VK API with ErrorCodeCaptchaNeeded
+type Captcha struct {
+	// SID is captcha_sid
+	SID string
+	// Img is URL of captcha image
+	Img string
+}
+
+// CaptchaSolver solves captcha challenges
+type CaptchaSolver interface {
+	// SolveCaptcha returns text from captcha image
+	//
+	// When ctx is Done, SolveCaptcha should return ctx.Err()
+	SolveCaptcha(ctx context.Context, c Captcha) (string, error)
+}
+
+// CaptchaSolverFunc is an adapter to allow the use of ordinary functions
+// as CaptchaSolver
+type CaptchaSolverFunc func(ctx context.Context, c Captcha) (string, error)
+
+// SolveCaptcha conforms to CaptchaSolver interface
+func (f CaptchaSolverFunc) SolveCaptcha(ctx context.Context, c Captcha) (string, error) {
+	return f(ctx, c)
+}
+
+// TerminalCaptchaSolver asks user to solve captcha by writing
+// its URL to Out and reading answer from In
+//
+// Challenges are asked one by one
+type TerminalCaptchaSolver struct {
+	In  io.Reader
+	Out io.Writer
+
+	mu     sync.Mutex
+	reader *bufio.Reader
+}
+
+// SolveCaptcha conforms to CaptchaSolver interface
+//
+// ctx is not checked while waiting for answer, since reading from In
+// can't be interrupted
+func (s *TerminalCaptchaSolver) SolveCaptcha(ctx context.Context, c Captcha) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	if s.reader == nil {
+		s.reader = bufio.NewReader(s.In)
+	}
+
+	if _, err := fmt.Fprintf(s.Out, "VK asks to solve captcha: %v\nEnter captcha: ", c.Img); err != nil {
+		return "", err
+	}
+
+	line, err := s.reader.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+
+	return strings.TrimSpace(line), nil
+}
+
+// ErrCaptchaRejected is returned to requester when CaptchaChallenge is rejected
+var ErrCaptchaRejected = errors.New("vk: captcha was rejected")
+
+// CaptchaChallenge is a captcha waiting to be solved in CaptchaQueue
+type CaptchaChallenge struct {
+	Captcha
+
+	answer chan string
+}
+
+// Solve passes answer to request waiting for it
+//
+// Empty answer is treated as rejection.
+// Only first call to Solve or Reject has any effect
+func (c *CaptchaChallenge) Solve(answer string) {
+	select {
+	case c.answer <- answer:
+	default:
+	}
+}
+
+// Reject makes request waiting for answer fail with ErrCaptchaRejected
+func (c *CaptchaChallenge) Reject() {
+	select {
+	case c.answer <- "":
+	default:
+	}
+}
+
+// CaptchaQueue is a CaptchaSolver which routes challenges to
+// whoever reads from Challenges, i.e. human operators
+//
+// Usage:
+//
+//	queue := vk.NewCaptchaQueue(0)
+//	go func() {
+//		for c := range queue.Challenges() {
+//			c.Solve(askOperator(c.Img))
+//		}
+//	}()
+//	api, _ := vk.NewBaseAPI(vk.BaseAPIConfig{AccessToken: token, CaptchaSolver: queue})
+type CaptchaQueue struct {
+	challenges chan *CaptchaChallenge
+}
+
+// NewCaptchaQueue creates a new CaptchaQueue with buffer of size Cap
+func NewCaptchaQueue(Cap int) *CaptchaQueue {
+	return &CaptchaQueue{
+		challenges: make(chan *CaptchaChallenge, Cap),
+	}
+}
+
+// Challenges returns channel of challenges to be solved
+//
+// Every challenge should be either solved or rejected
+func (q *CaptchaQueue) Challenges() <-chan *CaptchaChallenge {
+	return q.challenges
+}
+
+// SolveCaptcha conforms to CaptchaSolver interface
+func (q *CaptchaQueue) SolveCaptcha(ctx context.Context, c Captcha) (string, error) {
+	challenge := &CaptchaChallenge{
+		Captcha: c,
+		answer:  make(chan string, 1),
+	}
+
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case q.challenges <- challenge:
+	}
+
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case answer := <-challenge.answer:
+		if answer == "" {
+			return "", ErrCaptchaRejected
+		}
+		return answer, nil
+	}
+}
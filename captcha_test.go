@@ -0,0 +1,62 @@
+package vk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBaseAPICaptchaSolver(t *testing.T) {
+	api := newTestBaseAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("captcha_sid") == "42" && r.FormValue("captcha_key") == "answer" {
+			w.Write([]byte(`{"response":1}`))
+			return
+		}
+		w.Write([]byte(`{"error":{"error_code":14,"error_msg":"Captcha needed","captcha_sid":"42","captcha_img":"https://example.com/captcha.png"}}`))
+	})
+
+	var out bytes.Buffer
+	api.CaptchaSolver = &TerminalCaptchaSolver{
+		In:  strings.NewReader("answer\n"),
+		Out: &out,
+	}
+
+	resp, err := api.Request("wall.post", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if string(resp) != "1" {
+		t.Errorf("Expected response 1, got %s", resp)
+	}
+
+	if !strings.Contains(out.String(), "https://example.com/captcha.png") {
+		t.Errorf("Expected captcha URL to be printed, got %q", out.String())
+	}
+}
+
+func TestCaptchaQueue(t *testing.T) {
+	queue := NewCaptchaQueue(0)
+
+	go func() {
+		c := <-queue.Challenges()
+		c.Solve(c.SID + "-solved")
+	}()
+
+	answer, err := queue.SolveCaptcha(context.Background(), Captcha{SID: "1"})
+	if err != nil || answer != "1-solved" {
+		t.Errorf("Unexpected result: %q, %v", answer, err)
+	}
+
+	go func() {
+		c := <-queue.Challenges()
+		c.Reject()
+	}()
+
+	if _, err := queue.SolveCaptcha(context.Background(), Captcha{SID: "2"}); !errors.Is(err, ErrCaptchaRejected) {
+		t.Errorf("Expected rejection, got %v", err)
+	}
+}
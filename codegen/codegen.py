@@ -67,6 +67,10 @@ NATIVE_TYPES_ZERO_VALS = {
 	"""),
 }
 
+# easyjson only generates code for structs, slices and maps,
+# other types are decoded with their own UnmarshalJSON
+NON_EASYJSON_TYPES = set(NATIVE_TYPES_ZERO_VALS) | {'vk.BoolInt'}
+
 BOOL_INTS = [
 	'base_ok_response',
 	'base_property_exists',
@@ -284,6 +288,44 @@ FORCE_CODE_GENERATE = {
 	'message_chat_preview',
 }
 
+# Params which are missing in schema
+EXTRA_PARAMS = {
+	'messages.send': [
+		{'name': 'template', 'type': 'string'},
+	],
+	'messages.edit': [
+		{'name': 'keyboard', 'type': 'string'},
+	],
+}
+
+# Methods which are missing in schema
+EXTRA_METHODS = [
+	{
+		'name': 'messages.sendMessageEventAnswer',
+		'description': 'Answers message_event sent when callback button is pressed.',
+		'parameters': [
+			{'name': 'event_id', 'type': 'string', 'required': True, 'description': 'Event ID from message_event.'},
+			{'name': 'user_id', 'type': 'integer', 'required': True, 'description': 'ID of user who has pressed the button.'},
+			{'name': 'peer_id', 'type': 'integer', 'required': True, 'description': 'ID of conversation the button was pressed in.'},
+			{'name': 'event_data', 'type': 'string', 'description': 'Action to perform, if nil, button just stops loading.'},
+		],
+		'responses': {
+			'response': {'$ref': 'responses.json#/definitions/base_ok_response'},
+		},
+	},
+]
+
+for method in EXTRA_METHODS:
+	methods.setdefault(method['name'].split('.')[0], []).append(method)
+
+# Go types of params, which are encoded by types implementing query.Encoder
+PARAM_TYPE_OVERRIDES = {
+	('messages.send', 'keyboard'): 'vk.KeyboardParam',
+	('messages.edit', 'keyboard'): 'vk.KeyboardParam',
+	('messages.send', 'template'): '*vk.Template',
+	('messages.sendMessageEventAnswer', 'event_data'): '*EventData',
+}
+
 def goify_field_name(name):
 	name = handle_special_caps(''.join(x.title() for x in name.split('_')))
 
@@ -293,7 +335,7 @@ def goify_field_name(name):
 
 	return name
 
-def goify_field(field):
+def goify_field(method, field):
 	# TODO: Enums
 	go_name = goify_field_name(field['name'])
 
@@ -305,7 +347,9 @@ def goify_field(field):
 
 	t = field['type']
 	is_array = t == 'array'
-	if is_array:
+	if (method, field['name']) in PARAM_TYPE_OVERRIDES:
+		go_type = PARAM_TYPE_OVERRIDES[(method, field['name'])]
+	elif is_array:
 		native = {
 			'integer': 'CSVIntSlice',
 			'string': 'CSVStringSlice',
@@ -468,19 +512,19 @@ def parse_type(name, decl, varname):
 		zero_val = 'nil'
 		return_val = varname
 		interface_val = '&' + varname
-		parser_statement = 'err = json.Unmarshal(r, {})'.format(interface_val)
+		parser_statement = 'err = vk.Unmarshal(r, {})'.format(interface_val)
 	elif decl.startswith('interface'):
 		return_type = name
 		zero_val = 'nil'
 		return_val = varname
 		interface_val = varname
-		parser_statement = 'err = json.Unmarshal(r, {})'.format(interface_val)
+		parser_statement = 'err = vk.Unmarshal(r, {})'.format(interface_val)
 	else:
 		return_type = '*' + name
 		zero_val = 'nil'
 		return_val = '&' + varname
 		interface_val = '&' + varname
-		parser_statement = 'err = json.Unmarshal(r, {})'.format(interface_val)
+		parser_statement = 'err = vk.Unmarshal(r, {})'.format(interface_val)
 
 	return return_type, zero_val, return_val, interface_val, parser_statement
 
@@ -499,8 +543,8 @@ for namespace, ns_methods in methods.items():
 	writeln('package vkapi\n')
 
 	writeln('import (')
+	writeln('\t"context"')
 	writeln('\t"strconv"')
-	writeln('\t"encoding/json"')
 	writeln('\t"github.com/stek29/vk"')
 	writeln(')\n')
 
@@ -516,7 +560,8 @@ for namespace, ns_methods in methods.items():
 		res = mtd['responses']['response']
 		extref = mtd['responses'].get('extendedResponse')
 
-		has_params = len(mtd.get('parameters', [])) != 0
+		params = mtd.get('parameters', []) + EXTRA_PARAMS.get(mtd['name'], [])
+		has_params = len(params) != 0
 
 		if has_params:
 			writeln('// {}{}Params are params for {}.{}'.format(
@@ -527,14 +572,14 @@ for namespace, ns_methods in methods.items():
 			))
 
 			writeln('type {}{}Params struct {{'.format(go_ns, go_mtd_name))
-			for param in mtd['parameters']:
+			for param in params:
 				try:
 					writeln('// {}'.format(param['description']))
 				except KeyError:
 					pass
 					# print('No description for {}:{}'.format(mtd['name'], param['name']))
 
-				writeln(goify_field(param))
+				writeln(goify_field(mtd['name'], param))
 			writeln('}\n')
 
 		
@@ -607,7 +652,8 @@ for namespace, ns_methods in methods.items():
 			if extref is None:
 				if res_desc:
 					writeln('// {}'.format(res_desc))
-				if res_goified not in NATIVE_TYPES_ZERO_VALS:
+				if res_goified not in NON_EASYJSON_TYPES:
+					writeln('//')
 					writeln('//easyjson:json')
 				writeln('type {}{}Response {}'.format(go_ns, go_mtd_name, res_goified))
 			else:
@@ -626,7 +672,8 @@ for namespace, ns_methods in methods.items():
 				if res_desc:
 					writeln('// {}'.format(res_desc))
 
-				if res_goified not in NATIVE_TYPES_ZERO_VALS:
+				if res_goified not in NON_EASYJSON_TYPES:
+					writeln('//')
 					writeln('//easyjson:json')
 				writeln('type {}{}ResponseNormal {}'.format(go_ns, go_mtd_name, res_goified))
 
@@ -640,7 +687,8 @@ for namespace, ns_methods in methods.items():
 
 				if extres_desc:
 					writeln('// {}'.format(extres_desc))
-				if extres_goified not in NATIVE_TYPES_ZERO_VALS:
+				if extres_goified not in NON_EASYJSON_TYPES:
+					writeln('//')
 					writeln('//easyjson:json')
 				writeln('type {}{}ResponseExtended {}'.format(go_ns, go_mtd_name, extres_goified))
 
@@ -661,8 +709,18 @@ for namespace, ns_methods in methods.items():
 			'params {}Params'.format(go_ns + go_mtd_name) if has_params else '',
 			return_type
 		))
+		writeln('\treturn v.{}Context(context.Background(){})'.format(go_mtd_name, ', params' if has_params else ''))
+		writeln('}\n')
+
+		writeln('// {}Context is {} with context support'.format(go_mtd_name, go_mtd_name))
+		writeln('func (v {}) {}Context (ctx context.Context{}) ({}, error) {{'.format(
+			go_ns,
+			go_mtd_name,
+			', params {}Params'.format(go_ns + go_mtd_name) if has_params else '',
+			return_type
+		))
 
-		writeln('\tr, err := v.API.Request("{}", {})'.format(mtd['name'], 'params' if has_params else 'nil'))
+		writeln('\tr, err := vk.RequestContext(ctx, v.API, "{}", {})'.format(mtd['name'], 'params' if has_params else 'nil'))
 		writeln('\tif err != nil {')
 		writeln('\t\treturn {}, err'.format(zero_val))
 		writeln('\t}\n')
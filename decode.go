@@ -0,0 +1,57 @@
+package vk
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// maxPooledBufferSize is capacity of largest buffer put back to pool,
+// so occasional huge responses don't keep memory allocated forever
+const maxPooledBufferSize = 4 << 20
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// decodeAPIResponse reads response envelope from r using pooled buffer
+//
+// Response and errors are copied out of buffer by easyjson,
+// since json.RawMessage.UnmarshalJSON copies data
+func decodeAPIResponse(r io.Reader, resp *APIResponse) error {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer func() {
+		if buf.Cap() <= maxPooledBufferSize {
+			bufferPool.Put(buf)
+		}
+	}()
+
+	if _, err := buf.ReadFrom(r); err != nil {
+		return err
+	}
+
+	l := jlexer.Lexer{Data: buf.Bytes()}
+	resp.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// Unmarshal parses JSON-encoded data and stores result in v
+//
+// If v implements easyjson.Unmarshaler, it's decoded with easyjson's lexer,
+// otherwise encoding/json is used
+func Unmarshal(data []byte, v interface{}) error {
+	if u, ok := v.(easyjson.Unmarshaler); ok {
+		l := jlexer.Lexer{Data: data}
+		u.UnmarshalEasyJSON(&l)
+		return l.Error()
+	}
+
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,48 @@
+package vk
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecodeAPIResponseOwnsResponse(t *testing.T) {
+	var first APIResponse
+	if err := decodeAPIResponse(strings.NewReader(`{"response":{"count":1}}`), &first); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// pooled buffer is reused here, so first.Response would
+	// be overwritten if it wasn't copied out of it
+	var second APIResponse
+	if err := decodeAPIResponse(strings.NewReader(`{"response":{"count":2}}`), &second); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if string(first.Response) != `{"count":1}` || string(second.Response) != `{"count":2}` {
+		t.Errorf("Unexpected responses: %s, %s", first.Response, second.Response)
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	// User implements easyjson.Unmarshaler
+	var user User
+	if err := Unmarshal([]byte(`{"id":1,"first_name":"Pavel"}`), &user); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if user.ID != 1 || user.FirstName != "Pavel" {
+		t.Errorf("Unexpected user: %+v", user)
+	}
+
+	// slices don't, so encoding/json is used
+	var users []User
+	if err := Unmarshal([]byte(`[{"id":1},{"id":2}]`), &users); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(users) != 2 || users[1].ID != 2 {
+		t.Errorf("Unexpected users: %+v", users)
+	}
+
+	if err := Unmarshal([]byte(`{"id":`), &user); err == nil {
+		t.Errorf("Expected error for malformed JSON")
+	}
+}
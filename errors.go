@@ -0,0 +1,261 @@
+package vk
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"syscall"
+)
+
+// VK API error codes
+//
+// See https://vk.com/dev/errors
+const (
+	ErrorCodeUnknown                    = 1
+	ErrorCodeAppDisabled                = 2
+	ErrorCodeUnknownMethod              = 3
+	ErrorCodeInvalidSignature           = 4
+	ErrorCodeAuthFailed                 = 5
+	ErrorCodeTooManyRequests            = 6
+	ErrorCodePermissionDenied           = 7
+	ErrorCodeInvalidRequest             = 8
+	ErrorCodeFlood                      = 9
+	ErrorCodeInternalServer             = 10
+	ErrorCodeTestMode                   = 11
+	ErrorCodeCompile                    = 12
+	ErrorCodeRuntime                    = 13
+	ErrorCodeCaptchaNeeded              = 14
+	ErrorCodeAccessDenied               = 15
+	ErrorCodeHTTPSRequired              = 16
+	ErrorCodeValidationRequired         = 17
+	ErrorCodeUserDeleted                = 18
+	ErrorCodeContentBlocked             = 19
+	ErrorCodeStandaloneOnly             = 20
+	ErrorCodeStandaloneOpenAPIOnly      = 21
+	ErrorCodeUpload                     = 22
+	ErrorCodeMethodDisabled             = 23
+	ErrorCodeConfirmationRequired       = 24
+	ErrorCodeGroupTokenInvalid          = 27
+	ErrorCodeAppTokenInvalid            = 28
+	ErrorCodeRateLimit                  = 29
+	ErrorCodePrivateProfile             = 30
+	ErrorCodeNotImplemented             = 33
+	ErrorCodeParam                      = 100
+	ErrorCodeParamAPIID                 = 101
+	ErrorCodeLimits                     = 103
+	ErrorCodeNotFound                   = 104
+	ErrorCodeSaveFile                   = 105
+	ErrorCodeActionFailed               = 106
+	ErrorCodeParamUserID                = 113
+	ErrorCodeParamAlbumID               = 114
+	ErrorCodeParamServer                = 118
+	ErrorCodeParamTitle                 = 119
+	ErrorCodeParamHash                  = 121
+	ErrorCodeParamPhotos                = 122
+	ErrorCodeParamGroupID               = 125
+	ErrorCodeParamPhoto                 = 129
+	ErrorCodeParamPageID                = 140
+	ErrorCodeAccessPage                 = 141
+	ErrorCodeParamTimestamp             = 150
+	ErrorCodeFriendsListID              = 171
+	ErrorCodeFriendsListLimit           = 173
+	ErrorCodeFriendsAddYourself         = 174
+	ErrorCodeFriendsAddInEnemy          = 175
+	ErrorCodeFriendsAddEnemy            = 176
+	ErrorCodeFriendsAddNotFound         = 177
+	ErrorCodeAccessNote                 = 181
+	ErrorCodeAccessNoteComment          = 182
+	ErrorCodeAccessComment              = 183
+	ErrorCodeAccessAlbum                = 200
+	ErrorCodeAccessAudio                = 201
+	ErrorCodeAccessGroup                = 203
+	ErrorCodeAccessVideo                = 204
+	ErrorCodeAccessMarket               = 205
+	ErrorCodeWallAccessPost             = 210
+	ErrorCodeWallAccessComment          = 211
+	ErrorCodeWallAccessReplies          = 212
+	ErrorCodeWallAccessAddReply         = 213
+	ErrorCodeWallAddPost                = 214
+	ErrorCodeWallAdsPublished           = 219
+	ErrorCodeWallTooManyRecipients      = 220
+	ErrorCodeStatusNoAudio              = 221
+	ErrorCodeWallLinksForbidden         = 222
+	ErrorCodeWallReplyOwnerFlood        = 223
+	ErrorCodeWallAdsPostLimitReached    = 224
+	ErrorCodePollsAccess                = 250
+	ErrorCodePollsPollID                = 251
+	ErrorCodePollsAnswerID              = 252
+	ErrorCodePollsAccessWithoutVote     = 253
+	ErrorCodeAccessGroups               = 260
+	ErrorCodeAlbumFull                  = 300
+	ErrorCodeAlbumsMax                  = 302
+	ErrorCodeVotesPermission            = 500
+	ErrorCodeVotes                      = 503
+	ErrorCodeNotEnoughMoney             = 504
+	ErrorCodeAdsPermission              = 600
+	ErrorCodeWeightedFlood              = 601
+	ErrorCodeAdsPartialSuccess          = 602
+	ErrorCodeAdsSpecific                = 603
+	ErrorCodeGroupChangeCreator         = 700
+	ErrorCodeGroupNotInClub             = 701
+	ErrorCodeGroupTooManyOfficers       = 702
+	ErrorCodeGroupNeed2FA               = 703
+	ErrorCodeGroupHostNeed2FA           = 704
+	ErrorCodeGroupTooManyAddresses      = 706
+	ErrorCodeGroupAppIsNotInstalled     = 711
+	ErrorCodeVideoAlreadyAdded          = 800
+	ErrorCodeVideoCommentsClosed        = 801
+	ErrorCodeMessagesUserBlocked        = 900
+	ErrorCodeMessagesDenySend           = 901
+	ErrorCodeMessagesPrivacy            = 902
+	ErrorCodeMessagesTooOldPTS          = 907
+	ErrorCodeMessagesTooNewPTS          = 908
+	ErrorCodeMessagesEditExpired        = 909
+	ErrorCodeMessagesTooBig             = 910
+	ErrorCodeMessagesKeyboardInvalid    = 911
+	ErrorCodeMessagesChatBotFeature     = 912
+	ErrorCodeMessagesTooLongForwards    = 913
+	ErrorCodeMessagesTooLongMessage     = 914
+	ErrorCodeMessagesChatUserNoAccess   = 917
+	ErrorCodeMessagesCantSeeInviteLink  = 919
+	ErrorCodeMessagesEditKindDisallowed = 920
+	ErrorCodeMessagesCantFwd            = 921
+	ErrorCodeMessagesCantDeleteForAll   = 924
+	ErrorCodeMessagesChatNotAdmin       = 925
+	ErrorCodeMessagesChatNotExist       = 927
+	ErrorCodeMessagesContactNotFound    = 936
+	ErrorCodeParamPhone                 = 1000
+	ErrorCodePhoneAlreadyUsed           = 1004
+	ErrorCodeAuthFloodError             = 1105
+	ErrorCodeAuthDelay                  = 1112
+	ErrorCodeAnonymousTokenExpired      = 1114
+	ErrorCodeParamDocDeleteAccess       = 1150
+	ErrorCodeParamDocTitle              = 1152
+	ErrorCodeParamDocAccess             = 1153
+	ErrorCodeAppsMenuAccess             = 1260
+	ErrorCodeMarketRestoreTooLate       = 1400
+	ErrorCodeMarketCommentsClosed       = 1401
+	ErrorCodeMarketAlbumNotFound        = 1402
+	ErrorCodeMarketItemNotFound         = 1403
+	ErrorCodeMarketItemAlreadyAdded     = 1404
+	ErrorCodeMarketTooManyItems         = 1405
+	ErrorCodeMarketTooManyItemsInAlbum  = 1406
+	ErrorCodeMarketTooManyAlbums        = 1407
+	ErrorCodeStoryExpired               = 1600
+	ErrorCodeStoryIncorrectReplyPrivacy = 1602
+	ErrorCodePrettyCardsCardNotFound    = 1900
+	ErrorCodePrettyCardsTooManyCards    = 1901
+	ErrorCodePrettyCardsCardIsConnected = 1902
+)
+
+// Sentinel errors which can be used with errors.Is
+//
+// Only error code is compared, so errors.Is(err, ErrAccessDenied)
+// is true for any *APIError with code ErrorCodeAccessDenied
+var (
+	ErrUnknown            = &APIError{Code: ErrorCodeUnknown, Message: "Unknown error occurred"}
+	ErrAppDisabled        = &APIError{Code: ErrorCodeAppDisabled, Message: "Application is disabled"}
+	ErrUnknownMethod      = &APIError{Code: ErrorCodeUnknownMethod, Message: "Unknown method passed"}
+	ErrInvalidSignature   = &APIError{Code: ErrorCodeInvalidSignature, Message: "Incorrect signature"}
+	ErrAuthFailed         = &APIError{Code: ErrorCodeAuthFailed, Message: "User authorization failed"}
+	ErrTooManyRequests    = &APIError{Code: ErrorCodeTooManyRequests, Message: "Too many requests per second"}
+	ErrPermissionDenied   = &APIError{Code: ErrorCodePermissionDenied, Message: "Permission to perform this action is denied"}
+	ErrInvalidRequest     = &APIError{Code: ErrorCodeInvalidRequest, Message: "Invalid request"}
+	ErrFlood              = &APIError{Code: ErrorCodeFlood, Message: "Flood control"}
+	ErrInternalServer     = &APIError{Code: ErrorCodeInternalServer, Message: "Internal server error"}
+	ErrCaptchaNeeded      = &APIError{Code: ErrorCodeCaptchaNeeded, Message: "Captcha needed"}
+	ErrAccessDenied       = &APIError{Code: ErrorCodeAccessDenied, Message: "Access denied"}
+	ErrHTTPSRequired      = &APIError{Code: ErrorCodeHTTPSRequired, Message: "HTTP authorization failed"}
+	ErrValidationRequired = &APIError{Code: ErrorCodeValidationRequired, Message: "Validation required"}
+	ErrUserDeleted        = &APIError{Code: ErrorCodeUserDeleted, Message: "User was deleted or banned"}
+	ErrMethodDisabled     = &APIError{Code: ErrorCodeMethodDisabled, Message: "This method was disabled"}
+	ErrGroupTokenInvalid  = &APIError{Code: ErrorCodeGroupTokenInvalid, Message: "Group authorization failed"}
+	ErrAppTokenInvalid    = &APIError{Code: ErrorCodeAppTokenInvalid, Message: "Application authorization failed"}
+	ErrRateLimit          = &APIError{Code: ErrorCodeRateLimit, Message: "Rate limit reached"}
+	ErrPrivateProfile     = &APIError{Code: ErrorCodePrivateProfile, Message: "This profile is private"}
+	ErrParam              = &APIError{Code: ErrorCodeParam, Message: "One of the parameters specified was missing or invalid"}
+	ErrNotFound           = &APIError{Code: ErrorCodeNotFound, Message: "Not found"}
+	ErrWeightedFlood      = &APIError{Code: ErrorCodeWeightedFlood, Message: "Permission denied. You have requested too many actions this day"}
+)
+
+// Is allows comparing APIErrors using errors.Is
+//
+// Errors are considered equal if their codes are equal
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+// errorCode returns VK API error code of err, or 0 if err is not *APIError
+func errorCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Code
+	}
+	return 0
+}
+
+// IsTemporary checks if err is a transient error, and request which
+// caused it might succeed when repeated later
+//
+// Those are VK API errors 1, 6, 9 and 10, HTTP 5xx errors
+// and connection resets
+func IsTemporary(err error) bool {
+	switch errorCode(err) {
+	case ErrorCodeUnknown, ErrorCodeTooManyRequests, ErrorCodeFlood, ErrorCodeInternalServer:
+		return true
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// IsAuth checks if err is caused by invalid, expired or revoked access token
+func IsAuth(err error) bool {
+	switch errorCode(err) {
+	case ErrorCodeAuthFailed, ErrorCodeGroupTokenInvalid, ErrorCodeAppTokenInvalid,
+		ErrorCodeAnonymousTokenExpired:
+		return true
+	}
+	return false
+}
+
+// IsQuota checks if err is caused by exceeding one of request quotas
+func IsQuota(err error) bool {
+	switch errorCode(err) {
+	case ErrorCodeTooManyRequests, ErrorCodeFlood, ErrorCodeRateLimit, ErrorCodeWeightedFlood:
+		return true
+	}
+	return false
+}
+
+// IsPermission checks if err is caused by lack of access rights
+func IsPermission(err error) bool {
+	switch errorCode(err) {
+	case ErrorCodePermissionDenied, ErrorCodeAccessDenied, ErrorCodePrivateProfile,
+		ErrorCodeAccessAlbum, ErrorCodeAccessAudio, ErrorCodeAccessGroup,
+		ErrorCodeAccessVideo, ErrorCodeAccessMarket, ErrorCodeAccessGroups:
+		return true
+	}
+	return false
+}
+
+// IsUserActionRequired checks if err can only be resolved by user,
+// i.e. by entering captcha or passing validation at RedirectURI
+func IsUserActionRequired(err error) bool {
+	switch errorCode(err) {
+	case ErrorCodeCaptchaNeeded, ErrorCodeValidationRequired, ErrorCodeConfirmationRequired:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package vk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	var err error = &APIError{Code: ErrorCodeAccessDenied, Message: "Access denied: no access to call this method"}
+	wrapped := fmt.Errorf("calling groups.getMembers: %w", err)
+
+	if !errors.Is(wrapped, ErrAccessDenied) {
+		t.Errorf("Expected errors.Is(%v, ErrAccessDenied) to be true", wrapped)
+	}
+
+	if errors.Is(wrapped, ErrAuthFailed) {
+		t.Errorf("Expected errors.Is(%v, ErrAuthFailed) to be false", wrapped)
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	cases := []struct {
+		err       error
+		temporary bool
+		auth      bool
+		quota     bool
+	}{
+		{&APIError{Code: ErrorCodeTooManyRequests}, true, false, true},
+		{&APIError{Code: ErrorCodeAuthFailed}, false, true, false},
+		{&APIError{Code: ErrorCodeRateLimit}, false, false, true},
+		{&APIError{Code: ErrorCodeAccessDenied}, false, false, false},
+		{&HTTPError{StatusCode: 503}, true, false, false},
+		{&HTTPError{StatusCode: 404}, false, false, false},
+		{io.ErrUnexpectedEOF, true, false, false},
+		{errors.New("something"), false, false, false},
+	}
+
+	for _, tcase := range cases {
+		if got := IsTemporary(tcase.err); got != tcase.temporary {
+			t.Errorf("IsTemporary(%v): got %v, expected %v", tcase.err, got, tcase.temporary)
+		}
+		if got := IsAuth(tcase.err); got != tcase.auth {
+			t.Errorf("IsAuth(%v): got %v, expected %v", tcase.err, got, tcase.auth)
+		}
+		if got := IsQuota(tcase.err); got != tcase.quota {
+			t.Errorf("IsQuota(%v): got %v, expected %v", tcase.err, got, tcase.quota)
+		}
+	}
+}
+
+func TestAPIErrorCaptchaFields(t *testing.T) {
+	var resp APIResponse
+	err := json.Unmarshal([]byte(`{"error":{"error_code":14,"error_msg":"Captcha needed","captcha_sid":"123","captcha_img":"https://api.vk.com/captcha.php?sid=123"}}`), &resp)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !errors.Is(resp.Error, ErrCaptchaNeeded) {
+		t.Errorf("Expected captcha error, got %v", resp.Error)
+	}
+
+	if resp.Error.CaptchaSID != "123" || resp.Error.CaptchaImg != "https://api.vk.com/captcha.php?sid=123" {
+		t.Errorf("Captcha fields were not decoded: %+v", resp.Error)
+	}
+}
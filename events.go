@@ -9,12 +9,16 @@ import (
 type CallbackEvent struct {
 	// ID of group this event occured in
 	GroupID int
+	// Type of event, i.e. "message_new"
+	Type string
+	// EventID is unique ID of event, only sent by newer API versions
+	EventID string
 	// Secret for Callback API
 	Secret string
 	// Event itself
 	//
 	// One of Confirmation, MessageNew, MessageReply, MessageEdit,
-	// MessageAllow, MessageDeny, MessageTypingState, PhotoNew,
+	// MessageAllow, MessageDeny, MessageTypingState, MessageEvent, PhotoNew,
 	// PhotoCommentNew, PhotoCommentEdit, PhotoCommentRestore,
 	// PhotoCommentDelete, AudioNew, VideoNew, VideoCommentNew,
 	// VideoCommentEdit, VideoCommentRestore, VideoCommentDelete,
@@ -34,6 +38,7 @@ func (e *CallbackEvent) UnmarshalJSON(data []byte) error {
 		GroupID int             `json:"group_id"`
 		Secret  string          `json:"secret"`
 		Type    string          `json:"type"`
+		EventID string          `json:"event_id"`
 		Object  json.RawMessage `json:"object"`
 	}
 
@@ -44,6 +49,8 @@ func (e *CallbackEvent) UnmarshalJSON(data []byte) error {
 
 	e.GroupID = rawEvent.GroupID
 	e.Secret = rawEvent.Secret
+	e.Type = rawEvent.Type
+	e.EventID = rawEvent.EventID
 
 	switch rawEvent.Type {
 	case "confirmation":
@@ -74,6 +81,10 @@ func (e *CallbackEvent) UnmarshalJSON(data []byte) error {
 		evt := MessageDeny{}
 		err = json.Unmarshal(rawEvent.Object, &evt)
 		e.Event = evt
+	case "message_event":
+		evt := MessageEvent{}
+		err = json.Unmarshal(rawEvent.Object, &evt)
+		e.Event = evt
 	case "photo_new":
 		evt := PhotoNew{}
 		err = json.Unmarshal(rawEvent.Object, &evt)
@@ -264,6 +275,23 @@ type MessageDeny struct {
 	UserID int `json:"user_id"`
 }
 
+// MessageEvent -- callback button is pressed
+//
+// It should be answered with messages.sendMessageEventAnswer,
+// otherwise button keeps loading
+//
+//easyjson:json
+type MessageEvent struct {
+	UserID int `json:"user_id"`
+	PeerID int `json:"peer_id"`
+	// EventID is used to answer event, it's valid for a minute
+	EventID string `json:"event_id"`
+	// Payload of pressed button
+	Payload json.RawMessage `json:"payload"`
+	// ConversationMessageID of message with keyboard
+	ConversationMessageID int `json:"conversation_message_id"`
+}
+
 // MessageTypingState -- new message typing state
 //
 //easyjson:json
@@ -49,36 +49,38 @@ func main() {
 		cancel()
 	}(cancel)
 
-	events, err := bot.StartPolling(ctx, 0)
-	if err != nil {
-		log.Fatal("Cat start polling:", err)
-	}
+	router := vkbot.NewRouter(bot)
+
+	router.OnMessageNew(func(ctx context.Context, msg *vk.MessageNew) {
+		from := msg.PeerID
+		text := msg.Text
+		msgID := msg.ID
 
-	for e := range events {
-		// log.Printf("Got event: %+v", e)
-
-		switch ev := e.Event.(type) {
-		case vk.MessageNew:
-			from := ev.PeerID
-			text := ev.Text
-			msgID := ev.ID
-
-			log.Printf("New message(%v) from %v: `%v`", msgID, from, text)
-
-			if text != "" {
-				resp, err := vkapi.Messages{bot}.Send(vkapi.MessagesSendParams{
-					PeerID:  from,
-					Message: text,
-					// ForwardMessages: ([]int{msgID}),
-				})
-
-				if err != nil {
-					log.Printf("Cant send reply to (%v): %v", msgID, err)
-				} else {
-					log.Printf("Sent reply to (%v): reply id %v", msgID, resp)
-				}
-			}
+		log.Printf("New message(%v) from %v: `%v`", msgID, from, text)
+
+		if text == "" {
+			return
 		}
+
+		resp, err := vkapi.Messages{API: vkbot.BotFromContext(ctx)}.SendContext(ctx, vkapi.MessagesSendParams{
+			PeerID:  from,
+			Message: text,
+			// ForwardMessages: ([]int{msgID}),
+		})
+
+		if err != nil {
+			log.Printf("Cant send reply to (%v): %v", msgID, err)
+		} else {
+			log.Printf("Sent reply to (%v): reply id %v", msgID, resp)
+		}
+	})
+
+	router.Fallback(func(ctx context.Context, e vk.CallbackEvent) {
+		log.Printf("Unhandled %v event", e.Type)
+	})
+
+	if err := router.Run(ctx); err != nil {
+		log.Fatal("Cant start polling:", err)
 	}
 
 	log.Printf("Bye!")
@@ -1,50 +1,32 @@
 package main
 
 import (
-	"bytes"
+	"context"
 	"flag"
 	"fmt"
-	"io"
-	"mime/multipart"
-	"net/http"
 	"os"
 	"path/filepath"
 
 	"github.com/stek29/vk"
 	"github.com/stek29/vk/vkapi"
+	"github.com/stek29/vk/vkupload"
 )
 
-func uploadVideo(uploadURL, path string) error {
+func uploadVideo(ctx context.Context, u *vkupload.Uploader, params vkapi.VideoSaveParams, path string) (*vkupload.Video, error) {
 	file, err := os.Open(path)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer file.Close()
 
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-	part, err := writer.CreateFormFile("video_file", filepath.Base(file.Name()))
+	info, err := file.Stat()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	io.Copy(part, file)
-	writer.Close()
 
-	req, err := http.NewRequest("POST", uploadURL, body)
-	req.Header.Add("Content-Type", writer.FormDataContentType())
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-
-	if err != nil {
-		return err
-	}
-
-	if resp.StatusCode != 200 {
-		return fmt.Errorf("Unexpected status code: Not 200 but %v", resp.StatusCode)
-	}
-
-	return nil
+	return u.Video(ctx, params, filepath.Base(path), file, info.Size(), func(uploaded, total int64) {
+		fmt.Printf("\rINFO: Uploaded %v of %v bytes (%.1f%%)", uploaded, total, float64(uploaded)*100/float64(total))
+	})
 }
 
 func main() {
@@ -57,6 +39,7 @@ func main() {
 
 	flag.StringVar(&params.Name, "name", "", "video name")
 	flag.StringVar(&params.Description, "description", "", "video description")
+	flag.StringVar(&params.Link, "link", "", "link to video to import instead of uploading file")
 	flag.IntVar(&params.GroupID, "group-id", 0, "Group ID to post to")
 
 	flag.Parse()
@@ -65,18 +48,22 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	resp, err := vkapi.Video{API: client}.Save(params)
-	if err != nil {
-		panic(err)
-	}
-	fmt.Printf("INFO: Upload response: %+v\n", resp)
 
-	fmt.Printf("Created Video: https://vk.com/video%v_%v\n", resp.OwnerID, resp.VideoID)
-	fmt.Printf("INFO: Upload URL: %v\n", resp.UploadURL)
+	ctx := context.Background()
+	u := vkupload.NewUploader(client, vkupload.UploaderConfig{})
 
-	err = uploadVideo(resp.UploadURL, videoPath)
+	var video *vkupload.Video
+	if params.Link != "" {
+		fmt.Println("INFO: Waiting for video to be imported")
+		video, err = u.VideoLink(ctx, params)
+	} else {
+		video, err = uploadVideo(ctx, u, params, videoPath)
+		fmt.Println()
+	}
 	if err != nil {
 		panic(err)
 	}
+
 	fmt.Println("INFO: Video was uploaded")
+	fmt.Printf("Created Video: https://vk.com/%v\n", video.Attachment)
 }
@@ -0,0 +1,389 @@
+package vk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"unicode/utf8"
+)
+
+// ButtonColor is color of text and callback buttons
+type ButtonColor string
+
+// Possible ButtonColor values
+const (
+	ButtonPrimary   ButtonColor = "primary"
+	ButtonSecondary ButtonColor = "secondary"
+	ButtonNegative  ButtonColor = "negative"
+	ButtonPositive  ButtonColor = "positive"
+)
+
+// Possible ButtonAction types
+const (
+	ButtonActionText     = "text"
+	ButtonActionOpenLink = "open_link"
+	ButtonActionLocation = "location"
+	ButtonActionVKPay    = "vkpay"
+	ButtonActionOpenApp  = "open_app"
+	ButtonActionCallback = "callback"
+)
+
+const (
+	maxButtonLabel   = 40
+	maxButtonPayload = 255
+)
+
+// ButtonAction is action performed when button is pressed
+type ButtonAction struct {
+	// Type is one of ButtonAction* constants
+	Type string `json:"type"`
+	// Label is text of button, not used by location and vkpay buttons
+	Label string `json:"label,omitempty"`
+	// Payload is JSON sent with message (or message_event)
+	// when button is pressed
+	Payload string `json:"payload,omitempty"`
+	// Link is URL opened by open_link button
+	Link string `json:"link,omitempty"`
+	// Hash is VK Pay payment params for vkpay button,
+	// or navigation hash for open_app button
+	Hash string `json:"hash,omitempty"`
+	// AppID is ID of application opened by open_app button
+	AppID int `json:"app_id,omitempty"`
+	// OwnerID is ID of community application is opened in
+	OwnerID int `json:"owner_id,omitempty"`
+}
+
+// Button is button of keyboard
+type Button struct {
+	Action ButtonAction `json:"action"`
+	// Color is only used by text and callback buttons
+	Color ButtonColor `json:"color,omitempty"`
+}
+
+// KeyboardLimits are limits of keyboard size
+type KeyboardLimits struct {
+	Rows    int
+	Columns int
+	Buttons int
+}
+
+// Keyboard limits imposed by VK
+var (
+	// KeyboardLimitsDefault are limits of keyboard shown under input field
+	KeyboardLimitsDefault = KeyboardLimits{Rows: 10, Columns: 5, Buttons: 40}
+	// KeyboardLimitsInline are limits of keyboard attached to message
+	KeyboardLimitsInline = KeyboardLimits{Rows: 6, Columns: 5, Buttons: 10}
+)
+
+// ClientInfo describes features supported by client of user,
+// it's sent by VK with message_new event
+type ClientInfo struct {
+	// ButtonActions are ButtonAction types supported by client
+	ButtonActions  []string `json:"button_actions"`
+	Keyboard       bool     `json:"keyboard"`
+	InlineKeyboard bool     `json:"inline_keyboard"`
+	Carousel       bool     `json:"carousel"`
+	LangID         int      `json:"lang_id"`
+}
+
+// Keyboard is bot keyboard sent with message
+//
+// It can be built with Add* methods, which append buttons to
+// the last row, and Row, which starts a new one:
+//
+//	kb := vk.NewKeyboard().
+//		AddText("Yes", map[string]string{"command": "yes"}, vk.ButtonPositive).
+//		AddText("No", map[string]string{"command": "no"}, vk.ButtonNegative).
+//		Row().
+//		AddLink("Help", "https://vk.com/dev/bots_docs_3", nil)
+//	vkapi.Messages{API: api}.Send(vkapi.MessagesSendParams{PeerID: peerID, Message: "?", Keyboard: kb})
+//
+// Keyboard without buttons hides keyboard shown to user.
+//
+// Keyboard conforms to query.Encoder interface, and is validated when encoded
+type Keyboard struct {
+	// OneTime keyboard is hidden after button is pressed,
+	// not supported by inline keyboards
+	OneTime bool `json:"one_time,omitempty"`
+	// Inline keyboard is shown inside of message
+	Inline  bool       `json:"inline,omitempty"`
+	Buttons [][]Button `json:"buttons"`
+
+	// err is first error encountered while building keyboard
+	err error
+}
+
+// NewKeyboard creates a new empty Keyboard
+func NewKeyboard() *Keyboard {
+	return &Keyboard{Buttons: [][]Button{}}
+}
+
+// NewInlineKeyboard creates a new empty inline Keyboard
+func NewInlineKeyboard() *Keyboard {
+	return &Keyboard{Inline: true, Buttons: [][]Button{}}
+}
+
+// SetOneTime sets OneTime flag
+func (k *Keyboard) SetOneTime(oneTime bool) *Keyboard {
+	k.OneTime = oneTime
+	return k
+}
+
+// Row starts a new row of buttons
+func (k *Keyboard) Row() *Keyboard {
+	k.Buttons = append(k.Buttons, nil)
+	return k
+}
+
+// Add appends button to the last row
+func (k *Keyboard) Add(b Button) *Keyboard {
+	if len(k.Buttons) == 0 {
+		k.Row()
+	}
+
+	last := len(k.Buttons) - 1
+	k.Buttons[last] = append(k.Buttons[last], b)
+	return k
+}
+
+// AddText appends text button, which sends its label as message
+//
+// payload is marshalled to JSON, unless it's nil,
+// and is sent with message (see Message.Payload)
+func (k *Keyboard) AddText(label string, payload interface{}, color ButtonColor) *Keyboard {
+	return k.add(ButtonAction{Type: ButtonActionText, Label: label}, payload, color)
+}
+
+// AddLink appends button which opens link
+func (k *Keyboard) AddLink(label, link string, payload interface{}) *Keyboard {
+	return k.add(ButtonAction{Type: ButtonActionOpenLink, Label: label, Link: link}, payload, "")
+}
+
+// AddLocation appends button which sends location of user
+func (k *Keyboard) AddLocation(payload interface{}) *Keyboard {
+	return k.add(ButtonAction{Type: ButtonActionLocation}, payload, "")
+}
+
+// AddVKPay appends button which opens VK Pay window with payment params in hash
+// (i.e. "action=transfer-to-group&group_id=1&aid=10")
+func (k *Keyboard) AddVKPay(hash string, payload interface{}) *Keyboard {
+	return k.add(ButtonAction{Type: ButtonActionVKPay, Hash: hash}, payload, "")
+}
+
+// AddApp appends button which opens VK Mini App, ownerID and hash are optional
+func (k *Keyboard) AddApp(label string, appID, ownerID int, hash string, payload interface{}) *Keyboard {
+	return k.add(ButtonAction{
+		Type:    ButtonActionOpenApp,
+		Label:   label,
+		AppID:   appID,
+		OwnerID: ownerID,
+		Hash:    hash,
+	}, payload, "")
+}
+
+// AddCallback appends button which sends message_event instead of message
+func (k *Keyboard) AddCallback(label string, payload interface{}, color ButtonColor) *Keyboard {
+	return k.add(ButtonAction{Type: ButtonActionCallback, Label: label}, payload, color)
+}
+
+func (k *Keyboard) add(action ButtonAction, payload interface{}, color ButtonColor) *Keyboard {
+	b, err := newButton(action, payload, color)
+	if err != nil && k.err == nil {
+		k.err = err
+	}
+
+	return k.Add(b)
+}
+
+// newButton creates button with payload marshalled to JSON
+func newButton(action ButtonAction, payload interface{}, color ButtonColor) (Button, error) {
+	b := Button{Action: action, Color: color}
+	if payload == nil {
+		return b, nil
+	}
+
+	p, err := json.Marshal(payload)
+	if err != nil {
+		return b, fmt.Errorf("vk: can't marshal payload of button %q: %w", action.Label, err)
+	}
+	b.Action.Payload = string(p)
+
+	return b, nil
+}
+
+// Limits returns limits applicable to k
+func (k *Keyboard) Limits() KeyboardLimits {
+	if k.Inline {
+		return KeyboardLimitsInline
+	}
+	return KeyboardLimitsDefault
+}
+
+// Validate checks that keyboard doesn't exceed VK limits
+func (k *Keyboard) Validate() error {
+	if k.err != nil {
+		return k.err
+	}
+
+	if k.Inline && k.OneTime {
+		return errors.New("vk: inline keyboard can't be one time")
+	}
+
+	limits := k.Limits()
+	if len(k.Buttons) > limits.Rows {
+		return fmt.Errorf("vk: keyboard has %d rows, maximum is %d", len(k.Buttons), limits.Rows)
+	}
+
+	total := 0
+	for i, row := range k.Buttons {
+		if len(row) == 0 {
+			return fmt.Errorf("vk: keyboard row %d is empty", i)
+		}
+
+		if len(row) > limits.Columns {
+			return fmt.Errorf("vk: keyboard row %d has %d buttons, maximum is %d", i, len(row), limits.Columns)
+		}
+
+		for j, b := range row {
+			if err := b.validate(len(row)); err != nil {
+				return fmt.Errorf("vk: keyboard button %d of row %d: %w", j, i, err)
+			}
+		}
+
+		total += len(row)
+	}
+
+	if total > limits.Buttons {
+		return fmt.Errorf("vk: keyboard has %d buttons, maximum is %d", total, limits.Buttons)
+	}
+
+	return nil
+}
+
+// ValidateFor checks that keyboard doesn't exceed VK limits
+// and is supported by client
+func (k *Keyboard) ValidateFor(client ClientInfo) error {
+	if err := k.Validate(); err != nil {
+		return err
+	}
+
+	if k.Inline && !client.InlineKeyboard {
+		return errors.New("vk: client doesn't support inline keyboards")
+	}
+
+	if !k.Inline && !client.Keyboard {
+		return errors.New("vk: client doesn't support keyboards")
+	}
+
+	supported := make(map[string]bool, len(client.ButtonActions))
+	for _, action := range client.ButtonActions {
+		supported[action] = true
+	}
+
+	for _, row := range k.Buttons {
+		for _, b := range row {
+			if !supported[b.Action.Type] {
+				return fmt.Errorf("vk: client doesn't support %v buttons", b.Action.Type)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (b Button) validate(rowLen int) error {
+	switch b.Action.Type {
+	case ButtonActionText, ButtonActionCallback:
+		if b.Action.Label == "" {
+			return errors.New("label is required")
+		}
+	case ButtonActionOpenLink:
+		if b.Action.Label == "" || b.Action.Link == "" {
+			return errors.New("label and link are required")
+		}
+	case ButtonActionOpenApp:
+		if b.Action.Label == "" || b.Action.AppID == 0 {
+			return errors.New("label and app ID are required")
+		}
+	case ButtonActionVKPay:
+		if b.Action.Hash == "" {
+			return errors.New("hash is required")
+		}
+	case ButtonActionLocation:
+	default:
+		return fmt.Errorf("unknown type %q", b.Action.Type)
+	}
+
+	switch b.Action.Type {
+	case ButtonActionLocation, ButtonActionVKPay, ButtonActionOpenApp:
+		// these buttons occupy the whole row
+		if rowLen != 1 {
+			return fmt.Errorf("%v button must be alone in row", b.Action.Type)
+		}
+	}
+
+	if b.Color != "" && b.Action.Type != ButtonActionText && b.Action.Type != ButtonActionCallback {
+		return fmt.Errorf("%v button can't have color", b.Action.Type)
+	}
+
+	if utf8.RuneCountInString(b.Action.Label) > maxButtonLabel {
+		return fmt.Errorf("label is longer than %d characters", maxButtonLabel)
+	}
+
+	if len(b.Action.Payload) > maxButtonPayload {
+		return fmt.Errorf("payload is longer than %d bytes", maxButtonPayload)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface
+func (k *Keyboard) MarshalJSON() ([]byte, error) {
+	// alias type doesn't have MarshalJSON method
+	type keyboard Keyboard
+	kb := keyboard(*k)
+	if kb.Buttons == nil {
+		kb.Buttons = [][]Button{}
+	}
+	return json.Marshal(kb)
+}
+
+// EncodeValues conforms to query.Encoder interface
+func (k *Keyboard) EncodeValues(key string, v *url.Values) error {
+	if k == nil {
+		return nil
+	}
+
+	if err := k.Validate(); err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(k)
+	if err != nil {
+		return err
+	}
+
+	v.Set(key, string(encoded))
+	return nil
+}
+
+// KeyboardParam is keyboard sent with message, either *Keyboard or RawKeyboard
+type KeyboardParam interface {
+	EncodeValues(key string, v *url.Values) error
+}
+
+// RawKeyboard is keyboard JSON which is sent as is, without validation
+//
+// RawKeyboard conforms to query.Encoder interface
+type RawKeyboard json.RawMessage
+
+// EncodeValues conforms to query.Encoder interface
+func (k RawKeyboard) EncodeValues(key string, v *url.Values) error {
+	if len(k) == 0 {
+		return nil
+	}
+
+	v.Set(key, string(k))
+	return nil
+}
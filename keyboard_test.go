@@ -0,0 +1,126 @@
+package vk
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestKeyboardEncode(t *testing.T) {
+	kb := NewKeyboard().
+		SetOneTime(true).
+		AddText("Yes", map[string]string{"command": "yes"}, ButtonPositive).
+		AddCallback("No", nil, ButtonNegative).
+		Row().
+		AddLocation(nil)
+
+	params := struct {
+		Keyboard *Keyboard `url:"keyboard,omitempty"`
+	}{kb}
+
+	q, err := BuildRequestParams(params)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := `{"one_time":true,"buttons":[` +
+		`[{"action":{"type":"text","label":"Yes","payload":"{\"command\":\"yes\"}"},"color":"positive"},` +
+		`{"action":{"type":"callback","label":"No"},"color":"negative"}],` +
+		`[{"action":{"type":"location"}}]]}`
+
+	if got := q.Get("keyboard"); got != expected {
+		t.Errorf("Unexpected keyboard:\n%v\nexpected:\n%v", got, expected)
+	}
+
+	params.Keyboard = nil
+	if q, _ := BuildRequestParams(params); q.Get("keyboard") != "" {
+		t.Errorf("nil keyboard shouldn't be encoded")
+	}
+
+	// empty keyboard hides keyboard
+	if encoded, _ := json.Marshal(&Keyboard{}); string(encoded) != `{"buttons":[]}` {
+		t.Errorf("Unexpected empty keyboard: %s", encoded)
+	}
+}
+
+func TestKeyboardParamEncode(t *testing.T) {
+	raw := `{"buttons":[[{"action":{"type":"text","label":"Hi"}}]]}`
+
+	tests := []struct {
+		keyboard KeyboardParam
+		expected string
+	}{
+		{RawKeyboard(raw), raw},
+		{NewInlineKeyboard().AddCallback("Like", nil, ButtonPositive), `{"inline":true,"buttons":[[{"action":{"type":"callback","label":"Like"},"color":"positive"}]]}`},
+		{(*Keyboard)(nil), ""},
+		{RawKeyboard(nil), ""},
+		{nil, ""},
+	}
+
+	for _, tt := range tests {
+		params := struct {
+			Keyboard KeyboardParam `url:"keyboard,omitempty"`
+		}{tt.keyboard}
+
+		q, err := BuildRequestParams(params)
+		if err != nil {
+			t.Errorf("%#v: unexpected error: %v", tt.keyboard, err)
+		} else if got := q.Get("keyboard"); got != tt.expected {
+			t.Errorf("%#v: expected %v, got %v", tt.keyboard, tt.expected, got)
+		}
+	}
+}
+
+func TestKeyboardValidate(t *testing.T) {
+	tooManyInline := NewInlineKeyboard()
+	for i := 0; i < 11; i++ {
+		if i%5 == 0 {
+			tooManyInline.Row()
+		}
+		tooManyInline.AddText("b", nil, "")
+	}
+
+	tests := []struct {
+		kb  *Keyboard
+		err string
+	}{
+		{NewKeyboard().AddText("ok", nil, ButtonPrimary).AddLink("link", "https://vk.com", nil), ""},
+		{NewKeyboard().AddText("", nil, ""), "label is required"},
+		{NewKeyboard().AddText("a", nil, "").AddVKPay("action=pay-to-group", nil), "must be alone in row"},
+		{NewKeyboard().AddLink("a", "https://vk.com", nil).Row(), "row 1 is empty"},
+		{NewKeyboard().AddLink("a", "https://vk.com", nil), ""},
+		{NewKeyboard().Add(Button{Action: ButtonAction{Type: ButtonActionOpenLink, Label: "a", Link: "b"}, Color: ButtonPrimary}), "can't have color"},
+		{NewKeyboard().AddText(strings.Repeat("a", 41), nil, ""), "longer than 40"},
+		{NewKeyboard().AddText("a", make(chan int), ""), "can't marshal payload"},
+		{NewInlineKeyboard().SetOneTime(true), "can't be one time"},
+		{tooManyInline, "has 11 buttons, maximum is 10"},
+	}
+
+	for i, tt := range tests {
+		err := tt.kb.Validate()
+		if tt.err == "" && err != nil {
+			t.Errorf("%d: Unexpected error: %v", i, err)
+		} else if tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+			t.Errorf("%d: Expected error %q, got %v", i, tt.err, err)
+		}
+	}
+}
+
+func TestKeyboardValidateFor(t *testing.T) {
+	client := ClientInfo{
+		ButtonActions: []string{ButtonActionText, ButtonActionOpenLink},
+		Keyboard:      true,
+	}
+
+	if err := NewKeyboard().AddText("a", nil, "").ValidateFor(client); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if err := NewKeyboard().AddCallback("a", nil, "").ValidateFor(client); err == nil {
+		t.Errorf("Expected unsupported callback button error")
+	}
+
+	if err := NewInlineKeyboard().AddText("a", nil, "").ValidateFor(client); err == nil {
+		t.Errorf("Expected unsupported inline keyboard error")
+	}
+}
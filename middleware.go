@@ -0,0 +1,152 @@
+package vk
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Middleware wraps API adding some behaviour to it
+type Middleware func(next API) API
+
+// Chain wraps api with middlewares
+//
+// First middleware is the outermost one, so
+//
+//	vk.Chain(api, a, b, c)
+//
+// is the same as
+//
+//	a(b(c(api)))
+func Chain(api API, middlewares ...Middleware) API {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		api = middlewares[i](api)
+	}
+	return api
+}
+
+// Call represents a single API request passing through Interceptor
+type Call struct {
+	// Method is API method name
+	Method string
+	// Params are request params, without ones added by BaseAPI
+	Params url.Values
+
+	// Started is time when request was passed to next API
+	Started time.Time
+	// Duration is time it took next API to perform request
+	Duration time.Duration
+
+	// Response is raw response returned by next API
+	Response json.RawMessage
+	// Err is error returned by next API
+	Err error
+}
+
+// CallHandler performs call, filling its Response and Err
+type CallHandler func(ctx context.Context, call *Call)
+
+// Interceptor creates Middleware from function which can inspect and
+// modify call before and after passing it to next
+//
+// Usage:
+//
+//	logger := vk.Interceptor(func(ctx context.Context, call *vk.Call, next vk.CallHandler) {
+//		next(ctx, call)
+//		log.Printf("%v took %v", call.Method, call.Duration)
+//	})
+func Interceptor(fn func(ctx context.Context, call *Call, next CallHandler)) Middleware {
+	return func(next API) API {
+		return &interceptorAPI{
+			next: next,
+			fn:   fn,
+		}
+	}
+}
+
+type interceptorAPI struct {
+	next API
+	fn   func(ctx context.Context, call *Call, next CallHandler)
+}
+
+func (i *interceptorAPI) HTTPClient() *http.Client {
+	return i.next.HTTPClient()
+}
+
+func (i *interceptorAPI) Request(method string, params interface{}) (json.RawMessage, error) {
+	return i.RequestContext(context.Background(), method, params)
+}
+
+func (i *interceptorAPI) RequestContext(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	q, err := BuildRequestParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	call := &Call{
+		Method: method,
+		Params: q,
+	}
+
+	i.fn(ctx, call, i.invoke)
+
+	return call.Response, call.Err
+}
+
+func (i *interceptorAPI) invoke(ctx context.Context, call *Call) {
+	call.Started = time.Now()
+	call.Response, call.Err = RequestContext(ctx, i.next, call.Method, call.Params)
+	call.Duration = time.Since(call.Started)
+}
+
+// RetryMiddleware creates Middleware which wraps API with RetryAPI
+func RetryMiddleware(cfg RetryAPIConfig) Middleware {
+	return func(next API) API {
+		return NewRetryAPI(next, cfg)
+	}
+}
+
+// BatchMiddleware creates Middleware which wraps API with BatchAPI
+func BatchMiddleware(cfg BatchAPIConfig) Middleware {
+	return func(next API) API {
+		return NewBatchAPI(next, cfg)
+	}
+}
+
+// LoggingMiddleware creates Middleware which logs every request
+// with its duration and error using logger, or standard logger if nil
+//
+// Params are not logged, since they might contain secrets
+func LoggingMiddleware(logger *log.Logger) Middleware {
+	logf := log.Printf
+	if logger != nil {
+		logf = logger.Printf
+	}
+
+	return Interceptor(func(ctx context.Context, call *Call, next CallHandler) {
+		next(ctx, call)
+
+		if call.Err != nil {
+			logf("vk: %v failed after %v: %v", call.Method, call.Duration, call.Err)
+		} else {
+			logf("vk: %v took %v, %d bytes", call.Method, call.Duration, len(call.Response))
+		}
+	})
+}
+
+// AccessTokenMiddleware creates Middleware which sets access_token
+// of every request to token, overriding one used by BaseAPI
+func AccessTokenMiddleware(token string) Middleware {
+	return Interceptor(func(ctx context.Context, call *Call, next CallHandler) {
+		// Params might be owned by caller, so they're copied
+		params := make(url.Values, len(call.Params)+1)
+		MergeURLValues(params, call.Params)
+		params.Set("access_token", token)
+
+		call.Params = params
+		next(ctx, call)
+	})
+}
@@ -0,0 +1,79 @@
+package vk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestChainOrder(t *testing.T) {
+	var order []string
+
+	tracer := func(name string) Middleware {
+		return Interceptor(func(ctx context.Context, call *Call, next CallHandler) {
+			order = append(order, name)
+			next(ctx, call)
+		})
+	}
+
+	api := Chain(funcAPI(func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+		order = append(order, "api")
+		return json.RawMessage("1"), nil
+	}), tracer("a"), tracer("b"))
+
+	resp, err := api.Request("users.get", nil)
+	if err != nil || string(resp) != "1" {
+		t.Errorf("Unexpected result: %s, %v", resp, err)
+	}
+
+	if got := strings.Join(order, ","); got != "a,b,api" {
+		t.Errorf("Expected a,b,api order, got %v", got)
+	}
+}
+
+func TestInterceptorCall(t *testing.T) {
+	var seen *Call
+
+	api := Chain(funcAPI(func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+		return json.RawMessage(`{"ok":1}`), nil
+	}), Interceptor(func(ctx context.Context, call *Call, next CallHandler) {
+		next(ctx, call)
+		seen = call
+	}))
+
+	api.Request("users.get", map[string]string(nil))
+	if seen != nil {
+		t.Fatalf("Interceptor should not be called when params are invalid")
+	}
+
+	api.Request("users.get", struct {
+		UserIDs string `url:"user_ids"`
+	}{"1"})
+
+	if seen == nil {
+		t.Fatalf("Interceptor was not called")
+	}
+	if seen.Method != "users.get" || seen.Params.Get("user_ids") != "1" {
+		t.Errorf("Unexpected call: %+v", seen)
+	}
+	if seen.Started.IsZero() || string(seen.Response) != `{"ok":1}` {
+		t.Errorf("Call timing and response were not filled: %+v", seen)
+	}
+}
+
+func TestAccessTokenMiddleware(t *testing.T) {
+	base := newTestBaseAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if tokens := r.PostForm["access_token"]; len(tokens) != 1 || tokens[0] != "override" {
+			t.Errorf("Expected single overridden access_token, got %v", tokens)
+		}
+		w.Write([]byte(`{"response":1}`))
+	})
+
+	api := Chain(base, AccessTokenMiddleware("override"))
+	if _, err := api.Request("users.get", nil); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+package vk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// RequestInfo describes a single finished HTTP request made to VK
+//
+// It never contains request params, so access tokens can't leak through it
+type RequestInfo struct {
+	// Method is API method name, or pseudo-method for requests
+	// which are not API calls, i.e. "longpoll.check"
+	Method string
+
+	// Started is time when request was started
+	Started time.Time
+	// Duration is time it took to perform request
+	Duration time.Duration
+
+	// StatusCode is HTTP status code, 0 if no response was received
+	StatusCode int
+	// ErrorCode is VK API error code, 0 if there was no APIError
+	ErrorCode int
+	// Err is error request has failed with, if any
+	Err error
+
+	// RequestSize is size of request body in bytes
+	RequestSize int64
+	// ResponseSize is size of response body in bytes
+	ResponseSize int64
+}
+
+// Observer is notified about every request made to VK
+//
+// ObserveRequest is called synchronously, so it should not block
+type Observer interface {
+	ObserveRequest(ctx context.Context, info RequestInfo)
+}
+
+// ObserverFunc is an adapter to allow the use of ordinary functions
+// as Observer
+type ObserverFunc func(ctx context.Context, info RequestInfo)
+
+// ObserveRequest conforms to Observer interface
+func (f ObserverFunc) ObserveRequest(ctx context.Context, info RequestInfo) {
+	f(ctx, info)
+}
+
+// MultiObserver creates Observer which notifies every one of observers
+func MultiObserver(observers ...Observer) Observer {
+	return ObserverFunc(func(ctx context.Context, info RequestInfo) {
+		for _, o := range observers {
+			o.ObserveRequest(ctx, info)
+		}
+	})
+}
+
+// Tracer starts tracing spans, it's supposed to be implemented
+// by adapters to tracing libraries
+type Tracer interface {
+	// StartSpan starts a new span named name, and returns ctx with it
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a single traced operation started by Tracer
+type Span interface {
+	// SetAttribute sets attribute of span, value is either string or int64
+	SetAttribute(key string, value interface{})
+	// End finishes span, err is error operation has failed with, if any
+	End(err error)
+}
+
+// Span attribute names set by BaseAPI and vkbot pollers
+const (
+	SpanAttrMethod       = "vk.method"
+	SpanAttrStatusCode   = "http.status_code"
+	SpanAttrErrorCode    = "vk.error_code"
+	SpanAttrRequestSize  = "vk.request_size"
+	SpanAttrResponseSize = "vk.response_size"
+)
+
+// RequestObservation reports request to Observer and Tracer
+//
+// It's used by BaseAPI and vkbot pollers, and can be used by
+// other code making requests to VK directly.
+// Both Observer and Tracer might be nil
+type RequestObservation struct {
+	Info RequestInfo
+
+	observer Observer
+	span     Span
+}
+
+// StartRequestObservation starts observation of request to method
+//
+// Returned ctx should be used for request, and Finish should be called
+// when request is finished
+func StartRequestObservation(ctx context.Context, observer Observer, tracer Tracer, method string) (context.Context, *RequestObservation) {
+	o := &RequestObservation{
+		Info: RequestInfo{
+			Method:  method,
+			Started: time.Now(),
+		},
+		observer: observer,
+	}
+
+	if tracer != nil {
+		ctx, o.span = tracer.StartSpan(ctx, "vk "+method)
+		o.span.SetAttribute(SpanAttrMethod, method)
+	}
+
+	return ctx, o
+}
+
+// Finish sets Duration, Err and ErrorCode of Info,
+// and reports it to Observer and Tracer
+func (o *RequestObservation) Finish(ctx context.Context, err error) {
+	o.Info.Duration = time.Since(o.Info.Started)
+	o.Info.Err = err
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		o.Info.ErrorCode = apiErr.Code
+	}
+
+	if o.span != nil {
+		if o.Info.StatusCode != 0 {
+			o.span.SetAttribute(SpanAttrStatusCode, int64(o.Info.StatusCode))
+		}
+		if o.Info.ErrorCode != 0 {
+			o.span.SetAttribute(SpanAttrErrorCode, int64(o.Info.ErrorCode))
+		}
+		o.span.SetAttribute(SpanAttrRequestSize, o.Info.RequestSize)
+		o.span.SetAttribute(SpanAttrResponseSize, o.Info.ResponseSize)
+		o.span.End(err)
+	}
+
+	if o.observer != nil {
+		o.observer.ObserveRequest(ctx, o.Info)
+	}
+}
+
+// CountingReader wraps response body r, counting bytes read from it
+// into Info.ResponseSize
+func (o *RequestObservation) CountingReader(r io.Reader) io.Reader {
+	return &countingReader{r: r, n: &o.Info.ResponseSize}
+}
+
+type countingReader struct {
+	r io.Reader
+	n *int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	*c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,84 @@
+package vk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+type testSpan struct {
+	name  string
+	attrs map[string]interface{}
+	err   error
+	ended bool
+}
+
+func (s *testSpan) SetAttribute(key string, value interface{}) {
+	s.attrs[key] = value
+}
+
+func (s *testSpan) End(err error) {
+	s.err = err
+	s.ended = true
+}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	s := &testSpan{name: name, attrs: make(map[string]interface{})}
+	t.spans = append(t.spans, s)
+	return ctx, s
+}
+
+func TestBaseAPIObserver(t *testing.T) {
+	const body = `{"error":{"error_code":9,"error_msg":"Flood control"}}`
+
+	api := newTestBaseAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	})
+
+	var infos []RequestInfo
+	api.Observer = ObserverFunc(func(ctx context.Context, info RequestInfo) {
+		infos = append(infos, info)
+	})
+
+	tracer := &testTracer{}
+	api.Tracer = tracer
+
+	_, err := api.Request("messages.send", url.Values{"message": {"hi"}})
+	if err == nil {
+		t.Fatalf("Expected error")
+	}
+
+	if len(infos) != 1 {
+		t.Fatalf("Expected 1 observed request, got %v", len(infos))
+	}
+
+	info := infos[0]
+	if info.Method != "messages.send" || info.StatusCode != http.StatusOK || info.ErrorCode != ErrorCodeFlood {
+		t.Errorf("Unexpected info: %+v", info)
+	}
+	if info.RequestSize == 0 || info.ResponseSize != int64(len(body)) {
+		t.Errorf("Unexpected sizes: %v, %v", info.RequestSize, info.ResponseSize)
+	}
+	if strings.Contains(fmt.Sprintf("%+v", info), "test-token") {
+		t.Errorf("Access token leaked to RequestInfo: %+v", info)
+	}
+
+	if len(tracer.spans) != 1 {
+		t.Fatalf("Expected 1 span, got %v", len(tracer.spans))
+	}
+
+	span := tracer.spans[0]
+	if span.name != "vk messages.send" || !span.ended || span.err != err {
+		t.Errorf("Unexpected span: %+v", span)
+	}
+	if span.attrs[SpanAttrErrorCode] != int64(ErrorCodeFlood) || span.attrs[SpanAttrStatusCode] != int64(http.StatusOK) {
+		t.Errorf("Unexpected span attributes: %v", span.attrs)
+	}
+}
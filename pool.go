@@ -0,0 +1,260 @@
+package vk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// TokenPoolStrategy determines which token is used for next request
+type TokenPoolStrategy int
+
+// Possible TokenPoolStrategy values
+const (
+	// RoundRobin uses tokens one after another
+	RoundRobin TokenPoolStrategy = iota
+	// LeastLoaded uses token with least requests in flight
+	LeastLoaded
+)
+
+// ErrNoTokensAvailable is returned by TokenPool when all tokens
+// are either benched or cooled down
+var ErrNoTokensAvailable = errors.New("vk: no tokens available in pool")
+
+// TokenPoolError is returned by TokenPool when every token it tried
+// has failed, it matches both ErrNoTokensAvailable and Err, i.e.
+// errors.Is(err, ErrNoTokensAvailable) and errors.As(err, &apiErr) work
+type TokenPoolError struct {
+	// Err is error returned for the last tried token
+	Err error
+}
+
+// Error implements error interface
+func (e *TokenPoolError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrNoTokensAvailable, e.Err)
+}
+
+// Is reports if target is ErrNoTokensAvailable
+func (e *TokenPoolError) Is(target error) bool {
+	return target == ErrNoTokensAvailable
+}
+
+// Unwrap returns error of the last tried token
+func (e *TokenPoolError) Unwrap() error {
+	return e.Err
+}
+
+// moscowTime is used by default, since VK quotas are reset at midnight MSK
+var moscowTime = time.FixedZone("MSK", 3*60*60)
+
+// TokenPoolConfig represents configuration used for TokenPool creation
+type TokenPoolConfig struct {
+	// Optional: RoundRobin is used by default
+	Strategy TokenPoolStrategy
+	// Optional: if not 0, requests for every token are paced with ThrottledAPI
+	RequestsPerSecond int
+	// Optional: timezone in which daily quotas are reset, if nil, UTC+3 is used
+	Location *time.Location
+}
+
+// TokenStats represents usage statistics of one token in TokenPool
+type TokenStats struct {
+	// Token is masked access token, only last 4 characters are kept
+	Token string
+	// Requests is number of requests made with this token
+	Requests int64
+	// Errors is number of requests which have failed
+	Errors int64
+	// InFlight is number of requests being performed right now
+	InFlight int
+	// Benched is set if token has failed authorization
+	Benched bool
+	// CooldownUntil is time until which token is not used because
+	// it has reached its daily quota
+	CooldownUntil time.Time
+}
+
+type poolToken struct {
+	api   API
+	stats TokenStats
+}
+
+// TokenPool is an API which spreads requests between several tokens
+//
+// Tokens failing with authorization errors are benched and not used anymore,
+// and tokens which have reached daily quota (ErrorCodeRateLimit) are not
+// used until the next day. Such requests are repeated with next token.
+type TokenPool struct {
+	mu       sync.Mutex
+	tokens   []*poolToken
+	next     int
+	strategy TokenPoolStrategy
+	location *time.Location
+
+	// now is used instead of time.Now in tests
+	now func() time.Time
+}
+
+// NewTokenPool creates a new TokenPool which performs requests with apis
+func NewTokenPool(apis []*BaseAPI, cfg TokenPoolConfig) (*TokenPool, error) {
+	if len(apis) == 0 {
+		return nil, errors.New("At least one BaseAPI is required")
+	}
+
+	location := cfg.Location
+	if location == nil {
+		location = moscowTime
+	}
+
+	p := &TokenPool{
+		strategy: cfg.Strategy,
+		location: location,
+		now:      time.Now,
+	}
+
+	for _, base := range apis {
+		var api API = base
+		if cfg.RequestsPerSecond != 0 {
+			api = NewThrottledAPI(base, ThrottledAPIConfig{RequestsPerSecond: cfg.RequestsPerSecond})
+		}
+
+		p.tokens = append(p.tokens, &poolToken{
+			api:   api,
+			stats: TokenStats{Token: maskToken(base.AccessToken)},
+		})
+	}
+
+	return p, nil
+}
+
+func maskToken(token string) string {
+	const visible = 4
+	if len(token) <= visible {
+		return "****"
+	}
+	return "****" + token[len(token)-visible:]
+}
+
+// HTTPClient conforms to API interface
+func (p *TokenPool) HTTPClient() *http.Client {
+	return p.tokens[0].api.HTTPClient()
+}
+
+// Request conforms to API interface
+func (p *TokenPool) Request(method string, params interface{}) (json.RawMessage, error) {
+	return p.RequestContext(context.Background(), method, params)
+}
+
+// RequestContext conforms to ContextAPI interface
+func (p *TokenPool) RequestContext(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	var lastErr error
+
+	for attempt := 0; attempt < len(p.tokens); attempt++ {
+		token := p.acquire()
+		if token == nil {
+			break
+		}
+
+		resp, err := RequestContext(ctx, token.api, method, params)
+		p.release(token, err)
+
+		if err == nil || !(IsAuth(err) || errors.Is(err, ErrRateLimit)) {
+			return resp, err
+		}
+
+		lastErr = err
+	}
+
+	if lastErr != nil {
+		return nil, &TokenPoolError{Err: lastErr}
+	}
+
+	return nil, ErrNoTokensAvailable
+}
+
+func (t *poolToken) available(now time.Time) bool {
+	return !t.stats.Benched && !now.Before(t.stats.CooldownUntil)
+}
+
+// acquire picks token for next request, or returns nil if there are none
+func (p *TokenPool) acquire() *poolToken {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+
+	var picked *poolToken
+
+	switch p.strategy {
+	case LeastLoaded:
+		for _, t := range p.tokens {
+			if !t.available(now) {
+				continue
+			}
+			if picked == nil || t.stats.InFlight < picked.stats.InFlight ||
+				(t.stats.InFlight == picked.stats.InFlight && t.stats.Requests < picked.stats.Requests) {
+				picked = t
+			}
+		}
+	default:
+		for i := 0; i < len(p.tokens); i++ {
+			t := p.tokens[(p.next+i)%len(p.tokens)]
+			if t.available(now) {
+				picked = t
+				p.next = (p.next + i + 1) % len(p.tokens)
+				break
+			}
+		}
+	}
+
+	if picked != nil {
+		picked.stats.InFlight++
+		picked.stats.Requests++
+	}
+
+	return picked
+}
+
+func (p *TokenPool) release(t *poolToken, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	t.stats.InFlight--
+
+	if err == nil {
+		return
+	}
+
+	t.stats.Errors++
+
+	switch {
+	case IsAuth(err):
+		t.stats.Benched = true
+	case errors.Is(err, ErrRateLimit):
+		t.stats.CooldownUntil = nextDay(p.now(), p.location)
+	}
+}
+
+// nextDay returns start of the day after now in loc
+func nextDay(now time.Time, loc *time.Location) time.Time {
+	y, m, d := now.In(loc).Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+}
+
+// Stats returns usage statistics of every token in pool,
+// in order they were passed to NewTokenPool
+func (p *TokenPool) Stats() []TokenStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := make([]TokenStats, len(p.tokens))
+	for i, t := range p.tokens {
+		stats[i] = t.stats
+	}
+
+	return stats
+}
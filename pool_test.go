@@ -0,0 +1,107 @@
+package vk
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newTestPool(t *testing.T, cfg TokenPoolConfig, handler func(token string) string) *TokenPool {
+	var apis []*BaseAPI
+	for _, token := range []string{"token-aaaa", "token-bbbb", "token-cccc"} {
+		api := newTestBaseAPI(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(handler(r.FormValue("access_token"))))
+		})
+		api.AccessToken = token
+		apis = append(apis, api)
+	}
+
+	pool, err := NewTokenPool(apis, cfg)
+	if err != nil {
+		t.Fatalf("Cant create pool: %v", err)
+	}
+
+	return pool
+}
+
+func TestTokenPoolRoundRobin(t *testing.T) {
+	used := map[string]int{}
+	pool := newTestPool(t, TokenPoolConfig{}, func(token string) string {
+		used[token]++
+		return `{"response":1}`
+	})
+
+	for i := 0; i < 6; i++ {
+		if _, err := pool.Request("users.get", nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	for token, n := range used {
+		if n != 2 {
+			t.Errorf("Expected token %v to be used 2 times, got %v", token, n)
+		}
+	}
+
+	stats := pool.Stats()
+	if stats[0].Token != "****aaaa" || stats[0].Requests != 2 {
+		t.Errorf("Unexpected stats: %+v", stats[0])
+	}
+}
+
+func TestTokenPoolBenchAndCooldown(t *testing.T) {
+	pool := newTestPool(t, TokenPoolConfig{}, func(token string) string {
+		switch token {
+		case "token-aaaa":
+			return `{"error":{"error_code":5,"error_msg":"User authorization failed"}}`
+		case "token-bbbb":
+			return `{"error":{"error_code":29,"error_msg":"Rate limit reached"}}`
+		default:
+			return `{"response":1}`
+		}
+	})
+
+	now := time.Date(2019, 1, 1, 12, 0, 0, 0, moscowTime)
+	pool.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if _, err := pool.Request("users.get", nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	stats := pool.Stats()
+	if !stats[0].Benched {
+		t.Errorf("Expected first token to be benched")
+	}
+	if expected := time.Date(2019, 1, 2, 0, 0, 0, 0, moscowTime); !stats[1].CooldownUntil.Equal(expected) {
+		t.Errorf("Expected second token to cool down until %v, got %v", expected, stats[1].CooldownUntil)
+	}
+	if stats[0].Requests != 1 || stats[1].Requests != 1 || stats[2].Requests != 3 {
+		t.Errorf("Unexpected request counts: %+v", stats)
+	}
+
+	now = now.Add(24 * time.Hour)
+	pool.Request("users.get", nil)
+	if stats := pool.Stats(); stats[1].Requests != 2 {
+		t.Errorf("Expected second token to be used after cooldown, got %+v", stats[1])
+	}
+}
+
+func TestTokenPoolExhausted(t *testing.T) {
+	pool := newTestPool(t, TokenPoolConfig{Strategy: LeastLoaded}, func(token string) string {
+		return `{"error":{"error_code":5,"error_msg":"User authorization failed"}}`
+	})
+
+	_, err := pool.Request("users.get", nil)
+	if !errors.Is(err, ErrNoTokensAvailable) {
+		t.Errorf("Expected ErrNoTokensAvailable, got %v", err)
+	}
+
+	// error of the last token isn't flattened to text
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != ErrorCodeAuthFailed || !errors.Is(err, ErrAuthFailed) {
+		t.Errorf("Expected APIError to be wrapped, got %v", err)
+	}
+}
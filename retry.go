@@ -0,0 +1,154 @@
+package vk
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMaxRetries     = 3
+	defaultRetryBaseDelay = 300 * time.Millisecond
+	defaultRetryMaxDelay  = 10 * time.Second
+)
+
+// readOnlyMethodPrefixes are prefixes of method names (without namespace)
+// which are assumed to not modify anything
+var readOnlyMethodPrefixes = []string{"get", "search", "is", "check", "resolve"}
+
+// RetryAPIConfig represents configuration used for RetryAPI creation
+type RetryAPIConfig struct {
+	// Optional: maximal number of retries, if 0, 3 is used
+	MaxRetries int
+	// Optional: maximal number of retries for specific methods,
+	// overrides MaxRetries; 0 disables retries for that method
+	MethodMaxRetries map[string]int
+	// Optional: delay before first retry, if 0, 300ms is used
+	BaseDelay time.Duration
+	// Optional: maximal delay between retries, if 0, 10s is used
+	MaxDelay time.Duration
+	// Optional: params which make retrying mutating methods safe,
+	// if nil, only random_id is used
+	IdempotencyKeys []string
+}
+
+// RetryAPI is an API which retries requests failed with transient errors
+// with jittered exponential backoff
+//
+// Transient errors are the ones IsTemporary reports.
+//
+// Methods which might modify something (i.e. not get*, search*, is*,
+// check* and resolve*) are only retried if they have one of IdempotencyKeys
+// set, since otherwise retry might perform action twice.
+type RetryAPI struct {
+	api API
+	cfg RetryAPIConfig
+}
+
+// NewRetryAPI creates a new RetryAPI which performs requests using api
+func NewRetryAPI(api API, cfg RetryAPIConfig) *RetryAPI {
+	if cfg.MaxRetries <= 0 {
+		cfg.MaxRetries = defaultMaxRetries
+	}
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = defaultRetryBaseDelay
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = defaultRetryMaxDelay
+	}
+	if cfg.IdempotencyKeys == nil {
+		cfg.IdempotencyKeys = []string{"random_id"}
+	}
+
+	return &RetryAPI{
+		api: api,
+		cfg: cfg,
+	}
+}
+
+// HTTPClient conforms to API interface
+func (r *RetryAPI) HTTPClient() *http.Client {
+	return r.api.HTTPClient()
+}
+
+// Request conforms to API interface
+func (r *RetryAPI) Request(method string, params interface{}) (json.RawMessage, error) {
+	return r.RequestContext(context.Background(), method, params)
+}
+
+// RequestContext conforms to ContextAPI interface
+func (r *RetryAPI) RequestContext(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	q, err := BuildRequestParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	maxRetries := r.maxRetries(method)
+	if !isReadOnlyMethod(method) && !r.hasIdempotencyKey(q) {
+		maxRetries = 0
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := RequestContext(ctx, r.api, method, q)
+		if err == nil || attempt >= maxRetries || !IsTemporary(err) || ctx.Err() != nil {
+			return resp, err
+		}
+
+		timer := time.NewTimer(r.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (r *RetryAPI) maxRetries(method string) int {
+	if n, ok := r.cfg.MethodMaxRetries[method]; ok {
+		return n
+	}
+	return r.cfg.MaxRetries
+}
+
+func (r *RetryAPI) hasIdempotencyKey(q url.Values) bool {
+	for _, key := range r.cfg.IdempotencyKeys {
+		if v := q[key]; len(v) != 0 && v[0] != "" && v[0] != "0" {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns jittered delay before retry number attempt
+func (r *RetryAPI) backoff(attempt int) time.Duration {
+	delay := r.cfg.MaxDelay
+	if attempt < 32 {
+		if d := r.cfg.BaseDelay << uint(attempt); d > 0 && d < delay {
+			delay = d
+		}
+	}
+
+	// random delay in [delay/2, delay)
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+func isReadOnlyMethod(method string) bool {
+	name := method
+	if idx := strings.IndexByte(method, '.'); idx != -1 {
+		name = method[idx+1:]
+	}
+
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,73 @@
+package vk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func newTestRetryAPI(failures int, err error, calls *int) *RetryAPI {
+	return NewRetryAPI(funcAPI(func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+		*calls++
+		if *calls <= failures {
+			return nil, err
+		}
+		return json.RawMessage("1"), nil
+	}), RetryAPIConfig{
+		BaseDelay:        time.Millisecond,
+		MaxDelay:         5 * time.Millisecond,
+		MethodMaxRetries: map[string]int{"users.search": 1},
+	})
+}
+
+func TestRetryAPI(t *testing.T) {
+	cases := []struct {
+		name      string
+		method    string
+		params    url.Values
+		failures  int
+		err       error
+		wantCalls int
+		wantErr   bool
+	}{
+		{"transient", "users.get", nil, 2, &APIError{Code: 6}, 3, false},
+		{"http5xx", "users.get", nil, 1, &HTTPError{StatusCode: 502}, 2, false},
+		{"exhausted", "users.get", nil, 10, &APIError{Code: 10}, 4, true},
+		{"permanent", "users.get", nil, 1, &APIError{Code: 5}, 1, true},
+		{"http4xx", "users.get", nil, 1, &HTTPError{StatusCode: 404}, 1, true},
+		{"per method", "users.search", nil, 10, &APIError{Code: 1}, 2, true},
+		{"mutating", "messages.send", nil, 1, &APIError{Code: 6}, 1, true},
+		{"idempotent", "messages.send", url.Values{"random_id": {"42"}}, 1, &APIError{Code: 6}, 2, false},
+	}
+
+	for _, tcase := range cases {
+		calls := 0
+		api := newTestRetryAPI(tcase.failures, tcase.err, &calls)
+
+		_, err := api.Request(tcase.method, tcase.params)
+
+		if (err != nil) != tcase.wantErr {
+			t.Errorf("%v: unexpected error: %v", tcase.name, err)
+		}
+		if calls != tcase.wantCalls {
+			t.Errorf("%v: expected %v calls, got %v", tcase.name, tcase.wantCalls, calls)
+		}
+	}
+}
+
+func TestRetryAPIContext(t *testing.T) {
+	calls := 0
+	api := newTestRetryAPI(10, &APIError{Code: 6}, &calls)
+	api.cfg.BaseDelay = time.Hour
+	api.cfg.MaxDelay = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := api.RequestContext(ctx, "users.get", nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded, got %v", err)
+	}
+}
@@ -0,0 +1,162 @@
+package vk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Scope is a bit mask of access permissions requested by application
+//
+// See https://vk.com/dev/permissions
+type Scope int
+
+// Scopes of user access tokens
+const (
+	ScopeNotify        Scope = 1 << 0
+	ScopeFriends       Scope = 1 << 1
+	ScopePhotos        Scope = 1 << 2
+	ScopeAudio         Scope = 1 << 3
+	ScopeVideo         Scope = 1 << 4
+	ScopeStories       Scope = 1 << 6
+	ScopePages         Scope = 1 << 7
+	ScopeMenu          Scope = 1 << 8
+	ScopeStatus        Scope = 1 << 10
+	ScopeNotes         Scope = 1 << 11
+	ScopeMessages      Scope = 1 << 12
+	ScopeWall          Scope = 1 << 13
+	ScopeAds           Scope = 1 << 15
+	ScopeOffline       Scope = 1 << 16
+	ScopeDocs          Scope = 1 << 17
+	ScopeGroups        Scope = 1 << 18
+	ScopeNotifications Scope = 1 << 19
+	ScopeStats         Scope = 1 << 20
+	ScopeEmail         Scope = 1 << 22
+	ScopeMarket        Scope = 1 << 27
+)
+
+// Scopes of community access tokens
+//
+// They share bits with user scopes, so Scope of community token
+// should be formatted with GroupString
+const (
+	GroupScopeStories   Scope = 1 << 0
+	GroupScopePhotos    Scope = 1 << 2
+	GroupScopeAppWidget Scope = 1 << 6
+	GroupScopeMessages  Scope = 1 << 12
+	GroupScopeDocs      Scope = 1 << 17
+	GroupScopeManage    Scope = 1 << 18
+)
+
+type scopeName struct {
+	scope Scope
+	name  string
+}
+
+var userScopeNames = []scopeName{
+	{ScopeNotify, "notify"},
+	{ScopeFriends, "friends"},
+	{ScopePhotos, "photos"},
+	{ScopeAudio, "audio"},
+	{ScopeVideo, "video"},
+	{ScopeStories, "stories"},
+	{ScopePages, "pages"},
+	{ScopeMenu, "menu"},
+	{ScopeStatus, "status"},
+	{ScopeNotes, "notes"},
+	{ScopeMessages, "messages"},
+	{ScopeWall, "wall"},
+	{ScopeAds, "ads"},
+	{ScopeOffline, "offline"},
+	{ScopeDocs, "docs"},
+	{ScopeGroups, "groups"},
+	{ScopeNotifications, "notifications"},
+	{ScopeStats, "stats"},
+	{ScopeEmail, "email"},
+	{ScopeMarket, "market"},
+}
+
+var groupScopeNames = []scopeName{
+	{GroupScopeStories, "stories"},
+	{GroupScopePhotos, "photos"},
+	{GroupScopeAppWidget, "app_widget"},
+	{GroupScopeMessages, "messages"},
+	{GroupScopeDocs, "docs"},
+	{GroupScopeManage, "manage"},
+}
+
+// Has checks if s contains every permission of other
+func (s Scope) Has(other Scope) bool {
+	return s&other == other
+}
+
+// String returns comma-separated names of user permissions in s,
+// unknown bits are appended as a number
+func (s Scope) String() string {
+	return s.format(userScopeNames)
+}
+
+// GroupString returns comma-separated names of community permissions in s,
+// unknown bits are appended as a number
+func (s Scope) GroupString() string {
+	return s.format(groupScopeNames)
+}
+
+func (s Scope) format(names []scopeName) string {
+	var parts []string
+	rest := s
+
+	for _, n := range names {
+		if s.Has(n.scope) {
+			parts = append(parts, n.name)
+			rest &^= n.scope
+		}
+	}
+
+	if rest != 0 {
+		parts = append(parts, strconv.Itoa(int(rest)))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// ParseScope parses user permissions, either as a bit mask ("4096"),
+// or as names separated with commas or spaces ("messages,offline")
+//
+// Numbers can be mixed with names, i.e. as returned by String
+func ParseScope(s string) (Scope, error) {
+	return parseScope(s, userScopeNames)
+}
+
+// ParseGroupScope parses community permissions, see ParseScope
+func ParseGroupScope(s string) (Scope, error) {
+	return parseScope(s, groupScopeNames)
+}
+
+func parseScope(s string, names []scopeName) (Scope, error) {
+	var scope Scope
+
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+
+outer:
+	for _, field := range fields {
+		if n, err := strconv.Atoi(field); err == nil {
+			scope |= Scope(n)
+			continue
+		}
+
+		field = strings.ToLower(field)
+		for _, n := range names {
+			if n.name == field {
+				scope |= n.scope
+				continue outer
+			}
+		}
+
+		return 0, fmt.Errorf("vk: unknown scope %q", field)
+	}
+
+	return scope, nil
+}
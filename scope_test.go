@@ -0,0 +1,148 @@
+package vk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestScopeString(t *testing.T) {
+	if s := (ScopeMessages | ScopeOffline | 1<<30).String(); s != "messages,offline,1073741824" {
+		t.Errorf("Unexpected user scope string: %q", s)
+	}
+
+	if s := (GroupScopeMessages | GroupScopeManage).GroupString(); s != "messages,manage" {
+		t.Errorf("Unexpected group scope string: %q", s)
+	}
+}
+
+func TestParseScope(t *testing.T) {
+	tests := []struct {
+		in    string
+		group bool
+		scope Scope
+	}{
+		{"", false, 0},
+		{"4096", false, ScopeMessages},
+		{"messages,offline", false, ScopeMessages | ScopeOffline},
+		{"Wall friends 2", false, ScopeWall | ScopeFriends},
+		{"manage, photos", true, GroupScopeManage | GroupScopePhotos},
+	}
+
+	for _, tt := range tests {
+		parse := ParseScope
+		if tt.group {
+			parse = ParseGroupScope
+		}
+
+		scope, err := parse(tt.in)
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %v", tt.in, err)
+		} else if scope != tt.scope {
+			t.Errorf("%q parsed as %v, expected %v", tt.in, scope, tt.scope)
+		}
+	}
+
+	if _, err := ParseScope("messages,manage"); err == nil {
+		t.Errorf("Expected error for group scope name parsed as user scope")
+	}
+
+	// String output can be parsed back
+	scope := ScopeDocs | ScopeEmail | 1<<29
+	if parsed, err := ParseScope(scope.String()); err != nil || parsed != scope {
+		t.Errorf("Roundtrip failed: %v, %v", parsed, err)
+	}
+}
+
+// tokenAPI returns API which responds to methods available to tokenType
+//
+// Like VK, it fails user and community methods called with service token
+// with ErrAuthFailed, and other unavailable methods with ErrPermissionDenied
+func tokenAPI(tokenType TokenType) API {
+	return funcAPI(func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+		switch {
+		case method == "secure.checkToken" && tokenType == ServiceToken:
+			return nil, &APIError{Code: ErrorCodeParam, Message: "One of the parameters specified was missing or invalid: token is undefined"}
+		case method == "groups.getTokenPermissions" && tokenType == GroupToken:
+			return json.RawMessage(`{"mask":266240,"permissions":[]}`), nil
+		case method == "groups.getById" && tokenType == GroupToken:
+			return json.RawMessage(`[{"id":1}]`), nil
+		case method == "account.getAppPermissions" && tokenType == UserToken:
+			return json.RawMessage(`69632`), nil
+		case method == "users.get" && tokenType == UserToken:
+			return json.RawMessage(`[{"id":42}]`), nil
+		case tokenType == ServiceToken:
+			return nil, &APIError{Code: ErrorCodeAuthFailed, Message: "User authorization failed: method is unavailable with service token."}
+		}
+		return nil, ErrPermissionDenied
+	})
+}
+
+func TestInspectToken(t *testing.T) {
+	tests := []struct {
+		tokenType TokenType
+		expected  TokenInfo
+	}{
+		{GroupToken, TokenInfo{Type: GroupToken, OwnerID: 1, Scope: GroupScopeMessages | GroupScopeManage}},
+		{UserToken, TokenInfo{Type: UserToken, OwnerID: 42, Scope: ScopeMessages | ScopeOffline}},
+		{ServiceToken, TokenInfo{Type: ServiceToken}},
+	}
+
+	for _, tt := range tests {
+		info, err := InspectToken(tokenAPI(tt.tokenType))
+		if err != nil {
+			t.Errorf("Unexpected error for %v token: %v", tt.tokenType, err)
+		} else if *info != tt.expected {
+			t.Errorf("Unexpected info for %v token: %+v", tt.tokenType, info)
+		}
+	}
+
+	if _, err := InspectToken(tokenAPI(UnknownToken)); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("Expected first error, got %v", err)
+	}
+
+	// user and community methods fail with ErrAuthFailed for service token,
+	// so it's recognized only if it's checked first
+	var methods []string
+	info, err := InspectToken(funcAPI(func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+		methods = append(methods, method)
+		return tokenAPI(ServiceToken).Request(method, params)
+	}))
+	if err != nil || info.Type != ServiceToken || len(methods) != 1 || methods[0] != "secure.checkToken" {
+		t.Errorf("Expected service token after secure.checkToken, got %v, %v after %v", info, err, methods)
+	}
+
+	// invalid token fails every method with ErrAuthFailed, which is returned
+	_, err = InspectToken(funcAPI(func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+		return nil, &APIError{Code: ErrorCodeAuthFailed, Message: "User authorization failed: invalid access_token"}
+	}))
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != ErrorCodeAuthFailed {
+		t.Errorf("Expected ErrAuthFailed, got %v", err)
+	}
+
+	// errors unrelated to token type aren't treated as wrong type
+	methods = nil
+	_, err = InspectToken(funcAPI(func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+		methods = append(methods, method)
+		return nil, ErrTooManyRequests
+	}))
+	if !errors.Is(err, ErrTooManyRequests) || len(methods) != 1 {
+		t.Errorf("Expected rate limit error after one request, got %v after %v", err, methods)
+	}
+}
+
+func TestTokenInfoRequire(t *testing.T) {
+	info := &TokenInfo{Type: GroupToken, Scope: GroupScopeMessages}
+
+	if err := info.Require(GroupScopeMessages); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := info.Require(GroupScopeMessages | GroupScopeDocs)
+	var scopeErr *MissingScopeError
+	if !errors.As(err, &scopeErr) || scopeErr.Missing != GroupScopeDocs {
+		t.Errorf("Expected missing docs, got %v", err)
+	}
+}
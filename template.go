@@ -0,0 +1,226 @@
+package vk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"unicode/utf8"
+)
+
+// TemplateCarousel is type of carousel template
+const TemplateCarousel = "carousel"
+
+// Possible TemplateAction types
+const (
+	TemplateActionOpenLink  = "open_link"
+	TemplateActionOpenPhoto = "open_photo"
+)
+
+// Carousel limits imposed by VK
+const (
+	MaxCarouselElements   = 10
+	MaxElementButtons     = 3
+	MaxElementTitle       = 80
+	MaxElementDescription = 80
+)
+
+// TemplateAction is action performed when carousel element is tapped
+type TemplateAction struct {
+	// Type is one of TemplateAction* constants
+	Type string `json:"type"`
+	// Link is URL opened by open_link action
+	Link string `json:"link,omitempty"`
+}
+
+// TemplateElement is element of carousel
+type TemplateElement struct {
+	// Title is required unless PhotoID is set
+	Title string `json:"title,omitempty"`
+	// Description is required unless PhotoID is set
+	Description string `json:"description,omitempty"`
+	// PhotoID is ID of photo, i.e. "-1_456239017", its aspect ratio should be 13:8
+	PhotoID string `json:"photo_id,omitempty"`
+	// Buttons are shown under element, at least one is required
+	Buttons []Button        `json:"buttons,omitempty"`
+	Action  *TemplateAction `json:"action,omitempty"`
+}
+
+// Template is message template, only carousel is supported by VK
+//
+// It can be built with AddElement, which starts a new element,
+// and Add* methods, which append buttons to the last one:
+//
+//	carousel := vk.NewCarousel().
+//		AddElement(vk.TemplateElement{Title: "Cat", Description: "Meows", PhotoID: "-1_2"}).
+//		AddCallback("Buy", map[string]int{"buy": 1}, vk.ButtonPositive).
+//		AddElement(vk.TemplateElement{Title: "Dog", Description: "Barks", PhotoID: "-1_3"}).
+//		AddCallback("Buy", map[string]int{"buy": 2}, vk.ButtonPositive)
+//
+// Template conforms to query.Encoder interface, and is validated when encoded
+type Template struct {
+	// Type is TemplateCarousel
+	Type     string            `json:"type"`
+	Elements []TemplateElement `json:"elements"`
+
+	// err is first error encountered while building template
+	err error
+}
+
+// NewCarousel creates a new empty carousel
+func NewCarousel() *Template {
+	return &Template{Type: TemplateCarousel}
+}
+
+// AddElement appends element to carousel
+func (t *Template) AddElement(e TemplateElement) *Template {
+	t.Elements = append(t.Elements, e)
+	return t
+}
+
+// Add appends button to the last element
+func (t *Template) Add(b Button) *Template {
+	if len(t.Elements) == 0 {
+		if t.err == nil {
+			t.err = errors.New("vk: button is added to template without elements")
+		}
+		return t
+	}
+
+	last := &t.Elements[len(t.Elements)-1]
+	last.Buttons = append(last.Buttons, b)
+	return t
+}
+
+// AddText appends text button to the last element, see Keyboard.AddText
+func (t *Template) AddText(label string, payload interface{}, color ButtonColor) *Template {
+	return t.add(ButtonAction{Type: ButtonActionText, Label: label}, payload, color)
+}
+
+// AddLink appends button which opens link to the last element
+func (t *Template) AddLink(label, link string, payload interface{}) *Template {
+	return t.add(ButtonAction{Type: ButtonActionOpenLink, Label: label, Link: link}, payload, "")
+}
+
+// AddCallback appends button which sends message_event to the last element
+func (t *Template) AddCallback(label string, payload interface{}, color ButtonColor) *Template {
+	return t.add(ButtonAction{Type: ButtonActionCallback, Label: label}, payload, color)
+}
+
+func (t *Template) add(action ButtonAction, payload interface{}, color ButtonColor) *Template {
+	b, err := newButton(action, payload, color)
+	if err != nil && t.err == nil {
+		t.err = err
+	}
+
+	return t.Add(b)
+}
+
+// Validate checks that template doesn't exceed VK limits
+//
+// Every element of carousel should have the same set of fields
+// and the same number of buttons
+func (t *Template) Validate() error {
+	if t.err != nil {
+		return t.err
+	}
+
+	if t.Type != TemplateCarousel {
+		return fmt.Errorf("vk: unknown template type %q", t.Type)
+	}
+
+	if len(t.Elements) == 0 || len(t.Elements) > MaxCarouselElements {
+		return fmt.Errorf("vk: carousel should have 1 to %d elements", MaxCarouselElements)
+	}
+
+	first := t.Elements[0]
+	for i, e := range t.Elements {
+		if err := e.validate(); err != nil {
+			return fmt.Errorf("vk: carousel element %d: %w", i, err)
+		}
+
+		if (e.Title == "") != (first.Title == "") ||
+			(e.Description == "") != (first.Description == "") ||
+			(e.PhotoID == "") != (first.PhotoID == "") ||
+			len(e.Buttons) != len(first.Buttons) {
+			return fmt.Errorf("vk: carousel element %d has different fields than element 0", i)
+		}
+	}
+
+	return nil
+}
+
+// ValidateFor checks that template doesn't exceed VK limits
+// and is supported by client
+func (t *Template) ValidateFor(client ClientInfo) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+
+	if !client.Carousel {
+		return errors.New("vk: client doesn't support carousels")
+	}
+
+	return nil
+}
+
+func (e TemplateElement) validate() error {
+	if e.PhotoID == "" && (e.Title == "" || e.Description == "") {
+		return errors.New("title and description are required without photo")
+	}
+
+	if utf8.RuneCountInString(e.Title) > MaxElementTitle {
+		return fmt.Errorf("title is longer than %d characters", MaxElementTitle)
+	}
+
+	if utf8.RuneCountInString(e.Description) > MaxElementDescription {
+		return fmt.Errorf("description is longer than %d characters", MaxElementDescription)
+	}
+
+	if len(e.Buttons) == 0 || len(e.Buttons) > MaxElementButtons {
+		return fmt.Errorf("element should have 1 to %d buttons", MaxElementButtons)
+	}
+
+	for i, b := range e.Buttons {
+		if b.Action.Type == ButtonActionLocation {
+			return fmt.Errorf("button %d: location buttons aren't supported", i)
+		}
+
+		// carousel buttons are shown one per row
+		if err := b.validate(1); err != nil {
+			return fmt.Errorf("button %d: %w", i, err)
+		}
+	}
+
+	if e.Action != nil {
+		switch e.Action.Type {
+		case TemplateActionOpenPhoto:
+			if e.PhotoID == "" {
+				return errors.New("open_photo action requires photo")
+			}
+		case TemplateActionOpenLink:
+			if e.Action.Link == "" {
+				return errors.New("open_link action requires link")
+			}
+		default:
+			return fmt.Errorf("unknown action type %q", e.Action.Type)
+		}
+	}
+
+	return nil
+}
+
+// EncodeValues conforms to query.Encoder interface
+func (t *Template) EncodeValues(key string, v *url.Values) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+
+	v.Set(key, string(encoded))
+	return nil
+}
@@ -0,0 +1,42 @@
+package vk
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTemplateValidate(t *testing.T) {
+	element := func(title string) TemplateElement {
+		return TemplateElement{Title: title, Description: "d", PhotoID: "-1_2", Action: &TemplateAction{Type: TemplateActionOpenPhoto}}
+	}
+
+	tooMany := NewCarousel()
+	for i := 0; i < 11; i++ {
+		tooMany.AddElement(element("e")).AddText("b", nil, "")
+	}
+
+	tests := []struct {
+		tmpl *Template
+		err  string
+	}{
+		{NewCarousel().AddElement(element("a")).AddText("b", nil, "").AddElement(element("c")).AddLink("d", "https://vk.com", nil), ""},
+		{NewCarousel(), "1 to 10 elements"},
+		{tooMany, "1 to 10 elements"},
+		{NewCarousel().AddText("b", nil, ""), "without elements"},
+		{NewCarousel().AddElement(element("a")), "1 to 3 buttons"},
+		{NewCarousel().AddElement(TemplateElement{Title: "a"}).AddText("b", nil, ""), "title and description are required"},
+		{NewCarousel().AddElement(element(strings.Repeat("a", 81))).AddText("b", nil, ""), "title is longer"},
+		{NewCarousel().AddElement(element("a")).AddText("b", nil, "").AddElement(element("c")).AddText("d", nil, "").AddText("e", nil, ""), "different fields"},
+		{NewCarousel().AddElement(TemplateElement{Title: "a", Description: "b", Action: &TemplateAction{Type: TemplateActionOpenPhoto}}).AddText("b", nil, ""), "requires photo"},
+		{NewCarousel().AddElement(element("a")).Add(Button{Action: ButtonAction{Type: ButtonActionLocation}}), "location buttons"},
+	}
+
+	for i, tt := range tests {
+		err := tt.tmpl.Validate()
+		if tt.err == "" && err != nil {
+			t.Errorf("%d: Unexpected error: %v", i, err)
+		} else if tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+			t.Errorf("%d: Expected error %q, got %v", i, tt.err, err)
+		}
+	}
+}
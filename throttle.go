@@ -0,0 +1,280 @@
+package vk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Request limits imposed by VK on a single access token
+const (
+	// UserTokenRequestsPerSecond is request limit for user tokens
+	UserTokenRequestsPerSecond = 3
+	// GroupTokenRequestsPerSecond is request limit for group tokens
+	GroupTokenRequestsPerSecond = 20
+)
+
+// ThrottledAPIConfig represents configuration used for ThrottledAPI creation
+type ThrottledAPIConfig struct {
+	// Optional: if 0, limit of queue already shared by other ThrottledAPIs
+	// of the same access token is used, or UserTokenRequestsPerSecond if there's none
+	RequestsPerSecond int
+}
+
+// ThrottledAPI is an API which paces requests made by BaseAPI
+// to avoid hitting "Too many requests per second" errors
+//
+// Requests are queued and performed in order they were made.
+// All ThrottledAPIs sharing the same access token share the same queue,
+// so it's safe to create several of them and use them concurrently.
+// Queue is paced to the lowest RequestsPerSecond of ThrottledAPIs using it,
+// since VK limits are imposed on token regardless of its users.
+//
+// Queue is released when every ThrottledAPI using it is closed
+// or garbage collected.
+type ThrottledAPI struct {
+	api     *BaseAPI
+	key     limiterKey
+	rps     int
+	limiter *rateLimiter
+
+	closeOnce sync.Once
+}
+
+// NewThrottledAPI creates a new ThrottledAPI wrapping api
+func NewThrottledAPI(api *BaseAPI, cfg ThrottledAPIConfig) *ThrottledAPI {
+	key := limiterKey(sha256.Sum256([]byte(api.AccessToken)))
+	limiter, rps := acquireLimiter(key, cfg.RequestsPerSecond)
+
+	t := &ThrottledAPI{
+		api:     api,
+		key:     key,
+		rps:     rps,
+		limiter: limiter,
+	}
+	runtime.SetFinalizer(t, (*ThrottledAPI).Close)
+
+	return t
+}
+
+// Close releases queue of t, t shouldn't be used after that
+func (t *ThrottledAPI) Close() error {
+	t.closeOnce.Do(func() {
+		releaseLimiter(t.key, t.rps)
+	})
+	return nil
+}
+
+// HTTPClient conforms to API interface
+func (t *ThrottledAPI) HTTPClient() *http.Client {
+	return t.api.HTTPClient()
+}
+
+// Request conforms to API interface
+func (t *ThrottledAPI) Request(method string, params interface{}) (json.RawMessage, error) {
+	return t.RequestContext(context.Background(), method, params)
+}
+
+// RequestContext conforms to ContextAPI interface
+//
+// If ctx is Done while request is waiting in queue, ctx.Err() is returned
+func (t *ThrottledAPI) RequestContext(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	if err := t.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return t.api.RequestContext(ctx, method, params)
+}
+
+// limiterKey identifies shared limiter, it's hash of access token,
+// so access tokens aren't kept in memory
+type limiterKey [sha256.Size]byte
+
+type limiterEntry struct {
+	limiter *rateLimiter
+	// refs is number of ThrottledAPIs using limiter with each rate
+	refs map[int]int
+}
+
+// minRate returns the lowest rate limiter is used with
+func (e *limiterEntry) minRate() int {
+	min := 0
+	for rps := range e.refs {
+		if min == 0 || rps < min {
+			min = rps
+		}
+	}
+	return min
+}
+
+var tokenLimiters = struct {
+	sync.Mutex
+	m map[limiterKey]*limiterEntry
+}{m: make(map[limiterKey]*limiterEntry)}
+
+// acquireLimiter returns limiter shared by all users of key,
+// paced to the lowest rps of them, and rps it was acquired with,
+// which is limit of limiter if rps is 0
+//
+// Limiter should be released with releaseLimiter
+func acquireLimiter(key limiterKey, rps int) (*rateLimiter, int) {
+	tokenLimiters.Lock()
+	defer tokenLimiters.Unlock()
+
+	e, ok := tokenLimiters.m[key]
+	if rps <= 0 {
+		rps = UserTokenRequestsPerSecond
+		if ok {
+			rps = e.minRate()
+		}
+	}
+
+	if !ok {
+		e = &limiterEntry{
+			limiter: newRateLimiter(rps, time.Second),
+			refs:    make(map[int]int),
+		}
+		tokenLimiters.m[key] = e
+	}
+	e.refs[rps]++
+	e.limiter.setLimit(e.minRate())
+
+	return e.limiter, rps
+}
+
+// releaseLimiter removes limiter of key once it has no users,
+// otherwise it's paced to the lowest rps of remaining ones
+func releaseLimiter(key limiterKey, rps int) {
+	tokenLimiters.Lock()
+	defer tokenLimiters.Unlock()
+
+	e, ok := tokenLimiters.m[key]
+	if !ok {
+		return
+	}
+
+	if e.refs[rps]--; e.refs[rps] <= 0 {
+		delete(e.refs, rps)
+	}
+
+	if len(e.refs) == 0 {
+		delete(tokenLimiters.m, key)
+	} else {
+		e.limiter.setLimit(e.minRate())
+	}
+}
+
+// rateLimiter allows at most len(slots) events per window
+type rateLimiter struct {
+	mu     sync.Mutex
+	window time.Duration
+	// slots is a ring of times at which last events were allowed
+	slots []time.Time
+	next  int
+	// freed are future slots given back by cancelled Waits, earliest first
+	freed []time.Time
+}
+
+func newRateLimiter(n int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		window: window,
+		slots:  make([]time.Time, n),
+	}
+}
+
+// setLimit changes number of events allowed per window,
+// keeping times of the latest ones
+func (l *rateLimiter) setLimit(n int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if n == len(l.slots) {
+		return
+	}
+
+	// ring starting with the oldest event
+	ordered := append(append([]time.Time(nil), l.slots[l.next:]...), l.slots[:l.next]...)
+
+	slots := make([]time.Time, n)
+	if len(ordered) > n {
+		copy(slots, ordered[len(ordered)-n:])
+	} else {
+		copy(slots[n-len(ordered):], ordered)
+	}
+
+	l.slots = slots
+	l.next = 0
+}
+
+// reserve reserves next slot and returns time at which it becomes available
+func (l *rateLimiter) reserve() time.Time {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	for len(l.freed) != 0 && l.freed[0].Before(now) {
+		l.freed = l.freed[1:]
+	}
+
+	at := l.slots[l.next].Add(l.window)
+	if at.Before(now) {
+		at = now
+	}
+
+	// slot given back is already accounted in ring
+	if len(l.freed) != 0 && !l.freed[0].After(at) {
+		at = l.freed[0]
+		l.freed = l.freed[1:]
+		return at
+	}
+
+	l.slots[l.next] = at
+	l.next = (l.next + 1) % len(l.slots)
+
+	return at
+}
+
+// cancel gives back slot reserved at given time
+func (l *rateLimiter) cancel(at time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	i := sort.Search(len(l.freed), func(i int) bool {
+		return l.freed[i].After(at)
+	})
+
+	l.freed = append(l.freed, time.Time{})
+	copy(l.freed[i+1:], l.freed[i:])
+	l.freed[i] = at
+}
+
+// Wait blocks until next event is allowed or ctx is Done
+//
+// Slot reserved by cancelled Wait is given back to limiter,
+// so it doesn't delay later events
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	at := l.reserve()
+	delay := time.Until(at)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancel(at)
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,120 @@
+package vk
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterPacing(t *testing.T) {
+	const window = 50 * time.Millisecond
+	l := newRateLimiter(2, window)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("Unexpected error from Wait: %v", err)
+		}
+	}
+
+	// 5 events with 2 per window need at least 2 full windows
+	if elapsed := time.Since(start); elapsed < 2*window {
+		t.Errorf("Expected at least %v to pass, got %v", 2*window, elapsed)
+	}
+}
+
+func TestRateLimiterContext(t *testing.T) {
+	l := newRateLimiter(1, time.Hour)
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Unexpected error from first Wait: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Expected DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestRateLimiterCancelGivesBackSlot(t *testing.T) {
+	l := newRateLimiter(1, time.Hour)
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Unexpected error from first Wait: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// reserve slot and cancel as if ctx was cancelled during Wait
+	l.cancel(l.reserve())
+
+	if err := l.Wait(ctx); err != context.Canceled {
+		t.Errorf("Expected Canceled, got %v", err)
+	}
+
+	// cancelled slot is reused instead of pushing next one to 2 hours
+	if at := l.reserve(); time.Until(at) > time.Hour {
+		t.Errorf("Expected slot within an hour, got %v", time.Until(at))
+	}
+}
+
+func TestThrottledAPIShared(t *testing.T) {
+	api, _ := NewBaseAPI(BaseAPIConfig{AccessToken: "test-shared-token"})
+
+	a := NewThrottledAPI(api, ThrottledAPIConfig{RequestsPerSecond: 20})
+	b := NewThrottledAPI(api, ThrottledAPIConfig{RequestsPerSecond: 20})
+
+	if a.limiter != b.limiter || len(a.limiter.slots) != 20 {
+		t.Errorf("Expected limiter to be shared between same tokens")
+	}
+
+	// requests of every user count against the same token limit,
+	// so the lowest one is used
+	c := NewThrottledAPI(api, ThrottledAPIConfig{RequestsPerSecond: 3})
+	if a.limiter != c.limiter || len(a.limiter.slots) != 3 {
+		t.Errorf("Expected shared limiter with the lowest limit, got %v", len(c.limiter.slots))
+	}
+
+	// unset limit joins queue with its current limit
+	d := NewThrottledAPI(api, ThrottledAPIConfig{})
+	if d.limiter != a.limiter || d.rps != 3 {
+		t.Errorf("Expected unset limit to join shared queue, got %v", d.rps)
+	}
+	d.Close()
+
+	c.Close()
+	if len(a.limiter.slots) != 20 {
+		t.Errorf("Expected limit to be restored once lower one is released, got %v", len(a.limiter.slots))
+	}
+
+	for _, api := range []*ThrottledAPI{a, b, a} {
+		api.Close()
+	}
+
+	tokenLimiters.Lock()
+	defer tokenLimiters.Unlock()
+	if _, ok := tokenLimiters.m[limiterKey(sha256.Sum256([]byte("test-shared-token")))]; ok {
+		t.Errorf("Expected limiter to be released after Close")
+	}
+}
+
+func TestRateLimiterSetLimit(t *testing.T) {
+	l := newRateLimiter(3, time.Hour)
+	for i := 0; i < 3; i++ {
+		l.reserve()
+	}
+
+	// the latest events are kept, so lower limit is reached at once
+	l.setLimit(2)
+	if at := l.reserve(); time.Until(at) < 50*time.Minute {
+		t.Errorf("Expected lower limit to be reached, got slot in %v", time.Until(at))
+	}
+
+	l.setLimit(5)
+	if at := l.reserve(); time.Until(at) > time.Second {
+		t.Errorf("Expected free slot after limit is raised, got slot in %v", time.Until(at))
+	}
+}
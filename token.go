@@ -0,0 +1,246 @@
+package vk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// TokenType is type of access token
+type TokenType int
+
+// Possible TokenType values
+const (
+	UnknownToken TokenType = iota
+	// UserToken is token of user
+	UserToken
+	// GroupToken is token of community
+	GroupToken
+	// ServiceToken is token of application, obtained
+	// with client credentials flow
+	ServiceToken
+)
+
+// String returns name of token type
+func (t TokenType) String() string {
+	switch t {
+	case UserToken:
+		return "user"
+	case GroupToken:
+		return "group"
+	case ServiceToken:
+		return "service"
+	default:
+		return "unknown"
+	}
+}
+
+// RequestsPerSecond returns request limit imposed by VK on tokens of type t
+func (t TokenType) RequestsPerSecond() int {
+	if t == GroupToken {
+		return GroupTokenRequestsPerSecond
+	}
+	return UserTokenRequestsPerSecond
+}
+
+// TokenTyper is implemented by APIs which know type
+// of access token they use, i.e. vkbot.Bot
+type TokenTyper interface {
+	TokenType() TokenType
+}
+
+// TokenInfo represents access token inspected with InspectToken
+type TokenInfo struct {
+	Type TokenType
+	// OwnerID is ID of user for UserToken, ID of community for GroupToken,
+	// and 0 for ServiceToken
+	OwnerID int
+	// Scope is permissions granted to token, see GroupScope* for GroupToken
+	Scope Scope
+}
+
+// String returns human-readable description of token
+func (i *TokenInfo) String() string {
+	switch i.Type {
+	case GroupToken:
+		return fmt.Sprintf("group token of club%d [%v]", i.OwnerID, i.Scope.GroupString())
+	case UserToken:
+		return fmt.Sprintf("user token of id%d [%v]", i.OwnerID, i.Scope)
+	default:
+		return fmt.Sprintf("%v token", i.Type)
+	}
+}
+
+// MissingScopeError is returned by TokenInfo.Require when
+// token lacks some of required permissions
+type MissingScopeError struct {
+	Type    TokenType
+	Missing Scope
+}
+
+// Error implements error interface
+func (e *MissingScopeError) Error() string {
+	missing := e.Missing.String()
+	if e.Type == GroupToken {
+		missing = e.Missing.GroupString()
+	}
+	return fmt.Sprintf("vk.MissingScopeError: %v token lacks permissions: %v", e.Type, missing)
+}
+
+// Require returns *MissingScopeError if token lacks some of permissions
+// in scope, so bots can fail fast at startup
+func (i *TokenInfo) Require(scope Scope) error {
+	if missing := scope &^ i.Scope; missing != 0 {
+		return &MissingScopeError{
+			Type:    i.Type,
+			Missing: missing,
+		}
+	}
+	return nil
+}
+
+// InspectToken is InspectTokenContext with context.Background()
+func InspectToken(api API) (*TokenInfo, error) {
+	return InspectTokenContext(context.Background(), api)
+}
+
+// InspectTokenContext determines type, owner and permissions
+// of access token used by api
+//
+// Since VK has no method to do so, it tries secure.checkToken,
+// groups.getTokenPermissions and account.getAppPermissions, which are available
+// to service, community and user tokens respectively.
+// Only errors meaning that method is unavailable to token (i.e. ErrAccessDenied,
+// or ErrAuthFailed VK returns for service tokens) move on to the next method,
+// other errors are returned as is.
+// If every method is unavailable, the first error is returned.
+func InspectTokenContext(ctx context.Context, api API) (*TokenInfo, error) {
+	inspectors := []func(context.Context, API) (*TokenInfo, error){
+		inspectServiceToken,
+		inspectGroupToken,
+		inspectUserToken,
+	}
+
+	var firstErr error
+	for _, inspect := range inspectors {
+		info, err := inspect(ctx, api)
+		if err == nil {
+			return info, nil
+		}
+
+		if !isWrongTokenType(err) {
+			return nil, err
+		}
+
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return nil, firstErr
+}
+
+// isWrongTokenType checks if err is returned because method
+// is unavailable for this type of token, other errors, i.e.
+// rate limits or internal errors, say nothing about token type
+//
+// VK answers user and community methods called with service token
+// with ErrAuthFailed, so it's treated as wrong type too. If token is invalid,
+// every method fails with it, and it's returned by InspectTokenContext.
+func isWrongTokenType(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	switch apiErr.Code {
+	case ErrorCodeAuthFailed, ErrorCodePermissionDenied, ErrorCodeAccessDenied,
+		ErrorCodeGroupTokenInvalid, ErrorCodeAppTokenInvalid:
+		return true
+	}
+
+	return false
+}
+
+func inspectGroupToken(ctx context.Context, api API) (*TokenInfo, error) {
+	r, err := RequestContext(ctx, api, "groups.getTokenPermissions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var perms struct {
+		Mask int `json:"mask"`
+	}
+	if err := json.Unmarshal(r, &perms); err != nil {
+		return nil, err
+	}
+
+	r, err = RequestContext(ctx, api, "groups.getById", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []struct {
+		ID int `json:"id"`
+	}
+	if err := json.Unmarshal(r, &groups); err != nil {
+		return nil, err
+	}
+	if len(groups) != 1 {
+		return nil, errors.New("vk: VK did not return group of token")
+	}
+
+	return &TokenInfo{
+		Type:    GroupToken,
+		OwnerID: groups[0].ID,
+		Scope:   Scope(perms.Mask),
+	}, nil
+}
+
+func inspectUserToken(ctx context.Context, api API) (*TokenInfo, error) {
+	r, err := RequestContext(ctx, api, "account.getAppPermissions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var mask int
+	if err := json.Unmarshal(r, &mask); err != nil {
+		return nil, err
+	}
+
+	r, err = RequestContext(ctx, api, "users.get", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []struct {
+		ID int `json:"id"`
+	}
+	if err := json.Unmarshal(r, &users); err != nil {
+		return nil, err
+	}
+	if len(users) != 1 {
+		return nil, errors.New("vk: VK did not return user of token")
+	}
+
+	return &TokenInfo{
+		Type:    UserToken,
+		OwnerID: users[0].ID,
+		Scope:   Scope(mask),
+	}, nil
+}
+
+func inspectServiceToken(ctx context.Context, api API) (*TokenInfo, error) {
+	// secure methods are available only to service tokens, and VK
+	// checks params only once token is allowed to call method,
+	// so missing token param means that token is service one
+	_, err := RequestContext(ctx, api, "secure.checkToken", nil)
+
+	var apiErr *APIError
+	if err != nil && !(errors.As(err, &apiErr) && apiErr.Code == ErrorCodeParam) {
+		return nil, err
+	}
+
+	return &TokenInfo{Type: ServiceToken}, nil
+}
@@ -433,6 +433,8 @@ type Message struct {
 	// TODO: Geo
 	Payload           string    `json:"payload"`
 	ForwardedMessages []Message `json:"fwd_messages"`
+	// Template is carousel sent by bot, if any
+	Template *Template `json:"template"`
 
 	// TODO: Action types
 	Action *struct {
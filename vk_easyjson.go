@@ -7946,6 +7946,16 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk41(in *jlexer.Lexer, out *MessageRep
 				}
 				in.Delim(']')
 			}
+		case "template":
+			if in.IsNull() {
+				in.Skip()
+				out.Template = nil
+			} else {
+				if out.Template == nil {
+					out.Template = new(Template)
+				}
+				easyjsonC7452bc1DecodeGithubComStek29Vk42(in, out.Template)
+			}
 		case "action":
 			if in.IsNull() {
 				in.Skip()
@@ -8057,6 +8067,15 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk41(out *jwriter.Writer, in MessageRe
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"template\":"
+		out.RawString(prefix)
+		if in.Template == nil {
+			out.RawString("null")
+		} else {
+			easyjsonC7452bc1EncodeGithubComStek29Vk42(out, *in.Template)
+		}
+	}
 	{
 		const prefix string = ",\"action\":"
 		out.RawString(prefix)
@@ -8246,7 +8265,399 @@ func easyjsonC7452bc1Encode12(out *jwriter.Writer, in struct {
 	}
 	out.RawByte('}')
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk42(in *jlexer.Lexer, out *MessageNew) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk42(in *jlexer.Lexer, out *Template) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "elements":
+			if in.IsNull() {
+				in.Skip()
+				out.Elements = nil
+			} else {
+				in.Delim('[')
+				if out.Elements == nil {
+					if !in.IsDelim(']') {
+						out.Elements = make([]TemplateElement, 0, 0)
+					} else {
+						out.Elements = []TemplateElement{}
+					}
+				} else {
+					out.Elements = (out.Elements)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v85 TemplateElement
+					easyjsonC7452bc1DecodeGithubComStek29Vk43(in, &v85)
+					out.Elements = append(out.Elements, v85)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC7452bc1EncodeGithubComStek29Vk42(out *jwriter.Writer, in Template) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"elements\":"
+		out.RawString(prefix)
+		if in.Elements == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v86, v87 := range in.Elements {
+				if v86 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonC7452bc1EncodeGithubComStek29Vk43(out, v87)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonC7452bc1DecodeGithubComStek29Vk43(in *jlexer.Lexer, out *TemplateElement) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "photo_id":
+			out.PhotoID = string(in.String())
+		case "buttons":
+			if in.IsNull() {
+				in.Skip()
+				out.Buttons = nil
+			} else {
+				in.Delim('[')
+				if out.Buttons == nil {
+					if !in.IsDelim(']') {
+						out.Buttons = make([]Button, 0, 0)
+					} else {
+						out.Buttons = []Button{}
+					}
+				} else {
+					out.Buttons = (out.Buttons)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v88 Button
+					easyjsonC7452bc1DecodeGithubComStek29Vk44(in, &v88)
+					out.Buttons = append(out.Buttons, v88)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "action":
+			if in.IsNull() {
+				in.Skip()
+				out.Action = nil
+			} else {
+				if out.Action == nil {
+					out.Action = new(TemplateAction)
+				}
+				easyjsonC7452bc1DecodeGithubComStek29Vk45(in, out.Action)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC7452bc1EncodeGithubComStek29Vk43(out *jwriter.Writer, in TemplateElement) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Title != "" {
+		const prefix string = ",\"title\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Description))
+	}
+	if in.PhotoID != "" {
+		const prefix string = ",\"photo_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.PhotoID))
+	}
+	if len(in.Buttons) != 0 {
+		const prefix string = ",\"buttons\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v89, v90 := range in.Buttons {
+				if v89 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonC7452bc1EncodeGithubComStek29Vk44(out, v90)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Action != nil {
+		const prefix string = ",\"action\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjsonC7452bc1EncodeGithubComStek29Vk45(out, *in.Action)
+	}
+	out.RawByte('}')
+}
+func easyjsonC7452bc1DecodeGithubComStek29Vk45(in *jlexer.Lexer, out *TemplateAction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "link":
+			out.Link = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC7452bc1EncodeGithubComStek29Vk45(out *jwriter.Writer, in TemplateAction) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	if in.Link != "" {
+		const prefix string = ",\"link\":"
+		out.RawString(prefix)
+		out.String(string(in.Link))
+	}
+	out.RawByte('}')
+}
+func easyjsonC7452bc1DecodeGithubComStek29Vk44(in *jlexer.Lexer, out *Button) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "action":
+			easyjsonC7452bc1DecodeGithubComStek29Vk46(in, &out.Action)
+		case "color":
+			out.Color = ButtonColor(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC7452bc1EncodeGithubComStek29Vk44(out *jwriter.Writer, in Button) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix[1:])
+		easyjsonC7452bc1EncodeGithubComStek29Vk46(out, in.Action)
+	}
+	if in.Color != "" {
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		out.String(string(in.Color))
+	}
+	out.RawByte('}')
+}
+func easyjsonC7452bc1DecodeGithubComStek29Vk46(in *jlexer.Lexer, out *ButtonAction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "label":
+			out.Label = string(in.String())
+		case "payload":
+			out.Payload = string(in.String())
+		case "link":
+			out.Link = string(in.String())
+		case "hash":
+			out.Hash = string(in.String())
+		case "app_id":
+			out.AppID = int(in.Int())
+		case "owner_id":
+			out.OwnerID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC7452bc1EncodeGithubComStek29Vk46(out *jwriter.Writer, in ButtonAction) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	if in.Label != "" {
+		const prefix string = ",\"label\":"
+		out.RawString(prefix)
+		out.String(string(in.Label))
+	}
+	if in.Payload != "" {
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		out.String(string(in.Payload))
+	}
+	if in.Link != "" {
+		const prefix string = ",\"link\":"
+		out.RawString(prefix)
+		out.String(string(in.Link))
+	}
+	if in.Hash != "" {
+		const prefix string = ",\"hash\":"
+		out.RawString(prefix)
+		out.String(string(in.Hash))
+	}
+	if in.AppID != 0 {
+		const prefix string = ",\"app_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.AppID))
+	}
+	if in.OwnerID != 0 {
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.OwnerID))
+	}
+	out.RawByte('}')
+}
+func easyjsonC7452bc1DecodeGithubComStek29Vk47(in *jlexer.Lexer, out *MessageNew) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8295,11 +8706,11 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk42(in *jlexer.Lexer, out *MessageNew
 					out.Attachments = (out.Attachments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v85 Attachment
+					var v91 Attachment
 					if data := in.Raw(); in.Ok() {
-						in.AddError((v85).UnmarshalJSON(data))
+						in.AddError((v91).UnmarshalJSON(data))
 					}
-					out.Attachments = append(out.Attachments, v85)
+					out.Attachments = append(out.Attachments, v91)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -8324,13 +8735,23 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk42(in *jlexer.Lexer, out *MessageNew
 					out.ForwardedMessages = (out.ForwardedMessages)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v86 Message
-					(v86).UnmarshalEasyJSON(in)
-					out.ForwardedMessages = append(out.ForwardedMessages, v86)
+					var v92 Message
+					(v92).UnmarshalEasyJSON(in)
+					out.ForwardedMessages = append(out.ForwardedMessages, v92)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "template":
+			if in.IsNull() {
+				in.Skip()
+				out.Template = nil
+			} else {
+				if out.Template == nil {
+					out.Template = new(Template)
+				}
+				easyjsonC7452bc1DecodeGithubComStek29Vk42(in, out.Template)
+			}
 		case "action":
 			if in.IsNull() {
 				in.Skip()
@@ -8361,7 +8782,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk42(in *jlexer.Lexer, out *MessageNew
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk42(out *jwriter.Writer, in MessageNew) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk47(out *jwriter.Writer, in MessageNew) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -8407,11 +8828,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk42(out *jwriter.Writer, in MessageNe
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v87, v88 := range in.Attachments {
-				if v87 > 0 {
+			for v93, v94 := range in.Attachments {
+				if v93 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v88)
+				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v94)
 			}
 			out.RawByte(']')
 		}
@@ -8433,15 +8854,24 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk42(out *jwriter.Writer, in MessageNe
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v89, v90 := range in.ForwardedMessages {
-				if v89 > 0 {
+			for v95, v96 := range in.ForwardedMessages {
+				if v95 > 0 {
 					out.RawByte(',')
 				}
-				(v90).MarshalEasyJSON(out)
+				(v96).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"template\":"
+		out.RawString(prefix)
+		if in.Template == nil {
+			out.RawString("null")
+		} else {
+			easyjsonC7452bc1EncodeGithubComStek29Vk42(out, *in.Template)
+		}
+	}
 	{
 		const prefix string = ",\"action\":"
 		out.RawString(prefix)
@@ -8457,27 +8887,123 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk42(out *jwriter.Writer, in MessageNe
 // MarshalJSON supports json.Marshaler interface
 func (v MessageNew) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk42(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk47(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MessageNew) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk42(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk47(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MessageNew) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk42(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk47(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MessageNew) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk42(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk47(l, v)
+}
+func easyjsonC7452bc1DecodeGithubComStek29Vk48(in *jlexer.Lexer, out *MessageEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "peer_id":
+			out.PeerID = int(in.Int())
+		case "event_id":
+			out.EventID = string(in.String())
+		case "payload":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Payload).UnmarshalJSON(data))
+			}
+		case "conversation_message_id":
+			out.ConversationMessageID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk43(in *jlexer.Lexer, out *MessageEdit) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk48(out *jwriter.Writer, in MessageEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"peer_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.PeerID))
+	}
+	{
+		const prefix string = ",\"event_id\":"
+		out.RawString(prefix)
+		out.String(string(in.EventID))
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		out.Raw((in.Payload).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"conversation_message_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ConversationMessageID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MessageEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC7452bc1EncodeGithubComStek29Vk48(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MessageEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC7452bc1EncodeGithubComStek29Vk48(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MessageEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC7452bc1DecodeGithubComStek29Vk48(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MessageEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC7452bc1DecodeGithubComStek29Vk48(l, v)
+}
+func easyjsonC7452bc1DecodeGithubComStek29Vk49(in *jlexer.Lexer, out *MessageEdit) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8526,11 +9052,11 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk43(in *jlexer.Lexer, out *MessageEdi
 					out.Attachments = (out.Attachments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v91 Attachment
+					var v97 Attachment
 					if data := in.Raw(); in.Ok() {
-						in.AddError((v91).UnmarshalJSON(data))
+						in.AddError((v97).UnmarshalJSON(data))
 					}
-					out.Attachments = append(out.Attachments, v91)
+					out.Attachments = append(out.Attachments, v97)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -8555,13 +9081,23 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk43(in *jlexer.Lexer, out *MessageEdi
 					out.ForwardedMessages = (out.ForwardedMessages)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v92 Message
-					(v92).UnmarshalEasyJSON(in)
-					out.ForwardedMessages = append(out.ForwardedMessages, v92)
+					var v98 Message
+					(v98).UnmarshalEasyJSON(in)
+					out.ForwardedMessages = append(out.ForwardedMessages, v98)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "template":
+			if in.IsNull() {
+				in.Skip()
+				out.Template = nil
+			} else {
+				if out.Template == nil {
+					out.Template = new(Template)
+				}
+				easyjsonC7452bc1DecodeGithubComStek29Vk42(in, out.Template)
+			}
 		case "action":
 			if in.IsNull() {
 				in.Skip()
@@ -8592,7 +9128,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk43(in *jlexer.Lexer, out *MessageEdi
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk43(out *jwriter.Writer, in MessageEdit) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk49(out *jwriter.Writer, in MessageEdit) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -8638,11 +9174,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk43(out *jwriter.Writer, in MessageEd
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v93, v94 := range in.Attachments {
-				if v93 > 0 {
+			for v99, v100 := range in.Attachments {
+				if v99 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v94)
+				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v100)
 			}
 			out.RawByte(']')
 		}
@@ -8664,15 +9200,24 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk43(out *jwriter.Writer, in MessageEd
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v95, v96 := range in.ForwardedMessages {
-				if v95 > 0 {
+			for v101, v102 := range in.ForwardedMessages {
+				if v101 > 0 {
 					out.RawByte(',')
 				}
-				(v96).MarshalEasyJSON(out)
+				(v102).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"template\":"
+		out.RawString(prefix)
+		if in.Template == nil {
+			out.RawString("null")
+		} else {
+			easyjsonC7452bc1EncodeGithubComStek29Vk42(out, *in.Template)
+		}
+	}
 	{
 		const prefix string = ",\"action\":"
 		out.RawString(prefix)
@@ -8688,27 +9233,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk43(out *jwriter.Writer, in MessageEd
 // MarshalJSON supports json.Marshaler interface
 func (v MessageEdit) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk43(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk49(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MessageEdit) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk43(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk49(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MessageEdit) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk43(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk49(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MessageEdit) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk43(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk49(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk44(in *jlexer.Lexer, out *MessageDeny) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk50(in *jlexer.Lexer, out *MessageDeny) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8739,7 +9284,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk44(in *jlexer.Lexer, out *MessageDen
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk44(out *jwriter.Writer, in MessageDeny) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk50(out *jwriter.Writer, in MessageDeny) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -8754,27 +9299,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk44(out *jwriter.Writer, in MessageDe
 // MarshalJSON supports json.Marshaler interface
 func (v MessageDeny) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk44(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk50(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MessageDeny) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk44(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk50(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MessageDeny) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk44(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk50(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MessageDeny) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk44(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk50(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk45(in *jlexer.Lexer, out *MessageAllow) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk51(in *jlexer.Lexer, out *MessageAllow) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8807,7 +9352,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk45(in *jlexer.Lexer, out *MessageAll
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk45(out *jwriter.Writer, in MessageAllow) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk51(out *jwriter.Writer, in MessageAllow) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -8827,27 +9372,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk45(out *jwriter.Writer, in MessageAl
 // MarshalJSON supports json.Marshaler interface
 func (v MessageAllow) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk45(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk51(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MessageAllow) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk45(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk51(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MessageAllow) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk45(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk51(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MessageAllow) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk45(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk51(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk46(in *jlexer.Lexer, out *Message) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk52(in *jlexer.Lexer, out *Message) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -8896,11 +9441,11 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk46(in *jlexer.Lexer, out *Message) {
 					out.Attachments = (out.Attachments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v97 Attachment
+					var v103 Attachment
 					if data := in.Raw(); in.Ok() {
-						in.AddError((v97).UnmarshalJSON(data))
+						in.AddError((v103).UnmarshalJSON(data))
 					}
-					out.Attachments = append(out.Attachments, v97)
+					out.Attachments = append(out.Attachments, v103)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -8925,13 +9470,23 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk46(in *jlexer.Lexer, out *Message) {
 					out.ForwardedMessages = (out.ForwardedMessages)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v98 Message
-					(v98).UnmarshalEasyJSON(in)
-					out.ForwardedMessages = append(out.ForwardedMessages, v98)
+					var v104 Message
+					(v104).UnmarshalEasyJSON(in)
+					out.ForwardedMessages = append(out.ForwardedMessages, v104)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "template":
+			if in.IsNull() {
+				in.Skip()
+				out.Template = nil
+			} else {
+				if out.Template == nil {
+					out.Template = new(Template)
+				}
+				easyjsonC7452bc1DecodeGithubComStek29Vk42(in, out.Template)
+			}
 		case "action":
 			if in.IsNull() {
 				in.Skip()
@@ -8962,7 +9517,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk46(in *jlexer.Lexer, out *Message) {
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk46(out *jwriter.Writer, in Message) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk52(out *jwriter.Writer, in Message) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9008,11 +9563,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk46(out *jwriter.Writer, in Message)
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v99, v100 := range in.Attachments {
-				if v99 > 0 {
+			for v105, v106 := range in.Attachments {
+				if v105 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v100)
+				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v106)
 			}
 			out.RawByte(']')
 		}
@@ -9034,15 +9589,24 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk46(out *jwriter.Writer, in Message)
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v101, v102 := range in.ForwardedMessages {
-				if v101 > 0 {
+			for v107, v108 := range in.ForwardedMessages {
+				if v107 > 0 {
 					out.RawByte(',')
 				}
-				(v102).MarshalEasyJSON(out)
+				(v108).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"template\":"
+		out.RawString(prefix)
+		if in.Template == nil {
+			out.RawString("null")
+		} else {
+			easyjsonC7452bc1EncodeGithubComStek29Vk42(out, *in.Template)
+		}
+	}
 	{
 		const prefix string = ",\"action\":"
 		out.RawString(prefix)
@@ -9058,27 +9622,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk46(out *jwriter.Writer, in Message)
 // MarshalJSON supports json.Marshaler interface
 func (v Message) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk46(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk52(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Message) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk46(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk52(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Message) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk46(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk52(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Message) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk46(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk52(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk47(in *jlexer.Lexer, out *MarketItem) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk53(in *jlexer.Lexer, out *MarketItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9131,9 +9695,9 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk47(in *jlexer.Lexer, out *MarketItem
 					out.Photos = (out.Photos)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v103 Photo
-					(v103).UnmarshalEasyJSON(in)
-					out.Photos = append(out.Photos, v103)
+					var v109 Photo
+					(v109).UnmarshalEasyJSON(in)
+					out.Photos = append(out.Photos, v109)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -9154,7 +9718,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk47(in *jlexer.Lexer, out *MarketItem
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk47(out *jwriter.Writer, in MarketItem) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk53(out *jwriter.Writer, in MarketItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9210,11 +9774,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk47(out *jwriter.Writer, in MarketIte
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v104, v105 := range in.Photos {
-				if v104 > 0 {
+			for v110, v111 := range in.Photos {
+				if v110 > 0 {
 					out.RawByte(',')
 				}
-				(v105).MarshalEasyJSON(out)
+				(v111).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -9240,25 +9804,25 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk47(out *jwriter.Writer, in MarketIte
 // MarshalJSON supports json.Marshaler interface
 func (v MarketItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk47(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk53(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarketItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk47(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk53(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarketItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk47(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk53(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarketItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk47(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk53(l, v)
 }
 func easyjsonC7452bc1Decode13(in *jlexer.Lexer, out *struct {
 	Amount   int `json:"amount"`
@@ -9385,7 +9949,7 @@ func easyjsonC7452bc1Encode14(out *jwriter.Writer, in struct {
 	}
 	out.RawByte('}')
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk48(in *jlexer.Lexer, out *MarketCommentRestore) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk54(in *jlexer.Lexer, out *MarketCommentRestore) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9436,11 +10000,11 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk48(in *jlexer.Lexer, out *MarketComm
 					out.Attachments = (out.Attachments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v106 Attachment
+					var v112 Attachment
 					if data := in.Raw(); in.Ok() {
-						in.AddError((v106).UnmarshalJSON(data))
+						in.AddError((v112).UnmarshalJSON(data))
 					}
-					out.Attachments = append(out.Attachments, v106)
+					out.Attachments = append(out.Attachments, v112)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -9455,7 +10019,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk48(in *jlexer.Lexer, out *MarketComm
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk48(out *jwriter.Writer, in MarketCommentRestore) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk54(out *jwriter.Writer, in MarketCommentRestore) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9506,11 +10070,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk48(out *jwriter.Writer, in MarketCom
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v107, v108 := range in.Attachments {
-				if v107 > 0 {
+			for v113, v114 := range in.Attachments {
+				if v113 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v108)
+				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v114)
 			}
 			out.RawByte(']')
 		}
@@ -9521,27 +10085,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk48(out *jwriter.Writer, in MarketCom
 // MarshalJSON supports json.Marshaler interface
 func (v MarketCommentRestore) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk48(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk54(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarketCommentRestore) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk48(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk54(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarketCommentRestore) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk48(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk54(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarketCommentRestore) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk48(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk54(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk49(in *jlexer.Lexer, out *MarketCommentNew) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk55(in *jlexer.Lexer, out *MarketCommentNew) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9592,11 +10156,11 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk49(in *jlexer.Lexer, out *MarketComm
 					out.Attachments = (out.Attachments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v109 Attachment
+					var v115 Attachment
 					if data := in.Raw(); in.Ok() {
-						in.AddError((v109).UnmarshalJSON(data))
+						in.AddError((v115).UnmarshalJSON(data))
 					}
-					out.Attachments = append(out.Attachments, v109)
+					out.Attachments = append(out.Attachments, v115)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -9611,7 +10175,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk49(in *jlexer.Lexer, out *MarketComm
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk49(out *jwriter.Writer, in MarketCommentNew) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk55(out *jwriter.Writer, in MarketCommentNew) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9662,11 +10226,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk49(out *jwriter.Writer, in MarketCom
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v110, v111 := range in.Attachments {
-				if v110 > 0 {
+			for v116, v117 := range in.Attachments {
+				if v116 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v111)
+				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v117)
 			}
 			out.RawByte(']')
 		}
@@ -9677,27 +10241,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk49(out *jwriter.Writer, in MarketCom
 // MarshalJSON supports json.Marshaler interface
 func (v MarketCommentNew) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk49(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk55(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarketCommentNew) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk49(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk55(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarketCommentNew) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk49(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk55(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarketCommentNew) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk49(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk55(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk50(in *jlexer.Lexer, out *MarketCommentEdit) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk56(in *jlexer.Lexer, out *MarketCommentEdit) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9748,11 +10312,11 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk50(in *jlexer.Lexer, out *MarketComm
 					out.Attachments = (out.Attachments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v112 Attachment
+					var v118 Attachment
 					if data := in.Raw(); in.Ok() {
-						in.AddError((v112).UnmarshalJSON(data))
+						in.AddError((v118).UnmarshalJSON(data))
 					}
-					out.Attachments = append(out.Attachments, v112)
+					out.Attachments = append(out.Attachments, v118)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -9767,7 +10331,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk50(in *jlexer.Lexer, out *MarketComm
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk50(out *jwriter.Writer, in MarketCommentEdit) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk56(out *jwriter.Writer, in MarketCommentEdit) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9818,11 +10382,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk50(out *jwriter.Writer, in MarketCom
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v113, v114 := range in.Attachments {
-				if v113 > 0 {
+			for v119, v120 := range in.Attachments {
+				if v119 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v114)
+				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v120)
 			}
 			out.RawByte(']')
 		}
@@ -9833,27 +10397,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk50(out *jwriter.Writer, in MarketCom
 // MarshalJSON supports json.Marshaler interface
 func (v MarketCommentEdit) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk50(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk56(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarketCommentEdit) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk50(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk56(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarketCommentEdit) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk50(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk56(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarketCommentEdit) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk50(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk56(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk51(in *jlexer.Lexer, out *MarketCommentDelete) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk57(in *jlexer.Lexer, out *MarketCommentDelete) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9892,7 +10456,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk51(in *jlexer.Lexer, out *MarketComm
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk51(out *jwriter.Writer, in MarketCommentDelete) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk57(out *jwriter.Writer, in MarketCommentDelete) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -9927,27 +10491,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk51(out *jwriter.Writer, in MarketCom
 // MarshalJSON supports json.Marshaler interface
 func (v MarketCommentDelete) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk51(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk57(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarketCommentDelete) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk51(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk57(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarketCommentDelete) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk51(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk57(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarketCommentDelete) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk51(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk57(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk52(in *jlexer.Lexer, out *MarketCategory) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk58(in *jlexer.Lexer, out *MarketCategory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -9982,7 +10546,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk52(in *jlexer.Lexer, out *MarketCate
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk52(out *jwriter.Writer, in MarketCategory) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk58(out *jwriter.Writer, in MarketCategory) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10007,27 +10571,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk52(out *jwriter.Writer, in MarketCat
 // MarshalJSON supports json.Marshaler interface
 func (v MarketCategory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk52(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk58(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarketCategory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk52(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk58(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarketCategory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk52(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk58(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarketCategory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk52(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk58(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk53(in *jlexer.Lexer, out *MarketAlbum) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk59(in *jlexer.Lexer, out *MarketAlbum) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10068,7 +10632,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk53(in *jlexer.Lexer, out *MarketAlbu
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk53(out *jwriter.Writer, in MarketAlbum) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk59(out *jwriter.Writer, in MarketAlbum) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10108,27 +10672,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk53(out *jwriter.Writer, in MarketAlb
 // MarshalJSON supports json.Marshaler interface
 func (v MarketAlbum) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk53(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk59(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MarketAlbum) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk53(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk59(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MarketAlbum) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk53(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk59(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MarketAlbum) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk53(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk59(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk54(in *jlexer.Lexer, out *Link) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk60(in *jlexer.Lexer, out *Link) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10171,7 +10735,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk54(in *jlexer.Lexer, out *Link) {
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk54(out *jwriter.Writer, in Link) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk60(out *jwriter.Writer, in Link) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10216,27 +10780,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk54(out *jwriter.Writer, in Link) {
 // MarshalJSON supports json.Marshaler interface
 func (v Link) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk54(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk60(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Link) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk54(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk60(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Link) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk54(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk60(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Link) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk54(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk60(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk55(in *jlexer.Lexer, out *LeadFormsNew) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk61(in *jlexer.Lexer, out *LeadFormsNew) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10265,7 +10829,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk55(in *jlexer.Lexer, out *LeadFormsN
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk55(out *jwriter.Writer, in LeadFormsNew) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk61(out *jwriter.Writer, in LeadFormsNew) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10275,27 +10839,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk55(out *jwriter.Writer, in LeadForms
 // MarshalJSON supports json.Marshaler interface
 func (v LeadFormsNew) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk55(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk61(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LeadFormsNew) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk55(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk61(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *LeadFormsNew) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk55(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk61(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LeadFormsNew) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk55(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk61(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk56(in *jlexer.Lexer, out *GroupOfficersEdit) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk62(in *jlexer.Lexer, out *GroupOfficersEdit) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10332,7 +10896,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk56(in *jlexer.Lexer, out *GroupOffic
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk56(out *jwriter.Writer, in GroupOfficersEdit) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk62(out *jwriter.Writer, in GroupOfficersEdit) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10362,27 +10926,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk56(out *jwriter.Writer, in GroupOffi
 // MarshalJSON supports json.Marshaler interface
 func (v GroupOfficersEdit) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk56(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk62(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GroupOfficersEdit) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk56(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk62(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GroupOfficersEdit) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk56(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk62(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GroupOfficersEdit) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk56(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk62(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk57(in *jlexer.Lexer, out *GroupLeave) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk63(in *jlexer.Lexer, out *GroupLeave) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10415,7 +10979,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk57(in *jlexer.Lexer, out *GroupLeave
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk57(out *jwriter.Writer, in GroupLeave) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk63(out *jwriter.Writer, in GroupLeave) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10435,27 +10999,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk57(out *jwriter.Writer, in GroupLeav
 // MarshalJSON supports json.Marshaler interface
 func (v GroupLeave) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk57(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk63(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GroupLeave) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk57(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk63(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GroupLeave) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk57(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk63(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GroupLeave) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk57(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk63(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk58(in *jlexer.Lexer, out *GroupJoin) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk64(in *jlexer.Lexer, out *GroupJoin) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10488,7 +11052,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk58(in *jlexer.Lexer, out *GroupJoin)
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk58(out *jwriter.Writer, in GroupJoin) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk64(out *jwriter.Writer, in GroupJoin) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10508,27 +11072,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk58(out *jwriter.Writer, in GroupJoin
 // MarshalJSON supports json.Marshaler interface
 func (v GroupJoin) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk58(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk64(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GroupJoin) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk58(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk64(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GroupJoin) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk58(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk64(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GroupJoin) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk58(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk64(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk59(in *jlexer.Lexer, out *GroupChangeSettings) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk65(in *jlexer.Lexer, out *GroupChangeSettings) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10561,7 +11125,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk59(in *jlexer.Lexer, out *GroupChang
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk59(out *jwriter.Writer, in GroupChangeSettings) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk65(out *jwriter.Writer, in GroupChangeSettings) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10581,25 +11145,25 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk59(out *jwriter.Writer, in GroupChan
 // MarshalJSON supports json.Marshaler interface
 func (v GroupChangeSettings) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk59(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk65(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GroupChangeSettings) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk59(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk65(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GroupChangeSettings) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk59(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk65(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GroupChangeSettings) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk59(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk65(l, v)
 }
 func easyjsonC7452bc1Decode15(in *jlexer.Lexer, out *struct {
 	Title             *ChangedStringValue `json:"title"`
@@ -10833,7 +11397,7 @@ func easyjsonC7452bc1Encode15(out *jwriter.Writer, in struct {
 	}
 	out.RawByte('}')
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk60(in *jlexer.Lexer, out *GroupChangePhoto) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk66(in *jlexer.Lexer, out *GroupChangePhoto) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10866,7 +11430,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk60(in *jlexer.Lexer, out *GroupChang
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk60(out *jwriter.Writer, in GroupChangePhoto) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk66(out *jwriter.Writer, in GroupChangePhoto) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -10886,27 +11450,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk60(out *jwriter.Writer, in GroupChan
 // MarshalJSON supports json.Marshaler interface
 func (v GroupChangePhoto) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk60(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk66(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GroupChangePhoto) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk60(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk66(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GroupChangePhoto) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk60(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk66(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GroupChangePhoto) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk60(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk66(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk61(in *jlexer.Lexer, out *GroupAddress) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk67(in *jlexer.Lexer, out *GroupAddress) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -10961,7 +11525,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk61(in *jlexer.Lexer, out *GroupAddre
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk61(out *jwriter.Writer, in GroupAddress) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk67(out *jwriter.Writer, in GroupAddress) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -11097,27 +11661,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk61(out *jwriter.Writer, in GroupAddr
 // MarshalJSON supports json.Marshaler interface
 func (v GroupAddress) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk61(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk67(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GroupAddress) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk61(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk67(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GroupAddress) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk61(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk67(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GroupAddress) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk61(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk67(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk62(in *jlexer.Lexer, out *Group) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk68(in *jlexer.Lexer, out *Group) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -11297,14 +11861,14 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk62(in *jlexer.Lexer, out *Group) {
 					out.Contacts = (out.Contacts)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v115 struct {
+					var v121 struct {
 						UserID      int    `json:"user_id"`
 						Description string `json:"desc"`
 						Phone       string `json:"phone"`
 						Email       string `json:"email"`
 					}
-					easyjsonC7452bc1Decode19(in, &v115)
-					out.Contacts = append(out.Contacts, v115)
+					easyjsonC7452bc1Decode19(in, &v121)
+					out.Contacts = append(out.Contacts, v121)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -11325,9 +11889,9 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk62(in *jlexer.Lexer, out *Group) {
 					out.Links = (out.Links)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v116 MiniLink
-					(v116).UnmarshalEasyJSON(in)
-					out.Links = append(out.Links, v116)
+					var v122 MiniLink
+					(v122).UnmarshalEasyJSON(in)
+					out.Links = append(out.Links, v122)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -11360,7 +11924,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk62(in *jlexer.Lexer, out *Group) {
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk62(out *jwriter.Writer, in Group) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk68(out *jwriter.Writer, in Group) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -11570,11 +12134,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk62(out *jwriter.Writer, in Group) {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v117, v118 := range in.Contacts {
-				if v117 > 0 {
+			for v123, v124 := range in.Contacts {
+				if v123 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonC7452bc1Encode19(out, v118)
+				easyjsonC7452bc1Encode19(out, v124)
 			}
 			out.RawByte(']')
 		}
@@ -11586,11 +12150,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk62(out *jwriter.Writer, in Group) {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v119, v120 := range in.Links {
-				if v119 > 0 {
+			for v125, v126 := range in.Links {
+				if v125 > 0 {
 					out.RawByte(',')
 				}
-				(v120).MarshalEasyJSON(out)
+				(v126).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -11610,25 +12174,25 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk62(out *jwriter.Writer, in Group) {
 // MarshalJSON supports json.Marshaler interface
 func (v Group) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk62(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk68(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Group) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk62(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk68(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Group) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk62(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk68(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Group) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk62(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk68(l, v)
 }
 func easyjsonC7452bc1Decode20(in *jlexer.Lexer, out *struct {
 	Albums int `json:"albums"`
@@ -11843,9 +12407,9 @@ func easyjsonC7452bc1Decode18(in *jlexer.Lexer, out *struct {
 					out.Images = (out.Images)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v121 BaseImage
-					(v121).UnmarshalEasyJSON(in)
-					out.Images = append(out.Images, v121)
+					var v127 BaseImage
+					(v127).UnmarshalEasyJSON(in)
+					out.Images = append(out.Images, v127)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -11879,11 +12443,11 @@ func easyjsonC7452bc1Encode18(out *jwriter.Writer, in struct {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v122, v123 := range in.Images {
-				if v122 > 0 {
+			for v128, v129 := range in.Images {
+				if v128 > 0 {
 					out.RawByte(',')
 				}
-				(v123).MarshalEasyJSON(out)
+				(v129).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -12051,7 +12615,7 @@ func easyjsonC7452bc1Encode16(out *jwriter.Writer, in struct {
 	}
 	out.RawByte('}')
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk63(in *jlexer.Lexer, out *Gift) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk69(in *jlexer.Lexer, out *Gift) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12088,7 +12652,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk63(in *jlexer.Lexer, out *Gift) {
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk63(out *jwriter.Writer, in Gift) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk69(out *jwriter.Writer, in Gift) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12118,27 +12682,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk63(out *jwriter.Writer, in Gift) {
 // MarshalJSON supports json.Marshaler interface
 func (v Gift) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk63(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk69(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Gift) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk63(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk69(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Gift) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk63(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk69(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Gift) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk63(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk69(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk64(in *jlexer.Lexer, out *DocumentPreviewVideo) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk70(in *jlexer.Lexer, out *DocumentPreviewVideo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12175,7 +12739,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk64(in *jlexer.Lexer, out *DocumentPr
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk64(out *jwriter.Writer, in DocumentPreviewVideo) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk70(out *jwriter.Writer, in DocumentPreviewVideo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12205,27 +12769,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk64(out *jwriter.Writer, in DocumentP
 // MarshalJSON supports json.Marshaler interface
 func (v DocumentPreviewVideo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk64(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk70(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DocumentPreviewVideo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk64(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk70(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DocumentPreviewVideo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk64(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk70(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DocumentPreviewVideo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk64(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk70(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk65(in *jlexer.Lexer, out *DocumentPreviewPhoto) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk71(in *jlexer.Lexer, out *DocumentPreviewPhoto) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12260,9 +12824,9 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk65(in *jlexer.Lexer, out *DocumentPr
 					out.Sizes = (out.Sizes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v124 PhotoSize
-					(v124).UnmarshalEasyJSON(in)
-					out.Sizes = append(out.Sizes, v124)
+					var v130 PhotoSize
+					(v130).UnmarshalEasyJSON(in)
+					out.Sizes = append(out.Sizes, v130)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -12277,7 +12841,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk65(in *jlexer.Lexer, out *DocumentPr
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk65(out *jwriter.Writer, in DocumentPreviewPhoto) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk71(out *jwriter.Writer, in DocumentPreviewPhoto) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12288,11 +12852,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk65(out *jwriter.Writer, in DocumentP
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v125, v126 := range in.Sizes {
-				if v125 > 0 {
+			for v131, v132 := range in.Sizes {
+				if v131 > 0 {
 					out.RawByte(',')
 				}
-				(v126).MarshalEasyJSON(out)
+				(v132).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -12303,27 +12867,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk65(out *jwriter.Writer, in DocumentP
 // MarshalJSON supports json.Marshaler interface
 func (v DocumentPreviewPhoto) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk65(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk71(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DocumentPreviewPhoto) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk65(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk71(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DocumentPreviewPhoto) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk65(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk71(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DocumentPreviewPhoto) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk65(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk71(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk66(in *jlexer.Lexer, out *DocumentPreviewGraffiti) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk72(in *jlexer.Lexer, out *DocumentPreviewGraffiti) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12358,7 +12922,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk66(in *jlexer.Lexer, out *DocumentPr
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk66(out *jwriter.Writer, in DocumentPreviewGraffiti) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk72(out *jwriter.Writer, in DocumentPreviewGraffiti) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12383,27 +12947,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk66(out *jwriter.Writer, in DocumentP
 // MarshalJSON supports json.Marshaler interface
 func (v DocumentPreviewGraffiti) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk66(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk72(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DocumentPreviewGraffiti) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk66(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk72(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DocumentPreviewGraffiti) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk66(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk72(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DocumentPreviewGraffiti) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk66(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk72(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk67(in *jlexer.Lexer, out *DocumentPreviewAudioMessage) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk73(in *jlexer.Lexer, out *DocumentPreviewAudioMessage) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12440,9 +13004,9 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk67(in *jlexer.Lexer, out *DocumentPr
 					out.Waveform = (out.Waveform)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v127 int
-					v127 = int(in.Int())
-					out.Waveform = append(out.Waveform, v127)
+					var v133 int
+					v133 = int(in.Int())
+					out.Waveform = append(out.Waveform, v133)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -12461,7 +13025,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk67(in *jlexer.Lexer, out *DocumentPr
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk67(out *jwriter.Writer, in DocumentPreviewAudioMessage) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk73(out *jwriter.Writer, in DocumentPreviewAudioMessage) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12477,11 +13041,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk67(out *jwriter.Writer, in DocumentP
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v128, v129 := range in.Waveform {
-				if v128 > 0 {
+			for v134, v135 := range in.Waveform {
+				if v134 > 0 {
 					out.RawByte(',')
 				}
-				out.Int(int(v129))
+				out.Int(int(v135))
 			}
 			out.RawByte(']')
 		}
@@ -12502,27 +13066,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk67(out *jwriter.Writer, in DocumentP
 // MarshalJSON supports json.Marshaler interface
 func (v DocumentPreviewAudioMessage) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk67(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk73(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DocumentPreviewAudioMessage) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk67(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk73(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DocumentPreviewAudioMessage) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk67(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk73(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DocumentPreviewAudioMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk67(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk73(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk68(in *jlexer.Lexer, out *DocumentPreview) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk74(in *jlexer.Lexer, out *DocumentPreview) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12591,7 +13155,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk68(in *jlexer.Lexer, out *DocumentPr
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk68(out *jwriter.Writer, in DocumentPreview) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk74(out *jwriter.Writer, in DocumentPreview) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12637,27 +13201,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk68(out *jwriter.Writer, in DocumentP
 // MarshalJSON supports json.Marshaler interface
 func (v DocumentPreview) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk68(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk74(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DocumentPreview) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk68(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk74(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DocumentPreview) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk68(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk74(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DocumentPreview) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk68(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk74(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk69(in *jlexer.Lexer, out *Document) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk75(in *jlexer.Lexer, out *Document) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12706,7 +13270,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk69(in *jlexer.Lexer, out *Document)
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk69(out *jwriter.Writer, in Document) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk75(out *jwriter.Writer, in Document) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12766,27 +13330,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk69(out *jwriter.Writer, in Document)
 // MarshalJSON supports json.Marshaler interface
 func (v Document) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk69(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk75(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Document) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk69(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk75(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Document) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk69(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk75(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Document) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk69(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk75(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk70(in *jlexer.Lexer, out *DatabaseCity) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk76(in *jlexer.Lexer, out *DatabaseCity) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12825,7 +13389,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk70(in *jlexer.Lexer, out *DatabaseCi
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk70(out *jwriter.Writer, in DatabaseCity) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk76(out *jwriter.Writer, in DatabaseCity) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12860,27 +13424,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk70(out *jwriter.Writer, in DatabaseC
 // MarshalJSON supports json.Marshaler interface
 func (v DatabaseCity) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk70(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk76(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DatabaseCity) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk70(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk76(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DatabaseCity) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk70(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk76(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DatabaseCity) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk70(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk76(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk71(in *jlexer.Lexer, out *CropPhoto) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk77(in *jlexer.Lexer, out *CropPhoto) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -12915,7 +13479,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk71(in *jlexer.Lexer, out *CropPhoto)
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk71(out *jwriter.Writer, in CropPhoto) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk77(out *jwriter.Writer, in CropPhoto) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -12940,25 +13504,25 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk71(out *jwriter.Writer, in CropPhoto
 // MarshalJSON supports json.Marshaler interface
 func (v CropPhoto) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk71(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk77(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CropPhoto) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk71(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk77(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CropPhoto) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk71(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk77(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CropPhoto) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk71(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk77(l, v)
 }
 func easyjsonC7452bc1Decode21(in *jlexer.Lexer, out *struct {
 	X  int `json:"x"`
@@ -13033,7 +13597,7 @@ func easyjsonC7452bc1Encode21(out *jwriter.Writer, in struct {
 	}
 	out.RawByte('}')
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk72(in *jlexer.Lexer, out *Conversation) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk78(in *jlexer.Lexer, out *Conversation) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -13123,7 +13687,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk72(in *jlexer.Lexer, out *Conversati
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk72(out *jwriter.Writer, in Conversation) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk78(out *jwriter.Writer, in Conversation) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -13190,25 +13754,25 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk72(out *jwriter.Writer, in Conversat
 // MarshalJSON supports json.Marshaler interface
 func (v Conversation) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk72(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk78(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Conversation) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk72(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk78(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Conversation) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk72(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk78(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Conversation) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk72(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk78(l, v)
 }
 func easyjsonC7452bc1Decode25(in *jlexer.Lexer, out *struct {
 	MembersCount  int      `json:"members_count"`
@@ -13275,9 +13839,9 @@ func easyjsonC7452bc1Decode25(in *jlexer.Lexer, out *struct {
 					out.ActiveIDs = (out.ActiveIDs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v130 int
-					v130 = int(in.Int())
-					out.ActiveIDs = append(out.ActiveIDs, v130)
+					var v136 int
+					v136 = int(in.Int())
+					out.ActiveIDs = append(out.ActiveIDs, v136)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -13346,11 +13910,11 @@ func easyjsonC7452bc1Encode25(out *jwriter.Writer, in struct {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v131, v132 := range in.ActiveIDs {
-				if v131 > 0 {
+			for v137, v138 := range in.ActiveIDs {
+				if v137 > 0 {
 					out.RawByte(',')
 				}
-				out.Int(int(v132))
+				out.Int(int(v138))
 			}
 			out.RawByte(']')
 		}
@@ -13545,7 +14109,7 @@ func easyjsonC7452bc1Encode22(out *jwriter.Writer, in struct {
 	}
 	out.RawByte('}')
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk73(in *jlexer.Lexer, out *Confirmation) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk79(in *jlexer.Lexer, out *Confirmation) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -13574,7 +14138,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk73(in *jlexer.Lexer, out *Confirmati
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk73(out *jwriter.Writer, in Confirmation) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk79(out *jwriter.Writer, in Confirmation) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -13584,27 +14148,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk73(out *jwriter.Writer, in Confirmat
 // MarshalJSON supports json.Marshaler interface
 func (v Confirmation) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk73(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk79(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Confirmation) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk73(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk79(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Confirmation) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk73(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk79(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Confirmation) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk73(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk79(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk74(in *jlexer.Lexer, out *CommentBoard) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk80(in *jlexer.Lexer, out *CommentBoard) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -13647,11 +14211,11 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk74(in *jlexer.Lexer, out *CommentBoa
 					out.Attachments = (out.Attachments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v133 Attachment
+					var v139 Attachment
 					if data := in.Raw(); in.Ok() {
-						in.AddError((v133).UnmarshalJSON(data))
+						in.AddError((v139).UnmarshalJSON(data))
 					}
-					out.Attachments = append(out.Attachments, v133)
+					out.Attachments = append(out.Attachments, v139)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -13668,7 +14232,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk74(in *jlexer.Lexer, out *CommentBoa
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk74(out *jwriter.Writer, in CommentBoard) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk80(out *jwriter.Writer, in CommentBoard) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -13699,11 +14263,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk74(out *jwriter.Writer, in CommentBo
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v134, v135 := range in.Attachments {
-				if v134 > 0 {
+			for v140, v141 := range in.Attachments {
+				if v140 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v135)
+				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v141)
 			}
 			out.RawByte(']')
 		}
@@ -13719,25 +14283,25 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk74(out *jwriter.Writer, in CommentBo
 // MarshalJSON supports json.Marshaler interface
 func (v CommentBoard) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk74(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk80(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CommentBoard) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk74(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk80(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CommentBoard) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk74(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk80(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CommentBoard) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk74(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk80(l, v)
 }
 func easyjsonC7452bc1Decode26(in *jlexer.Lexer, out *struct {
 	Count     int `json:"count"`
@@ -13803,7 +14367,7 @@ func easyjsonC7452bc1Encode26(out *jwriter.Writer, in struct {
 	}
 	out.RawByte('}')
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk75(in *jlexer.Lexer, out *Comment) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk81(in *jlexer.Lexer, out *Comment) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -13850,11 +14414,11 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk75(in *jlexer.Lexer, out *Comment) {
 					out.Attachments = (out.Attachments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v136 Attachment
+					var v142 Attachment
 					if data := in.Raw(); in.Ok() {
-						in.AddError((v136).UnmarshalJSON(data))
+						in.AddError((v142).UnmarshalJSON(data))
 					}
-					out.Attachments = append(out.Attachments, v136)
+					out.Attachments = append(out.Attachments, v142)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -13869,7 +14433,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk75(in *jlexer.Lexer, out *Comment) {
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk75(out *jwriter.Writer, in Comment) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk81(out *jwriter.Writer, in Comment) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -13910,11 +14474,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk75(out *jwriter.Writer, in Comment)
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v137, v138 := range in.Attachments {
-				if v137 > 0 {
+			for v143, v144 := range in.Attachments {
+				if v143 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v138)
+				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v144)
 			}
 			out.RawByte(']')
 		}
@@ -13925,27 +14489,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk75(out *jwriter.Writer, in Comment)
 // MarshalJSON supports json.Marshaler interface
 func (v Comment) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk75(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk81(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Comment) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk75(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk81(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Comment) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk75(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk81(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Comment) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk75(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk81(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk76(in *jlexer.Lexer, out *Chat) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk82(in *jlexer.Lexer, out *Chat) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -13988,9 +14552,9 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk76(in *jlexer.Lexer, out *Chat) {
 					out.Users = (out.Users)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v139 int
-					v139 = int(in.Int())
-					out.Users = append(out.Users, v139)
+					var v145 int
+					v145 = int(in.Int())
+					out.Users = append(out.Users, v145)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -14019,7 +14583,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk76(in *jlexer.Lexer, out *Chat) {
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk76(out *jwriter.Writer, in Chat) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk82(out *jwriter.Writer, in Chat) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14050,11 +14614,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk76(out *jwriter.Writer, in Chat) {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v140, v141 := range in.Users {
-				if v140 > 0 {
+			for v146, v147 := range in.Users {
+				if v146 > 0 {
 					out.RawByte(',')
 				}
-				out.Int(int(v141))
+				out.Int(int(v147))
 			}
 			out.RawByte(']')
 		}
@@ -14100,25 +14664,25 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk76(out *jwriter.Writer, in Chat) {
 // MarshalJSON supports json.Marshaler interface
 func (v Chat) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk76(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk82(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Chat) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk76(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk82(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Chat) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk76(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk82(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Chat) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk76(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk82(l, v)
 }
 func easyjsonC7452bc1Decode27(in *jlexer.Lexer, out *struct {
 	Sound         BoolInt `json:"sound"`
@@ -14175,7 +14739,7 @@ func easyjsonC7452bc1Encode27(out *jwriter.Writer, in struct {
 	}
 	out.RawByte('}')
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk77(in *jlexer.Lexer, out *ChangedStringValue) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk83(in *jlexer.Lexer, out *ChangedStringValue) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14208,7 +14772,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk77(in *jlexer.Lexer, out *ChangedStr
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk77(out *jwriter.Writer, in ChangedStringValue) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk83(out *jwriter.Writer, in ChangedStringValue) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14228,27 +14792,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk77(out *jwriter.Writer, in ChangedSt
 // MarshalJSON supports json.Marshaler interface
 func (v ChangedStringValue) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk77(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk83(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ChangedStringValue) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk77(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk83(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ChangedStringValue) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk77(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk83(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ChangedStringValue) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk77(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk83(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk78(in *jlexer.Lexer, out *ChangedIntValue) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk84(in *jlexer.Lexer, out *ChangedIntValue) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14281,7 +14845,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk78(in *jlexer.Lexer, out *ChangedInt
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk78(out *jwriter.Writer, in ChangedIntValue) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk84(out *jwriter.Writer, in ChangedIntValue) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14301,27 +14865,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk78(out *jwriter.Writer, in ChangedIn
 // MarshalJSON supports json.Marshaler interface
 func (v ChangedIntValue) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk78(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk84(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ChangedIntValue) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk78(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk84(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ChangedIntValue) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk78(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk84(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ChangedIntValue) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk78(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk84(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk79(in *jlexer.Lexer, out *Category) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk85(in *jlexer.Lexer, out *Category) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14356,9 +14920,9 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk79(in *jlexer.Lexer, out *Category)
 					out.Subcategories = (out.Subcategories)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v142 BaseObjectWithName
-					(v142).UnmarshalEasyJSON(in)
-					out.Subcategories = append(out.Subcategories, v142)
+					var v148 BaseObjectWithName
+					(v148).UnmarshalEasyJSON(in)
+					out.Subcategories = append(out.Subcategories, v148)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -14381,9 +14945,9 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk79(in *jlexer.Lexer, out *Category)
 					out.PagePreviews = (out.PagePreviews)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v143 Group
-					(v143).UnmarshalEasyJSON(in)
-					out.PagePreviews = append(out.PagePreviews, v143)
+					var v149 Group
+					(v149).UnmarshalEasyJSON(in)
+					out.PagePreviews = append(out.PagePreviews, v149)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -14402,7 +14966,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk79(in *jlexer.Lexer, out *Category)
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk79(out *jwriter.Writer, in Category) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk85(out *jwriter.Writer, in Category) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14413,11 +14977,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk79(out *jwriter.Writer, in Category)
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v144, v145 := range in.Subcategories {
-				if v144 > 0 {
+			for v150, v151 := range in.Subcategories {
+				if v150 > 0 {
 					out.RawByte(',')
 				}
-				(v145).MarshalEasyJSON(out)
+				(v151).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -14432,11 +14996,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk79(out *jwriter.Writer, in Category)
 		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v146, v147 := range in.PagePreviews {
-				if v146 > 0 {
+			for v152, v153 := range in.PagePreviews {
+				if v152 > 0 {
 					out.RawByte(',')
 				}
-				(v147).MarshalEasyJSON(out)
+				(v153).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -14457,27 +15021,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk79(out *jwriter.Writer, in Category)
 // MarshalJSON supports json.Marshaler interface
 func (v Category) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk79(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk85(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Category) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk79(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk85(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Category) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk79(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk85(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Category) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk79(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk85(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk80(in *jlexer.Lexer, out *BoardTopicPoll) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk86(in *jlexer.Lexer, out *BoardTopicPoll) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14524,9 +15088,9 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk80(in *jlexer.Lexer, out *BoardTopic
 					out.Asnwers = (out.Asnwers)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v148 PollAnswer
-					(v148).UnmarshalEasyJSON(in)
-					out.Asnwers = append(out.Asnwers, v148)
+					var v154 PollAnswer
+					(v154).UnmarshalEasyJSON(in)
+					out.Asnwers = append(out.Asnwers, v154)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -14541,7 +15105,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk80(in *jlexer.Lexer, out *BoardTopic
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk80(out *jwriter.Writer, in BoardTopicPoll) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk86(out *jwriter.Writer, in BoardTopicPoll) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14582,11 +15146,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk80(out *jwriter.Writer, in BoardTopi
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v149, v150 := range in.Asnwers {
-				if v149 > 0 {
+			for v155, v156 := range in.Asnwers {
+				if v155 > 0 {
 					out.RawByte(',')
 				}
-				(v150).MarshalEasyJSON(out)
+				(v156).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -14597,27 +15161,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk80(out *jwriter.Writer, in BoardTopi
 // MarshalJSON supports json.Marshaler interface
 func (v BoardTopicPoll) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk80(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk86(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BoardTopicPoll) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk80(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk86(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BoardTopicPoll) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk80(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk86(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BoardTopicPoll) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk80(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk86(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk81(in *jlexer.Lexer, out *BoardTopic) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk87(in *jlexer.Lexer, out *BoardTopic) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14664,7 +15228,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk81(in *jlexer.Lexer, out *BoardTopic
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk81(out *jwriter.Writer, in BoardTopic) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk87(out *jwriter.Writer, in BoardTopic) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14719,27 +15283,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk81(out *jwriter.Writer, in BoardTopi
 // MarshalJSON supports json.Marshaler interface
 func (v BoardTopic) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk81(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk87(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BoardTopic) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk81(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk87(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BoardTopic) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk81(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk87(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BoardTopic) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk81(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk87(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk82(in *jlexer.Lexer, out *BoardPostRestore) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk88(in *jlexer.Lexer, out *BoardPostRestore) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14786,11 +15350,11 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk82(in *jlexer.Lexer, out *BoardPostR
 					out.Attachments = (out.Attachments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v151 Attachment
+					var v157 Attachment
 					if data := in.Raw(); in.Ok() {
-						in.AddError((v151).UnmarshalJSON(data))
+						in.AddError((v157).UnmarshalJSON(data))
 					}
-					out.Attachments = append(out.Attachments, v151)
+					out.Attachments = append(out.Attachments, v157)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -14807,7 +15371,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk82(in *jlexer.Lexer, out *BoardPostR
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk82(out *jwriter.Writer, in BoardPostRestore) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk88(out *jwriter.Writer, in BoardPostRestore) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14848,11 +15412,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk82(out *jwriter.Writer, in BoardPost
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v152, v153 := range in.Attachments {
-				if v152 > 0 {
+			for v158, v159 := range in.Attachments {
+				if v158 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v153)
+				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v159)
 			}
 			out.RawByte(']')
 		}
@@ -14868,27 +15432,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk82(out *jwriter.Writer, in BoardPost
 // MarshalJSON supports json.Marshaler interface
 func (v BoardPostRestore) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk82(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk88(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BoardPostRestore) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk82(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk88(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BoardPostRestore) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk82(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk88(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BoardPostRestore) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk82(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk88(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk83(in *jlexer.Lexer, out *BoardPostNew) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk89(in *jlexer.Lexer, out *BoardPostNew) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -14935,11 +15499,11 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk83(in *jlexer.Lexer, out *BoardPostN
 					out.Attachments = (out.Attachments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v154 Attachment
+					var v160 Attachment
 					if data := in.Raw(); in.Ok() {
-						in.AddError((v154).UnmarshalJSON(data))
+						in.AddError((v160).UnmarshalJSON(data))
 					}
-					out.Attachments = append(out.Attachments, v154)
+					out.Attachments = append(out.Attachments, v160)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -14956,7 +15520,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk83(in *jlexer.Lexer, out *BoardPostN
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk83(out *jwriter.Writer, in BoardPostNew) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk89(out *jwriter.Writer, in BoardPostNew) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -14997,11 +15561,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk83(out *jwriter.Writer, in BoardPost
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v155, v156 := range in.Attachments {
-				if v155 > 0 {
+			for v161, v162 := range in.Attachments {
+				if v161 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v156)
+				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v162)
 			}
 			out.RawByte(']')
 		}
@@ -15017,27 +15581,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk83(out *jwriter.Writer, in BoardPost
 // MarshalJSON supports json.Marshaler interface
 func (v BoardPostNew) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk83(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk89(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BoardPostNew) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk83(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk89(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BoardPostNew) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk83(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk89(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BoardPostNew) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk83(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk89(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk84(in *jlexer.Lexer, out *BoardPostEdit) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk90(in *jlexer.Lexer, out *BoardPostEdit) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15084,11 +15648,11 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk84(in *jlexer.Lexer, out *BoardPostE
 					out.Attachments = (out.Attachments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v157 Attachment
+					var v163 Attachment
 					if data := in.Raw(); in.Ok() {
-						in.AddError((v157).UnmarshalJSON(data))
+						in.AddError((v163).UnmarshalJSON(data))
 					}
-					out.Attachments = append(out.Attachments, v157)
+					out.Attachments = append(out.Attachments, v163)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -15105,7 +15669,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk84(in *jlexer.Lexer, out *BoardPostE
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk84(out *jwriter.Writer, in BoardPostEdit) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk90(out *jwriter.Writer, in BoardPostEdit) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15146,11 +15710,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk84(out *jwriter.Writer, in BoardPost
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v158, v159 := range in.Attachments {
-				if v158 > 0 {
+			for v164, v165 := range in.Attachments {
+				if v164 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v159)
+				easyjsonC7452bc1EncodeGithubComStek29Vk2(out, v165)
 			}
 			out.RawByte(']')
 		}
@@ -15166,27 +15730,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk84(out *jwriter.Writer, in BoardPost
 // MarshalJSON supports json.Marshaler interface
 func (v BoardPostEdit) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk84(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk90(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BoardPostEdit) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk84(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk90(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BoardPostEdit) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk84(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk90(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BoardPostEdit) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk84(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk90(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk85(in *jlexer.Lexer, out *BoardPostDelete) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk91(in *jlexer.Lexer, out *BoardPostDelete) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15221,7 +15785,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk85(in *jlexer.Lexer, out *BoardPostD
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk85(out *jwriter.Writer, in BoardPostDelete) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk91(out *jwriter.Writer, in BoardPostDelete) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15246,27 +15810,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk85(out *jwriter.Writer, in BoardPost
 // MarshalJSON supports json.Marshaler interface
 func (v BoardPostDelete) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk85(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk91(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BoardPostDelete) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk85(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk91(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BoardPostDelete) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk85(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk91(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BoardPostDelete) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk85(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk91(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk86(in *jlexer.Lexer, out *BaseObjectWithName) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk92(in *jlexer.Lexer, out *BaseObjectWithName) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15299,7 +15863,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk86(in *jlexer.Lexer, out *BaseObject
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk86(out *jwriter.Writer, in BaseObjectWithName) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk92(out *jwriter.Writer, in BaseObjectWithName) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15319,27 +15883,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk86(out *jwriter.Writer, in BaseObjec
 // MarshalJSON supports json.Marshaler interface
 func (v BaseObjectWithName) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk86(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk92(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BaseObjectWithName) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk86(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk92(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BaseObjectWithName) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk86(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk92(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BaseObjectWithName) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk86(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk92(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk87(in *jlexer.Lexer, out *BaseObject) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk93(in *jlexer.Lexer, out *BaseObject) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15372,7 +15936,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk87(in *jlexer.Lexer, out *BaseObject
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk87(out *jwriter.Writer, in BaseObject) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk93(out *jwriter.Writer, in BaseObject) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15392,27 +15956,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk87(out *jwriter.Writer, in BaseObjec
 // MarshalJSON supports json.Marshaler interface
 func (v BaseObject) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk87(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk93(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BaseObject) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk87(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk93(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BaseObject) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk87(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk93(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BaseObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk87(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk93(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk88(in *jlexer.Lexer, out *BaseImage) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk94(in *jlexer.Lexer, out *BaseImage) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15447,7 +16011,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk88(in *jlexer.Lexer, out *BaseImage)
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk88(out *jwriter.Writer, in BaseImage) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk94(out *jwriter.Writer, in BaseImage) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15472,27 +16036,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk88(out *jwriter.Writer, in BaseImage
 // MarshalJSON supports json.Marshaler interface
 func (v BaseImage) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk88(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk94(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BaseImage) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk88(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk94(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BaseImage) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk88(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk94(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BaseImage) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk88(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk94(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk89(in *jlexer.Lexer, out *AudioNew) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk95(in *jlexer.Lexer, out *AudioNew) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15551,7 +16115,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk89(in *jlexer.Lexer, out *AudioNew)
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk89(out *jwriter.Writer, in AudioNew) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk95(out *jwriter.Writer, in AudioNew) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15636,27 +16200,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk89(out *jwriter.Writer, in AudioNew)
 // MarshalJSON supports json.Marshaler interface
 func (v AudioNew) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk89(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk95(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AudioNew) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk89(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk95(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AudioNew) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk89(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk95(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AudioNew) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk89(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk95(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk90(in *jlexer.Lexer, out *Audio) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk96(in *jlexer.Lexer, out *Audio) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15715,7 +16279,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk90(in *jlexer.Lexer, out *Audio) {
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk90(out *jwriter.Writer, in Audio) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk96(out *jwriter.Writer, in Audio) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15800,27 +16364,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk90(out *jwriter.Writer, in Audio) {
 // MarshalJSON supports json.Marshaler interface
 func (v Audio) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk90(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk96(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Audio) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk90(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk96(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Audio) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk90(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk96(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Audio) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk90(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk96(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk91(in *jlexer.Lexer, out *Album) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk97(in *jlexer.Lexer, out *Album) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15865,7 +16429,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk91(in *jlexer.Lexer, out *Album) {
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk91(out *jwriter.Writer, in Album) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk97(out *jwriter.Writer, in Album) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15915,27 +16479,27 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk91(out *jwriter.Writer, in Album) {
 // MarshalJSON supports json.Marshaler interface
 func (v Album) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk91(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk97(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Album) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk91(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk97(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Album) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk91(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk97(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Album) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk91(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk97(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk92(in *jlexer.Lexer, out *APIResponse) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk98(in *jlexer.Lexer, out *APIResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -15968,6 +16532,29 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk92(in *jlexer.Lexer, out *APIRespons
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.Response).UnmarshalJSON(data))
 			}
+		case "execute_errors":
+			if in.IsNull() {
+				in.Skip()
+				out.ExecuteErrors = nil
+			} else {
+				in.Delim('[')
+				if out.ExecuteErrors == nil {
+					if !in.IsDelim(']') {
+						out.ExecuteErrors = make([]APIError, 0, 0)
+					} else {
+						out.ExecuteErrors = []APIError{}
+					}
+				} else {
+					out.ExecuteErrors = (out.ExecuteErrors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v166 APIError
+					(v166).UnmarshalEasyJSON(in)
+					out.ExecuteErrors = append(out.ExecuteErrors, v166)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -15978,7 +16565,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk92(in *jlexer.Lexer, out *APIRespons
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk92(out *jwriter.Writer, in APIResponse) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk98(out *jwriter.Writer, in APIResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -15996,33 +16583,49 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk92(out *jwriter.Writer, in APIRespon
 		out.RawString(prefix)
 		out.Raw((in.Response).MarshalJSON())
 	}
+	{
+		const prefix string = ",\"execute_errors\":"
+		out.RawString(prefix)
+		if in.ExecuteErrors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v167, v168 := range in.ExecuteErrors {
+				if v167 > 0 {
+					out.RawByte(',')
+				}
+				(v168).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v APIResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk92(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk98(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v APIResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk92(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk98(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *APIResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk92(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk98(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *APIResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk92(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk98(l, v)
 }
-func easyjsonC7452bc1DecodeGithubComStek29Vk93(in *jlexer.Lexer, out *APIError) {
+func easyjsonC7452bc1DecodeGithubComStek29Vk99(in *jlexer.Lexer, out *APIError) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -16045,6 +16648,8 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk93(in *jlexer.Lexer, out *APIError)
 			out.Code = int(in.Int())
 		case "error_msg":
 			out.Message = string(in.String())
+		case "method":
+			out.Method = string(in.String())
 		case "request_params":
 			if in.IsNull() {
 				in.Skip()
@@ -16067,16 +16672,24 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk93(in *jlexer.Lexer, out *APIError)
 					out.RequestParams = (out.RequestParams)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v160 struct {
+					var v169 struct {
 						Key   string `json:"key"`
 						Value string `json:"value"`
 					}
-					easyjsonC7452bc1Decode28(in, &v160)
-					out.RequestParams = append(out.RequestParams, v160)
+					easyjsonC7452bc1Decode28(in, &v169)
+					out.RequestParams = append(out.RequestParams, v169)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "captcha_sid":
+			out.CaptchaSID = string(in.String())
+		case "captcha_img":
+			out.CaptchaImg = string(in.String())
+		case "redirect_uri":
+			out.RedirectURI = string(in.String())
+		case "confirmation_text":
+			out.ConfirmationText = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -16087,7 +16700,7 @@ func easyjsonC7452bc1DecodeGithubComStek29Vk93(in *jlexer.Lexer, out *APIError)
 		in.Consumed()
 	}
 }
-func easyjsonC7452bc1EncodeGithubComStek29Vk93(out *jwriter.Writer, in APIError) {
+func easyjsonC7452bc1EncodeGithubComStek29Vk99(out *jwriter.Writer, in APIError) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -16101,6 +16714,11 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk93(out *jwriter.Writer, in APIError)
 		out.RawString(prefix)
 		out.String(string(in.Message))
 	}
+	if in.Method != "" {
+		const prefix string = ",\"method\":"
+		out.RawString(prefix)
+		out.String(string(in.Method))
+	}
 	{
 		const prefix string = ",\"request_params\":"
 		out.RawString(prefix)
@@ -16108,40 +16726,60 @@ func easyjsonC7452bc1EncodeGithubComStek29Vk93(out *jwriter.Writer, in APIError)
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v161, v162 := range in.RequestParams {
-				if v161 > 0 {
+			for v170, v171 := range in.RequestParams {
+				if v170 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonC7452bc1Encode28(out, v162)
+				easyjsonC7452bc1Encode28(out, v171)
 			}
 			out.RawByte(']')
 		}
 	}
+	if in.CaptchaSID != "" {
+		const prefix string = ",\"captcha_sid\":"
+		out.RawString(prefix)
+		out.String(string(in.CaptchaSID))
+	}
+	if in.CaptchaImg != "" {
+		const prefix string = ",\"captcha_img\":"
+		out.RawString(prefix)
+		out.String(string(in.CaptchaImg))
+	}
+	if in.RedirectURI != "" {
+		const prefix string = ",\"redirect_uri\":"
+		out.RawString(prefix)
+		out.String(string(in.RedirectURI))
+	}
+	if in.ConfirmationText != "" {
+		const prefix string = ",\"confirmation_text\":"
+		out.RawString(prefix)
+		out.String(string(in.ConfirmationText))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v APIError) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC7452bc1EncodeGithubComStek29Vk93(&w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk99(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v APIError) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC7452bc1EncodeGithubComStek29Vk93(w, v)
+	easyjsonC7452bc1EncodeGithubComStek29Vk99(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *APIError) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC7452bc1DecodeGithubComStek29Vk93(&r, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk99(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *APIError) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC7452bc1DecodeGithubComStek29Vk93(l, v)
+	easyjsonC7452bc1DecodeGithubComStek29Vk99(l, v)
 }
 func easyjsonC7452bc1Decode28(in *jlexer.Lexer, out *struct {
 	Key   string `json:"key"`
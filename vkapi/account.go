@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 	"strconv"
 
 	"github.com/stek29/vk"
@@ -19,6 +19,7 @@ type AccountGetCountersParams struct {
 }
 
 // AccountGetCountersResponse is response for Account.GetCounters
+//
 //easyjson:json
 type AccountGetCountersResponse struct {
 	// New friends requests number
@@ -45,13 +46,18 @@ type AccountGetCountersResponse struct {
 
 // GetCounters Returns non-null values of user counters.
 func (v Account) GetCounters(params AccountGetCountersParams) (*AccountGetCountersResponse, error) {
-	r, err := v.API.Request("account.getCounters", params)
+	return v.GetCountersContext(context.Background(), params)
+}
+
+// GetCountersContext is GetCounters with context support
+func (v Account) GetCountersContext(ctx context.Context, params AccountGetCountersParams) (*AccountGetCountersResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.getCounters", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp AccountGetCountersResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +74,12 @@ type AccountSetNameInMenuParams struct {
 
 // SetNameInMenu Sets an application screen name (up to 17 characters), that is shown to the user in the left menu.
 func (v Account) SetNameInMenu(params AccountSetNameInMenuParams) (bool, error) {
-	r, err := v.API.Request("account.setNameInMenu", params)
+	return v.SetNameInMenuContext(context.Background(), params)
+}
+
+// SetNameInMenuContext is SetNameInMenu with context support
+func (v Account) SetNameInMenuContext(ctx context.Context, params AccountSetNameInMenuParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.setNameInMenu", params)
 	if err != nil {
 		return false, err
 	}
@@ -84,7 +95,12 @@ type AccountSetOnlineParams struct {
 
 // SetOnline Marks the current user as online for 15 minutes.
 func (v Account) SetOnline(params AccountSetOnlineParams) (bool, error) {
-	r, err := v.API.Request("account.setOnline", params)
+	return v.SetOnlineContext(context.Background(), params)
+}
+
+// SetOnlineContext is SetOnline with context support
+func (v Account) SetOnlineContext(ctx context.Context, params AccountSetOnlineParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.setOnline", params)
 	if err != nil {
 		return false, err
 	}
@@ -94,7 +110,12 @@ func (v Account) SetOnline(params AccountSetOnlineParams) (bool, error) {
 
 // SetOffline Marks a current user as offline.
 func (v Account) SetOffline() (bool, error) {
-	r, err := v.API.Request("account.setOffline", nil)
+	return v.SetOfflineContext(context.Background())
+}
+
+// SetOfflineContext is SetOffline with context support
+func (v Account) SetOfflineContext(ctx context.Context) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.setOffline", nil)
 	if err != nil {
 		return false, err
 	}
@@ -121,7 +142,12 @@ type AccountRegisterDeviceParams struct {
 
 // RegisterDevice Subscribes an iOS/Android/Windows Phone-based device to receive push notifications
 func (v Account) RegisterDevice(params AccountRegisterDeviceParams) (bool, error) {
-	r, err := v.API.Request("account.registerDevice", params)
+	return v.RegisterDeviceContext(context.Background(), params)
+}
+
+// RegisterDeviceContext is RegisterDevice with context support
+func (v Account) RegisterDeviceContext(ctx context.Context, params AccountRegisterDeviceParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.registerDevice", params)
 	if err != nil {
 		return false, err
 	}
@@ -138,7 +164,12 @@ type AccountUnregisterDeviceParams struct {
 
 // UnregisterDevice Unsubscribes a device from push notifications.
 func (v Account) UnregisterDevice(params AccountUnregisterDeviceParams) (bool, error) {
-	r, err := v.API.Request("account.unregisterDevice", params)
+	return v.UnregisterDeviceContext(context.Background(), params)
+}
+
+// UnregisterDeviceContext is UnregisterDevice with context support
+func (v Account) UnregisterDeviceContext(ctx context.Context, params AccountUnregisterDeviceParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.unregisterDevice", params)
 	if err != nil {
 		return false, err
 	}
@@ -160,7 +191,12 @@ type AccountSetSilenceModeParams struct {
 
 // SetSilenceMode Mutes push notifications for the set period of time.
 func (v Account) SetSilenceMode(params AccountSetSilenceModeParams) (bool, error) {
-	r, err := v.API.Request("account.setSilenceMode", params)
+	return v.SetSilenceModeContext(context.Background(), params)
+}
+
+// SetSilenceModeContext is SetSilenceMode with context support
+func (v Account) SetSilenceModeContext(ctx context.Context, params AccountSetSilenceModeParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.setSilenceMode", params)
 	if err != nil {
 		return false, err
 	}
@@ -175,6 +211,7 @@ type AccountGetPushSettingsParams struct {
 }
 
 // AccountGetPushSettingsResponse is response for Account.GetPushSettings
+//
 //easyjson:json
 type AccountGetPushSettingsResponse struct {
 	// Information whether notifications are disabled
@@ -239,13 +276,18 @@ type AccountGetPushSettingsResponse struct {
 
 // GetPushSettings Gets settings of push notifications.
 func (v Account) GetPushSettings(params AccountGetPushSettingsParams) (*AccountGetPushSettingsResponse, error) {
-	r, err := v.API.Request("account.getPushSettings", params)
+	return v.GetPushSettingsContext(context.Background(), params)
+}
+
+// GetPushSettingsContext is GetPushSettings with context support
+func (v Account) GetPushSettingsContext(ctx context.Context, params AccountGetPushSettingsParams) (*AccountGetPushSettingsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.getPushSettings", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp AccountGetPushSettingsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -266,7 +308,12 @@ type AccountSetPushSettingsParams struct {
 
 // SetPushSettings Change push settings.
 func (v Account) SetPushSettings(params AccountSetPushSettingsParams) (bool, error) {
-	r, err := v.API.Request("account.setPushSettings", params)
+	return v.SetPushSettingsContext(context.Background(), params)
+}
+
+// SetPushSettingsContext is SetPushSettings with context support
+func (v Account) SetPushSettingsContext(ctx context.Context, params AccountSetPushSettingsParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.setPushSettings", params)
 	if err != nil {
 		return false, err
 	}
@@ -286,7 +333,12 @@ type AccountGetAppPermissionsResponse int
 
 // GetAppPermissions Gets settings of the user in this application.
 func (v Account) GetAppPermissions(params AccountGetAppPermissionsParams) (AccountGetAppPermissionsResponse, error) {
-	r, err := v.API.Request("account.getAppPermissions", params)
+	return v.GetAppPermissionsContext(context.Background(), params)
+}
+
+// GetAppPermissionsContext is GetAppPermissions with context support
+func (v Account) GetAppPermissionsContext(ctx context.Context, params AccountGetAppPermissionsParams) (AccountGetAppPermissionsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.getAppPermissions", params)
 	if err != nil {
 		return 0, err
 	}
@@ -311,6 +363,7 @@ type AccountGetActiveOffersParams struct {
 }
 
 // AccountGetActiveOffersResponse is response for Account.GetActiveOffers
+//
 //easyjson:json
 type AccountGetActiveOffersResponse struct {
 	// Total number
@@ -339,13 +392,18 @@ type AccountGetActiveOffersResponse struct {
 
 // GetActiveOffers Returns a list of active ads (offers) which executed by the user will bring him/her respective number of votes to his balance in the application.
 func (v Account) GetActiveOffers(params AccountGetActiveOffersParams) (*AccountGetActiveOffersResponse, error) {
-	r, err := v.API.Request("account.getActiveOffers", params)
+	return v.GetActiveOffersContext(context.Background(), params)
+}
+
+// GetActiveOffersContext is GetActiveOffers with context support
+func (v Account) GetActiveOffersContext(ctx context.Context, params AccountGetActiveOffersParams) (*AccountGetActiveOffersResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.getActiveOffers", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp AccountGetActiveOffersResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -361,6 +419,7 @@ type AccountGetBannedParams struct {
 }
 
 // AccountGetBannedResponse is response for Account.GetBanned
+//
 //easyjson:json
 type AccountGetBannedResponse struct {
 	// Total number
@@ -370,13 +429,18 @@ type AccountGetBannedResponse struct {
 
 // GetBanned Returns a user's blacklist.
 func (v Account) GetBanned(params AccountGetBannedParams) (*AccountGetBannedResponse, error) {
-	r, err := v.API.Request("account.getBanned", params)
+	return v.GetBannedContext(context.Background(), params)
+}
+
+// GetBannedContext is GetBanned with context support
+func (v Account) GetBannedContext(ctx context.Context, params AccountGetBannedParams) (*AccountGetBannedResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.getBanned", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp AccountGetBannedResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -390,6 +454,7 @@ type AccountGetInfoParams struct {
 }
 
 // AccountGetInfoResponse is response for Account.GetInfo
+//
 //easyjson:json
 type AccountGetInfoResponse struct {
 	// Country code
@@ -410,13 +475,18 @@ type AccountGetInfoResponse struct {
 
 // GetInfo Returns current account info.
 func (v Account) GetInfo(params AccountGetInfoParams) (*AccountGetInfoResponse, error) {
-	r, err := v.API.Request("account.getInfo", params)
+	return v.GetInfoContext(context.Background(), params)
+}
+
+// GetInfoContext is GetInfo with context support
+func (v Account) GetInfoContext(ctx context.Context, params AccountGetInfoParams) (*AccountGetInfoResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.getInfo", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp AccountGetInfoResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -433,7 +503,12 @@ type AccountSetInfoParams struct {
 
 // SetInfo Allows to edit the current account info.
 func (v Account) SetInfo(params AccountSetInfoParams) (bool, error) {
-	r, err := v.API.Request("account.setInfo", params)
+	return v.SetInfoContext(context.Background(), params)
+}
+
+// SetInfoContext is SetInfo with context support
+func (v Account) SetInfoContext(ctx context.Context, params AccountSetInfoParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.setInfo", params)
 	if err != nil {
 		return false, err
 	}
@@ -454,6 +529,7 @@ type AccountChangePasswordParams struct {
 }
 
 // AccountChangePasswordResponse is response for Account.ChangePassword
+//
 //easyjson:json
 type AccountChangePasswordResponse struct {
 	// New token
@@ -464,13 +540,18 @@ type AccountChangePasswordResponse struct {
 
 // ChangePassword Changes a user password after access is successfully restored with the [vk.com/dev/auth.restore|auth.restore] method.
 func (v Account) ChangePassword(params AccountChangePasswordParams) (*AccountChangePasswordResponse, error) {
-	r, err := v.API.Request("account.changePassword", params)
+	return v.ChangePasswordContext(context.Background(), params)
+}
+
+// ChangePasswordContext is ChangePassword with context support
+func (v Account) ChangePasswordContext(ctx context.Context, params AccountChangePasswordParams) (*AccountChangePasswordResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.changePassword", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp AccountChangePasswordResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -478,6 +559,7 @@ func (v Account) ChangePassword(params AccountChangePasswordParams) (*AccountCha
 }
 
 // AccountGetProfileInfoResponse is response for Account.GetProfileInfo
+//
 //easyjson:json
 type AccountGetProfileInfoResponse struct {
 	// User first name
@@ -522,13 +604,18 @@ type AccountGetProfileInfoResponse struct {
 
 // GetProfileInfo Returns the current account info.
 func (v Account) GetProfileInfo() (*AccountGetProfileInfoResponse, error) {
-	r, err := v.API.Request("account.getProfileInfo", nil)
+	return v.GetProfileInfoContext(context.Background())
+}
+
+// GetProfileInfoContext is GetProfileInfo with context support
+func (v Account) GetProfileInfoContext(ctx context.Context) (*AccountGetProfileInfoResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.getProfileInfo", nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp AccountGetProfileInfoResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -568,6 +655,7 @@ type AccountSaveProfileInfoParams struct {
 }
 
 // AccountSaveProfileInfoResponse is response for Account.SaveProfileInfo
+//
 //easyjson:json
 type AccountSaveProfileInfoResponse struct {
 	// 1 if changes has been processed
@@ -586,13 +674,18 @@ type AccountSaveProfileInfoResponse struct {
 
 // SaveProfileInfo Edits current profile info.
 func (v Account) SaveProfileInfo(params AccountSaveProfileInfoParams) (*AccountSaveProfileInfoResponse, error) {
-	r, err := v.API.Request("account.saveProfileInfo", params)
+	return v.SaveProfileInfoContext(context.Background(), params)
+}
+
+// SaveProfileInfoContext is SaveProfileInfo with context support
+func (v Account) SaveProfileInfoContext(ctx context.Context, params AccountSaveProfileInfoParams) (*AccountSaveProfileInfoResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.saveProfileInfo", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp AccountSaveProfileInfoResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -606,7 +699,12 @@ type AccountBanParams struct {
 
 // Ban does account.ban
 func (v Account) Ban(params AccountBanParams) (bool, error) {
-	r, err := v.API.Request("account.ban", params)
+	return v.BanContext(context.Background(), params)
+}
+
+// BanContext is Ban with context support
+func (v Account) BanContext(ctx context.Context, params AccountBanParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.ban", params)
 	if err != nil {
 		return false, err
 	}
@@ -621,7 +719,12 @@ type AccountUnbanParams struct {
 
 // Unban does account.unban
 func (v Account) Unban(params AccountUnbanParams) (bool, error) {
-	r, err := v.API.Request("account.unban", params)
+	return v.UnbanContext(context.Background(), params)
+}
+
+// UnbanContext is Unban with context support
+func (v Account) UnbanContext(ctx context.Context, params AccountUnbanParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "account.unban", params)
 	if err != nil {
 		return false, err
 	}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 	"strconv"
 
 	"github.com/stek29/vk"
@@ -34,6 +34,7 @@ type AppsGetCatalogParams struct {
 }
 
 // AppsGetCatalogResponse is response for Apps.GetCatalog
+//
 //easyjson:json
 type AppsGetCatalogResponse struct {
 	// Total number
@@ -43,13 +44,18 @@ type AppsGetCatalogResponse struct {
 
 // GetCatalog Returns a list of applications (apps) available to users in the App Catalog.
 func (v Apps) GetCatalog(params AppsGetCatalogParams) (*AppsGetCatalogResponse, error) {
-	r, err := v.API.Request("apps.getCatalog", params)
+	return v.GetCatalogContext(context.Background(), params)
+}
+
+// GetCatalogContext is GetCatalog with context support
+func (v Apps) GetCatalogContext(ctx context.Context, params AppsGetCatalogParams) (*AppsGetCatalogResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "apps.getCatalog", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp AppsGetCatalogResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -73,6 +79,7 @@ type AppsGetParams struct {
 }
 
 // AppsGetResponse is response for Apps.Get
+//
 //easyjson:json
 type AppsGetResponse struct {
 	// Total number
@@ -82,13 +89,18 @@ type AppsGetResponse struct {
 
 // Get Returns applications data.
 func (v Apps) Get(params AppsGetParams) (*AppsGetResponse, error) {
-	r, err := v.API.Request("apps.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Apps) GetContext(ctx context.Context, params AppsGetParams) (*AppsGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "apps.get", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp AppsGetResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +127,12 @@ type AppsSendRequestResponse int
 
 // SendRequest Sends a request to another user in an app that uses VK authorization.
 func (v Apps) SendRequest(params AppsSendRequestParams) (AppsSendRequestResponse, error) {
-	r, err := v.API.Request("apps.sendRequest", params)
+	return v.SendRequestContext(context.Background(), params)
+}
+
+// SendRequestContext is SendRequest with context support
+func (v Apps) SendRequestContext(ctx context.Context, params AppsSendRequestParams) (AppsSendRequestResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "apps.sendRequest", params)
 	if err != nil {
 		return 0, err
 	}
@@ -134,7 +151,12 @@ func (v Apps) SendRequest(params AppsSendRequestParams) (AppsSendRequestResponse
 
 // DeleteAppRequests Deletes all request notifications from the current app.
 func (v Apps) DeleteAppRequests() (bool, error) {
-	r, err := v.API.Request("apps.deleteAppRequests", nil)
+	return v.DeleteAppRequestsContext(context.Background())
+}
+
+// DeleteAppRequestsContext is DeleteAppRequests with context support
+func (v Apps) DeleteAppRequestsContext(ctx context.Context) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "apps.deleteAppRequests", nil)
 	if err != nil {
 		return false, err
 	}
@@ -155,6 +177,7 @@ type AppsGetFriendsListParams struct {
 }
 
 // AppsGetFriendsListResponse is response for Apps.GetFriendsList
+//
 //easyjson:json
 type AppsGetFriendsListResponse struct {
 	// Total number
@@ -164,13 +187,18 @@ type AppsGetFriendsListResponse struct {
 
 // GetFriendsList Creates friends list for requests and invites in current app.
 func (v Apps) GetFriendsList(params AppsGetFriendsListParams) (*AppsGetFriendsListResponse, error) {
-	r, err := v.API.Request("apps.getFriendsList", params)
+	return v.GetFriendsListContext(context.Background(), params)
+}
+
+// GetFriendsListContext is GetFriendsList with context support
+func (v Apps) GetFriendsListContext(ctx context.Context, params AppsGetFriendsListParams) (*AppsGetFriendsListResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "apps.getFriendsList", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp AppsGetFriendsListResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -194,6 +222,7 @@ type AppsGetLeaderboardResponse interface {
 }
 
 // AppsGetLeaderboardResponseNormal is non-extended version of AppsGetLeaderboardResponse
+//
 //easyjson:json
 type AppsGetLeaderboardResponseNormal struct {
 	// Total number
@@ -213,6 +242,7 @@ type AppsGetLeaderboardResponseNormal struct {
 func (AppsGetLeaderboardResponseNormal) isAppsGetLeaderboard() {}
 
 // AppsGetLeaderboardResponseExtended is extended version of AppsGetLeaderboardResponse
+//
 //easyjson:json
 type AppsGetLeaderboardResponseExtended struct {
 	// Total number
@@ -234,7 +264,12 @@ func (AppsGetLeaderboardResponseExtended) isAppsGetLeaderboard() {}
 
 // GetLeaderboard Returns players rating in the game.
 func (v Apps) GetLeaderboard(params AppsGetLeaderboardParams) (AppsGetLeaderboardResponse, error) {
-	r, err := v.API.Request("apps.getLeaderboard", params)
+	return v.GetLeaderboardContext(context.Background(), params)
+}
+
+// GetLeaderboardContext is GetLeaderboard with context support
+func (v Apps) GetLeaderboardContext(ctx context.Context, params AppsGetLeaderboardParams) (AppsGetLeaderboardResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "apps.getLeaderboard", params)
 	if err != nil {
 		return nil, err
 	}
@@ -242,11 +277,11 @@ func (v Apps) GetLeaderboard(params AppsGetLeaderboardParams) (AppsGetLeaderboar
 	var resp AppsGetLeaderboardResponse
 	if params.Extended {
 		var tmp AppsGetLeaderboardResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp AppsGetLeaderboardResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -266,7 +301,12 @@ type AppsGetScoreResponse int
 
 // GetScore Returns user score in app
 func (v Apps) GetScore(params AppsGetScoreParams) (AppsGetScoreResponse, error) {
-	r, err := v.API.Request("apps.getScore", params)
+	return v.GetScoreContext(context.Background(), params)
+}
+
+// GetScoreContext is GetScore with context support
+func (v Apps) GetScoreContext(ctx context.Context, params AppsGetScoreParams) (AppsGetScoreResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "apps.getScore", params)
 	if err != nil {
 		return 0, err
 	}
@@ -289,6 +329,7 @@ type AppsGetScopesParams struct {
 }
 
 // AppsGetScopesResponse is response for Apps.GetScopes
+//
 //easyjson:json
 type AppsGetScopesResponse struct {
 	// Total number
@@ -304,13 +345,18 @@ type AppsGetScopesResponse struct {
 
 // GetScopes Returns scopes for auth
 func (v Apps) GetScopes(params AppsGetScopesParams) (*AppsGetScopesResponse, error) {
-	r, err := v.API.Request("apps.getScopes", params)
+	return v.GetScopesContext(context.Background(), params)
+}
+
+// GetScopesContext is GetScopes with context support
+func (v Apps) GetScopesContext(ctx context.Context, params AppsGetScopesParams) (*AppsGetScopesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "apps.getScopes", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp AppsGetScopesResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
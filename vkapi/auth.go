@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -23,7 +23,12 @@ type AuthCheckPhoneParams struct {
 
 // CheckPhone Checks a user's phone number for correctness.
 func (v Auth) CheckPhone(params AuthCheckPhoneParams) (bool, error) {
-	r, err := v.API.Request("auth.checkPhone", params)
+	return v.CheckPhoneContext(context.Background(), params)
+}
+
+// CheckPhoneContext is CheckPhone with context support
+func (v Auth) CheckPhoneContext(ctx context.Context, params AuthCheckPhoneParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "auth.checkPhone", params)
 	if err != nil {
 		return false, err
 	}
@@ -40,6 +45,7 @@ type AuthRestoreParams struct {
 }
 
 // AuthRestoreResponse is response for Auth.Restore
+//
 //easyjson:json
 type AuthRestoreResponse struct {
 	// 1 if success
@@ -50,13 +56,18 @@ type AuthRestoreResponse struct {
 
 // Restore Allows to restore account access using a code received via SMS. " This method is only available for apps with [vk.com/dev/auth_direct|Direct authorization] access. "
 func (v Auth) Restore(params AuthRestoreParams) (*AuthRestoreResponse, error) {
-	r, err := v.API.Request("auth.restore", params)
+	return v.RestoreContext(context.Background(), params)
+}
+
+// RestoreContext is Restore with context support
+func (v Auth) RestoreContext(ctx context.Context, params AuthRestoreParams) (*AuthRestoreResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "auth.restore", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp AuthRestoreResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
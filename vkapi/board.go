@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 	"strconv"
 
 	"github.com/stek29/vk"
@@ -39,6 +39,7 @@ type BoardGetTopicsResponse interface {
 }
 
 // BoardGetTopicsResponseNormal is non-extended version of BoardGetTopicsResponse
+//
 //easyjson:json
 type BoardGetTopicsResponseNormal struct {
 	// Total number
@@ -53,6 +54,7 @@ type BoardGetTopicsResponseNormal struct {
 func (BoardGetTopicsResponseNormal) isBoardGetTopics() {}
 
 // BoardGetTopicsResponseExtended is extended version of BoardGetTopicsResponse
+//
 //easyjson:json
 type BoardGetTopicsResponseExtended struct {
 	// Total number
@@ -69,7 +71,12 @@ func (BoardGetTopicsResponseExtended) isBoardGetTopics() {}
 
 // GetTopics Returns a list of topics on a community's discussion board.
 func (v Board) GetTopics(params BoardGetTopicsParams) (BoardGetTopicsResponse, error) {
-	r, err := v.API.Request("board.getTopics", params)
+	return v.GetTopicsContext(context.Background(), params)
+}
+
+// GetTopicsContext is GetTopics with context support
+func (v Board) GetTopicsContext(ctx context.Context, params BoardGetTopicsParams) (BoardGetTopicsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "board.getTopics", params)
 	if err != nil {
 		return nil, err
 	}
@@ -77,11 +84,11 @@ func (v Board) GetTopics(params BoardGetTopicsParams) (BoardGetTopicsResponse, e
 	var resp BoardGetTopicsResponse
 	if params.Extended {
 		var tmp BoardGetTopicsResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp BoardGetTopicsResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -116,6 +123,7 @@ type BoardGetCommentsResponse interface {
 }
 
 // BoardGetCommentsResponseNormal is non-extended version of BoardGetCommentsResponse
+//
 //easyjson:json
 type BoardGetCommentsResponseNormal struct {
 	// Total number
@@ -127,6 +135,7 @@ type BoardGetCommentsResponseNormal struct {
 func (BoardGetCommentsResponseNormal) isBoardGetComments() {}
 
 // BoardGetCommentsResponseExtended is extended version of BoardGetCommentsResponse
+//
 //easyjson:json
 type BoardGetCommentsResponseExtended struct {
 	// Total number
@@ -141,7 +150,12 @@ func (BoardGetCommentsResponseExtended) isBoardGetComments() {}
 
 // GetComments Returns a list of comments on a topic on a community's discussion board.
 func (v Board) GetComments(params BoardGetCommentsParams) (BoardGetCommentsResponse, error) {
-	r, err := v.API.Request("board.getComments", params)
+	return v.GetCommentsContext(context.Background(), params)
+}
+
+// GetCommentsContext is GetComments with context support
+func (v Board) GetCommentsContext(ctx context.Context, params BoardGetCommentsParams) (BoardGetCommentsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "board.getComments", params)
 	if err != nil {
 		return nil, err
 	}
@@ -149,11 +163,11 @@ func (v Board) GetComments(params BoardGetCommentsParams) (BoardGetCommentsRespo
 	var resp BoardGetCommentsResponse
 	if params.Extended {
 		var tmp BoardGetCommentsResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp BoardGetCommentsResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -182,7 +196,12 @@ type BoardAddTopicResponse int
 
 // AddTopic Creates a new topic on a community's discussion board.
 func (v Board) AddTopic(params BoardAddTopicParams) (BoardAddTopicResponse, error) {
-	r, err := v.API.Request("board.addTopic", params)
+	return v.AddTopicContext(context.Background(), params)
+}
+
+// AddTopicContext is AddTopic with context support
+func (v Board) AddTopicContext(ctx context.Context, params BoardAddTopicParams) (BoardAddTopicResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "board.addTopic", params)
 	if err != nil {
 		return 0, err
 	}
@@ -223,7 +242,12 @@ type BoardCreateCommentResponse int
 
 // CreateComment Adds a comment on a topic on a community's discussion board.
 func (v Board) CreateComment(params BoardCreateCommentParams) (BoardCreateCommentResponse, error) {
-	r, err := v.API.Request("board.createComment", params)
+	return v.CreateCommentContext(context.Background(), params)
+}
+
+// CreateCommentContext is CreateComment with context support
+func (v Board) CreateCommentContext(ctx context.Context, params BoardCreateCommentParams) (BoardCreateCommentResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "board.createComment", params)
 	if err != nil {
 		return 0, err
 	}
@@ -250,7 +274,12 @@ type BoardDeleteTopicParams struct {
 
 // DeleteTopic Deletes a topic from a community's discussion board.
 func (v Board) DeleteTopic(params BoardDeleteTopicParams) (bool, error) {
-	r, err := v.API.Request("board.deleteTopic", params)
+	return v.DeleteTopicContext(context.Background(), params)
+}
+
+// DeleteTopicContext is DeleteTopic with context support
+func (v Board) DeleteTopicContext(ctx context.Context, params BoardDeleteTopicParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "board.deleteTopic", params)
 	if err != nil {
 		return false, err
 	}
@@ -270,7 +299,12 @@ type BoardEditTopicParams struct {
 
 // EditTopic Edits the title of a topic on a community's discussion board.
 func (v Board) EditTopic(params BoardEditTopicParams) (bool, error) {
-	r, err := v.API.Request("board.editTopic", params)
+	return v.EditTopicContext(context.Background(), params)
+}
+
+// EditTopicContext is EditTopic with context support
+func (v Board) EditTopicContext(ctx context.Context, params BoardEditTopicParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "board.editTopic", params)
 	if err != nil {
 		return false, err
 	}
@@ -294,7 +328,12 @@ type BoardEditCommentParams struct {
 
 // EditComment Edits a comment on a topic on a community's discussion board.
 func (v Board) EditComment(params BoardEditCommentParams) (bool, error) {
-	r, err := v.API.Request("board.editComment", params)
+	return v.EditCommentContext(context.Background(), params)
+}
+
+// EditCommentContext is EditComment with context support
+func (v Board) EditCommentContext(ctx context.Context, params BoardEditCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "board.editComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -314,7 +353,12 @@ type BoardRestoreCommentParams struct {
 
 // RestoreComment Restores a comment deleted from a topic on a community's discussion board.
 func (v Board) RestoreComment(params BoardRestoreCommentParams) (bool, error) {
-	r, err := v.API.Request("board.restoreComment", params)
+	return v.RestoreCommentContext(context.Background(), params)
+}
+
+// RestoreCommentContext is RestoreComment with context support
+func (v Board) RestoreCommentContext(ctx context.Context, params BoardRestoreCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "board.restoreComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -334,7 +378,12 @@ type BoardDeleteCommentParams struct {
 
 // DeleteComment Deletes a comment on a topic on a community's discussion board.
 func (v Board) DeleteComment(params BoardDeleteCommentParams) (bool, error) {
-	r, err := v.API.Request("board.deleteComment", params)
+	return v.DeleteCommentContext(context.Background(), params)
+}
+
+// DeleteCommentContext is DeleteComment with context support
+func (v Board) DeleteCommentContext(ctx context.Context, params BoardDeleteCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "board.deleteComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -352,7 +401,12 @@ type BoardOpenTopicParams struct {
 
 // OpenTopic Re-opens a previously closed topic on a community's discussion board.
 func (v Board) OpenTopic(params BoardOpenTopicParams) (bool, error) {
-	r, err := v.API.Request("board.openTopic", params)
+	return v.OpenTopicContext(context.Background(), params)
+}
+
+// OpenTopicContext is OpenTopic with context support
+func (v Board) OpenTopicContext(ctx context.Context, params BoardOpenTopicParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "board.openTopic", params)
 	if err != nil {
 		return false, err
 	}
@@ -370,7 +424,12 @@ type BoardCloseTopicParams struct {
 
 // CloseTopic Closes a topic on a community's discussion board so that comments cannot be posted.
 func (v Board) CloseTopic(params BoardCloseTopicParams) (bool, error) {
-	r, err := v.API.Request("board.closeTopic", params)
+	return v.CloseTopicContext(context.Background(), params)
+}
+
+// CloseTopicContext is CloseTopic with context support
+func (v Board) CloseTopicContext(ctx context.Context, params BoardCloseTopicParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "board.closeTopic", params)
 	if err != nil {
 		return false, err
 	}
@@ -388,7 +447,12 @@ type BoardFixTopicParams struct {
 
 // FixTopic Pins a topic (fixes its place) to the top of a community's discussion board.
 func (v Board) FixTopic(params BoardFixTopicParams) (bool, error) {
-	r, err := v.API.Request("board.fixTopic", params)
+	return v.FixTopicContext(context.Background(), params)
+}
+
+// FixTopicContext is FixTopic with context support
+func (v Board) FixTopicContext(ctx context.Context, params BoardFixTopicParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "board.fixTopic", params)
 	if err != nil {
 		return false, err
 	}
@@ -406,7 +470,12 @@ type BoardUnfixTopicParams struct {
 
 // UnfixTopic Unpins a pinned topic from the top of a community's discussion board.
 func (v Board) UnfixTopic(params BoardUnfixTopicParams) (bool, error) {
-	r, err := v.API.Request("board.unfixTopic", params)
+	return v.UnfixTopicContext(context.Background(), params)
+}
+
+// UnfixTopicContext is UnfixTopic with context support
+func (v Board) UnfixTopicContext(ctx context.Context, params BoardUnfixTopicParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "board.unfixTopic", params)
 	if err != nil {
 		return false, err
 	}
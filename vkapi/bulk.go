@@ -0,0 +1,364 @@
+package vkapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stek29/vk"
+)
+
+// Maximal number of IDs accepted by methods at once
+const (
+	UsersGetMaxIDs               = 1000
+	GroupsGetByIDMaxIDs          = 500
+	GroupsIsMemberMaxIDs         = 500
+	SecureSendNotificationMaxIDs = 100
+)
+
+const defaultBulkParallel = 3
+
+// BulkConfig represents configuration used by bulk helpers (i.e. Users.GetMany)
+type BulkConfig struct {
+	// Optional: number of IDs sent in one request,
+	// if 0 or more than maximum of method, maximum is used
+	ChunkSize int
+	// Optional: number of requests made concurrently, if 0, 3 is used
+	Parallel int
+	// Optional: maximal number of requests made per second,
+	// if negative, requests aren't paced
+	//
+	// *vk.ThrottledAPI is used as is. *vk.BaseAPI is wrapped with vk.ThrottledAPI,
+	// if 0, it joins queue of other ThrottledAPIs of its access token with their limit.
+	// Other APIs are paced by helper, if 0, limit of token type is used
+	// if API conforms to vk.TokenTyper (i.e. vkbot.Bot), or vk.UserTokenRequestsPerSecond
+	RequestsPerSecond int
+}
+
+// ChunkError is error of one request made by bulk helper
+type ChunkError struct {
+	// Offset is index of first ID of chunk in input
+	Offset int
+	// Size is number of IDs in chunk
+	Size int
+	Err  error
+}
+
+// Error implements error interface
+func (e *ChunkError) Error() string {
+	return fmt.Sprintf("vkapi.ChunkError: IDs %d-%d: %v", e.Offset, e.Offset+e.Size-1, e.Err)
+}
+
+// Unwrap returns underlying error
+func (e *ChunkError) Unwrap() error {
+	return e.Err
+}
+
+// BulkError is returned by bulk helpers if some of requests have failed,
+// results of successful requests are returned with it
+type BulkError struct {
+	// Chunks are errors of failed requests, ordered by Offset
+	Chunks []*ChunkError
+}
+
+// Error implements error interface
+func (e *BulkError) Error() string {
+	msgs := make([]string, len(e.Chunks))
+	for i, c := range e.Chunks {
+		msgs[i] = c.Error()
+	}
+	return fmt.Sprintf("vkapi.BulkError: %d chunks failed: %s", len(e.Chunks), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns error of first failed chunk,
+// so errors.Is and errors.As can be used with BulkError
+func (e *BulkError) Unwrap() error {
+	if len(e.Chunks) == 0 {
+		return nil
+	}
+	return e.Chunks[0]
+}
+
+// bulk splits IDs into chunks and requests them concurrently
+type bulk struct {
+	n        int
+	size     int
+	parallel int
+	rps      int
+	chunks   int
+}
+
+func newBulk(n, maxSize int, cfg BulkConfig) bulk {
+	b := bulk{
+		n:        n,
+		size:     cfg.ChunkSize,
+		parallel: cfg.Parallel,
+		rps:      cfg.RequestsPerSecond,
+	}
+
+	if b.size <= 0 || b.size > maxSize {
+		b.size = maxSize
+	}
+
+	if b.parallel <= 0 {
+		b.parallel = defaultBulkParallel
+	}
+
+	b.chunks = (n + b.size - 1) / b.size
+	return b
+}
+
+// pace returns api making at most b.rps requests per second,
+// and function which should be called once requests are done
+func (b bulk) pace(api vk.API) (vk.API, func()) {
+	// single request doesn't need pacing
+	if b.rps < 0 || b.chunks <= 1 {
+		return api, func() {}
+	}
+
+	switch api := api.(type) {
+	case *vk.ThrottledAPI:
+		return api, func() {}
+	case *vk.BaseAPI:
+		t := vk.NewThrottledAPI(api, vk.ThrottledAPIConfig{RequestsPerSecond: b.rps})
+		return t, func() { t.Close() }
+	}
+
+	rps := b.rps
+	if rps == 0 {
+		rps = vk.UserTokenRequestsPerSecond
+		if typer, ok := api.(vk.TokenTyper); ok {
+			rps = typer.TokenType().RequestsPerSecond()
+		}
+	}
+
+	return &pacedAPI{API: api, interval: time.Second / time.Duration(rps)}, func() {}
+}
+
+// pacedAPI spaces requests made by bulk helper by interval
+type pacedAPI struct {
+	vk.API
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// Request conforms to API interface
+func (p *pacedAPI) Request(method string, params interface{}) (json.RawMessage, error) {
+	return p.RequestContext(context.Background(), method, params)
+}
+
+// RequestContext conforms to vk.ContextAPI interface
+func (p *pacedAPI) RequestContext(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	p.mu.Lock()
+	at := time.Now()
+	if at.Before(p.next) {
+		at = p.next
+	}
+	p.next = at.Add(p.interval)
+	p.mu.Unlock()
+
+	if d := time.Until(at); d > 0 {
+		timer := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return vk.RequestContext(ctx, p.API, method, params)
+}
+
+// run calls do for each chunk concurrently, do receives api
+// requests should be made with, index of chunk and bounds of its IDs
+//
+// Returns *BulkError if some of chunks have failed
+func (b bulk) run(ctx context.Context, api vk.API, do func(ctx context.Context, api vk.API, chunk, lo, hi int) error) error {
+	api, done := b.pace(api)
+	defer done()
+
+	errs := make([]*ChunkError, b.chunks)
+	sem := make(chan struct{}, b.parallel)
+	var wg sync.WaitGroup
+
+	for i := 0; i < b.chunks; i++ {
+		lo, hi := i*b.size, (i+1)*b.size
+		if hi > b.n {
+			hi = b.n
+		}
+
+		select {
+		case <-ctx.Done():
+			errs[i] = &ChunkError{Offset: lo, Size: hi - lo, Err: ctx.Err()}
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i, lo, hi int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := do(ctx, api, i, lo, hi); err != nil {
+				errs[i] = &ChunkError{Offset: lo, Size: hi - lo, Err: err}
+			}
+		}(i, lo, hi)
+	}
+
+	wg.Wait()
+
+	var bulkErr BulkError
+	for _, err := range errs {
+		if err != nil {
+			bulkErr.Chunks = append(bulkErr.Chunks, err)
+		}
+	}
+
+	if len(bulkErr.Chunks) != 0 {
+		return &bulkErr
+	}
+	return nil
+}
+
+// GetMany is Get for any number of UserIDs, which are split into
+// chunks of up to UsersGetMaxIDs and requested concurrently
+//
+// Users are returned in order of UserIDs. If some of requests fail,
+// users from successful ones are returned with *BulkError
+//
+// If UserIDs is empty, single request is made, returning current user
+func (v Users) GetMany(ctx context.Context, params UsersGetParams, cfg BulkConfig) (UsersGetResponse, error) {
+	ids := params.UserIDs
+	if len(ids) == 0 {
+		return v.GetContext(ctx, params)
+	}
+
+	b := newBulk(len(ids), UsersGetMaxIDs, cfg)
+	results := make([]UsersGetResponse, b.chunks)
+
+	err := b.run(ctx, v.API, func(ctx context.Context, api vk.API, chunk, lo, hi int) error {
+		p := params
+		p.UserIDs = ids[lo:hi]
+
+		resp, err := Users{API: api}.GetContext(ctx, p)
+		results[chunk] = resp
+		return err
+	})
+
+	var merged UsersGetResponse
+	for _, r := range results {
+		merged = append(merged, r...)
+	}
+	return merged, err
+}
+
+// GetByIDMany is GetByID for any number of GroupIDs, see Users.GetMany
+//
+// If GroupIDs is empty, single request is made with GroupID
+func (v Groups) GetByIDMany(ctx context.Context, params GroupsGetByIDParams, cfg BulkConfig) (GroupsGetByIDResponse, error) {
+	ids := params.GroupIDs
+	if len(ids) == 0 {
+		return v.GetByIDContext(ctx, params)
+	}
+
+	b := newBulk(len(ids), GroupsGetByIDMaxIDs, cfg)
+	results := make([]GroupsGetByIDResponse, b.chunks)
+
+	err := b.run(ctx, v.API, func(ctx context.Context, api vk.API, chunk, lo, hi int) error {
+		p := params
+		p.GroupIDs = ids[lo:hi]
+
+		resp, err := Groups{API: api}.GetByIDContext(ctx, p)
+		results[chunk] = resp
+		return err
+	})
+
+	var merged GroupsGetByIDResponse
+	for _, r := range results {
+		merged = append(merged, r...)
+	}
+	return merged, err
+}
+
+// GroupsIsMemberManyItem is membership of one user returned by Groups.IsMemberMany
+type GroupsIsMemberManyItem struct {
+	UserID int `json:"user_id"`
+	// Information whether user is a member of the group
+	Member vk.BoolInt `json:"member"`
+	// Information whether user has been invited to the group,
+	// only set if Extended is true
+	Invitation vk.BoolInt `json:"invitation,omitempty"`
+	// Information whether user has sent request to the group,
+	// only set if Extended is true
+	Request vk.BoolInt `json:"request,omitempty"`
+}
+
+// IsMemberMany is IsMember for any number of UserIDs, see Users.GetMany
+//
+// Membership is returned for every ID in UserIDs, or for UserID if UserIDs is empty
+func (v Groups) IsMemberMany(ctx context.Context, params GroupsIsMemberParams, cfg BulkConfig) ([]GroupsIsMemberManyItem, error) {
+	ids := params.UserIDs
+	if len(ids) == 0 && params.UserID != 0 {
+		ids = CSVIntSlice{params.UserID}
+	}
+
+	b := newBulk(len(ids), GroupsIsMemberMaxIDs, cfg)
+	results := make([][]GroupsIsMemberManyItem, b.chunks)
+
+	err := b.run(ctx, v.API, func(ctx context.Context, api vk.API, chunk, lo, hi int) error {
+		p := params
+		p.UserID = 0
+		p.UserIDs = ids[lo:hi]
+
+		// with user_ids, VK returns array regardless of extended
+		r, err := vk.RequestContext(ctx, api, "groups.isMember", p)
+		if err != nil {
+			return err
+		}
+
+		return vk.Unmarshal(r, &results[chunk])
+	})
+
+	var merged []GroupsIsMemberManyItem
+	for _, r := range results {
+		merged = append(merged, r...)
+	}
+	return merged, err
+}
+
+// SendNotificationMany is SendNotification for any number of UserIDs,
+// see Users.GetMany
+//
+// Notification is sent to every ID in UserIDs, or to UserID if UserIDs is empty.
+// IDs of users notification was sent to are returned
+func (v Secure) SendNotificationMany(ctx context.Context, params SecureSendNotificationParams, cfg BulkConfig) (SecureSendNotificationResponse, error) {
+	ids := params.UserIDs
+	if len(ids) == 0 && params.UserID != 0 {
+		ids = CSVIntSlice{params.UserID}
+	}
+
+	b := newBulk(len(ids), SecureSendNotificationMaxIDs, cfg)
+	results := make([]SecureSendNotificationResponse, b.chunks)
+
+	err := b.run(ctx, v.API, func(ctx context.Context, api vk.API, chunk, lo, hi int) error {
+		p := params
+		p.UserID = 0
+		p.UserIDs = ids[lo:hi]
+
+		resp, err := Secure{API: api}.SendNotificationContext(ctx, p)
+		results[chunk] = resp
+		return err
+	})
+
+	var merged SecureSendNotificationResponse
+	for _, r := range results {
+		merged = append(merged, r...)
+	}
+	return merged, err
+}
@@ -0,0 +1,194 @@
+package vkapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stek29/vk"
+)
+
+func TestUsersGetMany(t *testing.T) {
+	ids := make(CSVStringSlice, 2500)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i + 1)
+	}
+
+	users := Users{API: funcAPI(func(method string, q url.Values) (json.RawMessage, error) {
+		chunk := strings.Split(q.Get("user_ids"), ",")
+		if len(chunk) > UsersGetMaxIDs {
+			t.Errorf("Too many IDs in chunk: %v", len(chunk))
+		}
+
+		if chunk[0] == "1001" {
+			return nil, vk.ErrTooManyRequests
+		}
+
+		var items []string
+		for _, id := range chunk {
+			items = append(items, fmt.Sprintf(`{"id":%s}`, id))
+		}
+		return json.RawMessage("[" + strings.Join(items, ",") + "]"), nil
+	})}
+
+	resp, err := users.GetMany(context.Background(), UsersGetParams{UserIDs: ids}, BulkConfig{})
+
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) || len(bulkErr.Chunks) != 1 || bulkErr.Chunks[0].Offset != 1000 || bulkErr.Chunks[0].Size != 1000 {
+		t.Fatalf("Expected error of second chunk, got %v", err)
+	}
+
+	if !errors.Is(err, vk.ErrTooManyRequests) {
+		t.Errorf("Expected to unwrap to ErrTooMany: %v", err)
+	}
+
+	// results of first and third chunks are returned in order
+	if len(resp) != 1500 || resp[999].ID != 1000 || resp[1000].ID != 2001 || resp[1499].ID != 2500 {
+		t.Errorf("Unexpected users: %v", len(resp))
+	}
+}
+
+func TestGroupsIsMemberMany(t *testing.T) {
+	groups := Groups{API: funcAPI(func(method string, q url.Values) (json.RawMessage, error) {
+		if q.Get("user_id") != "" {
+			t.Errorf("Unexpected user_id: %v", q)
+		}
+
+		var items []string
+		for _, id := range strings.Split(q.Get("user_ids"), ",") {
+			items = append(items, fmt.Sprintf(`{"user_id":%s,"member":1}`, id))
+		}
+		return json.RawMessage("[" + strings.Join(items, ",") + "]"), nil
+	})}
+
+	resp, err := groups.IsMemberMany(context.Background(), GroupsIsMemberParams{
+		GroupID: "1",
+		UserID:  5,
+		UserIDs: CSVIntSlice{1, 2, 3, 4, 5},
+	}, BulkConfig{ChunkSize: 2, Parallel: 2})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(resp) != 5 {
+		t.Fatalf("Unexpected response: %+v", resp)
+	}
+
+	for i, item := range resp {
+		if item.UserID != i+1 || !item.Member {
+			t.Errorf("Unexpected item %v: %+v", i, item)
+		}
+	}
+}
+
+func TestUsersGetManyNoIDs(t *testing.T) {
+	var calls int
+	users := Users{API: funcAPI(func(method string, q url.Values) (json.RawMessage, error) {
+		calls++
+		if method != "users.get" || q.Get("user_ids") != "" {
+			t.Errorf("Unexpected request: %v %v", method, q)
+		}
+		return json.RawMessage(`[{"id":1}]`), nil
+	})}
+
+	resp, err := users.GetMany(context.Background(), UsersGetParams{}, BulkConfig{})
+	if err != nil || calls != 1 || len(resp) != 1 || resp[0].ID != 1 {
+		t.Errorf("Expected current user, got %v, %v (%v calls)", resp, err, calls)
+	}
+}
+
+func TestBulkPaced(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		times []time.Time
+	)
+
+	users := Users{API: funcAPI(func(method string, q url.Values) (json.RawMessage, error) {
+		mu.Lock()
+		times = append(times, time.Now())
+		mu.Unlock()
+		return json.RawMessage("[]"), nil
+	})}
+
+	_, err := users.GetMany(context.Background(), UsersGetParams{UserIDs: CSVStringSlice{"1", "2", "3", "4"}}, BulkConfig{
+		ChunkSize:         1,
+		Parallel:          4,
+		RequestsPerSecond: 20,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(times) != 4 {
+		t.Fatalf("Expected 4 requests, got %v", len(times))
+	}
+
+	// 4 requests at 20 per second take at least 150ms
+	if d := times[3].Sub(times[0]); d < 140*time.Millisecond {
+		t.Errorf("Requests weren't paced: %v", d)
+	}
+}
+
+func TestBulkUserIDOnly(t *testing.T) {
+	var calls []string
+	api := funcAPI(func(method string, q url.Values) (json.RawMessage, error) {
+		calls = append(calls, method+" "+q.Get("user_ids"))
+		if q.Get("user_id") != "" {
+			t.Errorf("Unexpected user_id: %v", q)
+		}
+		if method == "groups.isMember" {
+			return json.RawMessage(`[{"user_id":5,"member":1}]`), nil
+		}
+		return json.RawMessage(`[5]`), nil
+	})
+
+	members, err := Groups{API: api}.IsMemberMany(context.Background(), GroupsIsMemberParams{GroupID: "1", UserID: 5}, BulkConfig{})
+	if err != nil || len(members) != 1 || members[0].UserID != 5 || !members[0].Member {
+		t.Errorf("Unexpected membership: %+v, %v", members, err)
+	}
+
+	sent, err := Secure{API: api}.SendNotificationMany(context.Background(), SecureSendNotificationParams{UserID: 5, Message: "hi"}, BulkConfig{})
+	if err != nil || len(sent) != 1 || sent[0] != 5 {
+		t.Errorf("Unexpected notification result: %v, %v", sent, err)
+	}
+
+	if fmt.Sprint(calls) != "[groups.isMember 5 secure.sendNotification 5]" {
+		t.Errorf("Unexpected calls: %v", calls)
+	}
+}
+
+// groupTokenAPI is funcAPI which uses community token
+type groupTokenAPI struct {
+	funcAPI
+}
+
+func (groupTokenAPI) TokenType() vk.TokenType {
+	return vk.GroupToken
+}
+
+func TestBulkPacedTokenType(t *testing.T) {
+	users := Users{API: groupTokenAPI{funcAPI(func(method string, q url.Values) (json.RawMessage, error) {
+		return json.RawMessage("[]"), nil
+	})}}
+
+	started := time.Now()
+	_, err := users.GetMany(context.Background(), UsersGetParams{UserIDs: CSVStringSlice{"1", "2", "3", "4"}}, BulkConfig{
+		ChunkSize: 1,
+		Parallel:  4,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// 4 requests take a second at user token limit, but 150ms at community one
+	if d := time.Since(started); d < 140*time.Millisecond || d > 500*time.Millisecond {
+		t.Errorf("Requests weren't paced to community token limit: %v", d)
+	}
+}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -24,6 +24,7 @@ type DatabaseGetCountriesParams struct {
 }
 
 // DatabaseGetCountriesResponse is response for Database.GetCountries
+//
 //easyjson:json
 type DatabaseGetCountriesResponse struct {
 	// Total number
@@ -33,13 +34,18 @@ type DatabaseGetCountriesResponse struct {
 
 // GetCountries Returns a list of countries.
 func (v Database) GetCountries(params DatabaseGetCountriesParams) (*DatabaseGetCountriesResponse, error) {
-	r, err := v.API.Request("database.getCountries", params)
+	return v.GetCountriesContext(context.Background(), params)
+}
+
+// GetCountriesContext is GetCountries with context support
+func (v Database) GetCountriesContext(ctx context.Context, params DatabaseGetCountriesParams) (*DatabaseGetCountriesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "database.getCountries", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DatabaseGetCountriesResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +65,7 @@ type DatabaseGetRegionsParams struct {
 }
 
 // DatabaseGetRegionsResponse is response for Database.GetRegions
+//
 //easyjson:json
 type DatabaseGetRegionsResponse struct {
 	// Total number
@@ -68,13 +75,18 @@ type DatabaseGetRegionsResponse struct {
 
 // GetRegions Returns a list of regions.
 func (v Database) GetRegions(params DatabaseGetRegionsParams) (*DatabaseGetRegionsResponse, error) {
-	r, err := v.API.Request("database.getRegions", params)
+	return v.GetRegionsContext(context.Background(), params)
+}
+
+// GetRegionsContext is GetRegions with context support
+func (v Database) GetRegionsContext(ctx context.Context, params DatabaseGetRegionsParams) (*DatabaseGetRegionsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "database.getRegions", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DatabaseGetRegionsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -88,18 +100,24 @@ type DatabaseGetCountriesByIDParams struct {
 }
 
 // DatabaseGetCountriesByIDResponse is response for Database.GetCountriesByID
+//
 //easyjson:json
 type DatabaseGetCountriesByIDResponse []vk.BaseObject
 
 // GetCountriesByID Returns information about countries by their IDs.
 func (v Database) GetCountriesByID(params DatabaseGetCountriesByIDParams) (DatabaseGetCountriesByIDResponse, error) {
-	r, err := v.API.Request("database.getCountriesById", params)
+	return v.GetCountriesByIDContext(context.Background(), params)
+}
+
+// GetCountriesByIDContext is GetCountriesByID with context support
+func (v Database) GetCountriesByIDContext(ctx context.Context, params DatabaseGetCountriesByIDParams) (DatabaseGetCountriesByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "database.getCountriesById", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DatabaseGetCountriesByIDResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +141,7 @@ type DatabaseGetCitiesParams struct {
 }
 
 // DatabaseGetCitiesResponse is response for Database.GetCities
+//
 //easyjson:json
 type DatabaseGetCitiesResponse struct {
 	// Total number
@@ -132,13 +151,18 @@ type DatabaseGetCitiesResponse struct {
 
 // GetCities Returns a list of cities.
 func (v Database) GetCities(params DatabaseGetCitiesParams) (*DatabaseGetCitiesResponse, error) {
-	r, err := v.API.Request("database.getCities", params)
+	return v.GetCitiesContext(context.Background(), params)
+}
+
+// GetCitiesContext is GetCities with context support
+func (v Database) GetCitiesContext(ctx context.Context, params DatabaseGetCitiesParams) (*DatabaseGetCitiesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "database.getCities", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DatabaseGetCitiesResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -152,18 +176,24 @@ type DatabaseGetCitiesByIDParams struct {
 }
 
 // DatabaseGetCitiesByIDResponse is response for Database.GetCitiesByID
+//
 //easyjson:json
 type DatabaseGetCitiesByIDResponse []vk.BaseObject
 
 // GetCitiesByID Returns information about cities by their IDs.
 func (v Database) GetCitiesByID(params DatabaseGetCitiesByIDParams) (DatabaseGetCitiesByIDResponse, error) {
-	r, err := v.API.Request("database.getCitiesById", params)
+	return v.GetCitiesByIDContext(context.Background(), params)
+}
+
+// GetCitiesByIDContext is GetCitiesByID with context support
+func (v Database) GetCitiesByIDContext(ctx context.Context, params DatabaseGetCitiesByIDParams) (DatabaseGetCitiesByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "database.getCitiesById", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DatabaseGetCitiesByIDResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -185,6 +215,7 @@ type DatabaseGetUniversitiesParams struct {
 }
 
 // DatabaseGetUniversitiesResponse is response for Database.GetUniversities
+//
 //easyjson:json
 type DatabaseGetUniversitiesResponse struct {
 	// Total number
@@ -194,13 +225,18 @@ type DatabaseGetUniversitiesResponse struct {
 
 // GetUniversities Returns a list of higher education institutions.
 func (v Database) GetUniversities(params DatabaseGetUniversitiesParams) (*DatabaseGetUniversitiesResponse, error) {
-	r, err := v.API.Request("database.getUniversities", params)
+	return v.GetUniversitiesContext(context.Background(), params)
+}
+
+// GetUniversitiesContext is GetUniversities with context support
+func (v Database) GetUniversitiesContext(ctx context.Context, params DatabaseGetUniversitiesParams) (*DatabaseGetUniversitiesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "database.getUniversities", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DatabaseGetUniversitiesResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -220,6 +256,7 @@ type DatabaseGetSchoolsParams struct {
 }
 
 // DatabaseGetSchoolsResponse is response for Database.GetSchools
+//
 //easyjson:json
 type DatabaseGetSchoolsResponse struct {
 	// Total number
@@ -229,13 +266,18 @@ type DatabaseGetSchoolsResponse struct {
 
 // GetSchools Returns a list of schools.
 func (v Database) GetSchools(params DatabaseGetSchoolsParams) (*DatabaseGetSchoolsResponse, error) {
-	r, err := v.API.Request("database.getSchools", params)
+	return v.GetSchoolsContext(context.Background(), params)
+}
+
+// GetSchoolsContext is GetSchools with context support
+func (v Database) GetSchoolsContext(ctx context.Context, params DatabaseGetSchoolsParams) (*DatabaseGetSchoolsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "database.getSchools", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DatabaseGetSchoolsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -250,18 +292,24 @@ type DatabaseGetSchoolClassesParams struct {
 
 // DatabaseGetSchoolClassesResponse is response for Database.GetSchoolClasses
 // Class ID or letter
+//
 //easyjson:json
 type DatabaseGetSchoolClassesResponse [][]genTODOType
 
 // GetSchoolClasses Returns a list of school classes specified for the country.
 func (v Database) GetSchoolClasses(params DatabaseGetSchoolClassesParams) (DatabaseGetSchoolClassesResponse, error) {
-	r, err := v.API.Request("database.getSchoolClasses", params)
+	return v.GetSchoolClassesContext(context.Background(), params)
+}
+
+// GetSchoolClassesContext is GetSchoolClasses with context support
+func (v Database) GetSchoolClassesContext(ctx context.Context, params DatabaseGetSchoolClassesParams) (DatabaseGetSchoolClassesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "database.getSchoolClasses", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DatabaseGetSchoolClassesResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -279,6 +327,7 @@ type DatabaseGetFacultiesParams struct {
 }
 
 // DatabaseGetFacultiesResponse is response for Database.GetFaculties
+//
 //easyjson:json
 type DatabaseGetFacultiesResponse struct {
 	// Total number
@@ -288,13 +337,18 @@ type DatabaseGetFacultiesResponse struct {
 
 // GetFaculties Returns a list of faculties (i.e., university departments).
 func (v Database) GetFaculties(params DatabaseGetFacultiesParams) (*DatabaseGetFacultiesResponse, error) {
-	r, err := v.API.Request("database.getFaculties", params)
+	return v.GetFacultiesContext(context.Background(), params)
+}
+
+// GetFacultiesContext is GetFaculties with context support
+func (v Database) GetFacultiesContext(ctx context.Context, params DatabaseGetFacultiesParams) (*DatabaseGetFacultiesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "database.getFaculties", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DatabaseGetFacultiesResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -312,6 +366,7 @@ type DatabaseGetChairsParams struct {
 }
 
 // DatabaseGetChairsResponse is response for Database.GetChairs
+//
 //easyjson:json
 type DatabaseGetChairsResponse struct {
 	// Total number
@@ -321,13 +376,18 @@ type DatabaseGetChairsResponse struct {
 
 // GetChairs Returns list of chairs on a specified faculty.
 func (v Database) GetChairs(params DatabaseGetChairsParams) (*DatabaseGetChairsResponse, error) {
-	r, err := v.API.Request("database.getChairs", params)
+	return v.GetChairsContext(context.Background(), params)
+}
+
+// GetChairsContext is GetChairs with context support
+func (v Database) GetChairsContext(ctx context.Context, params DatabaseGetChairsParams) (*DatabaseGetChairsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "database.getChairs", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DatabaseGetChairsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -343,6 +403,7 @@ type DatabaseGetMetroStationsParams struct {
 }
 
 // DatabaseGetMetroStationsResponse is response for Database.GetMetroStations
+//
 //easyjson:json
 type DatabaseGetMetroStationsResponse struct {
 	// Total number
@@ -361,13 +422,18 @@ type DatabaseGetMetroStationsResponse struct {
 
 // GetMetroStations Get metro stations by city
 func (v Database) GetMetroStations(params DatabaseGetMetroStationsParams) (*DatabaseGetMetroStationsResponse, error) {
-	r, err := v.API.Request("database.getMetroStations", params)
+	return v.GetMetroStationsContext(context.Background(), params)
+}
+
+// GetMetroStationsContext is GetMetroStations with context support
+func (v Database) GetMetroStationsContext(ctx context.Context, params DatabaseGetMetroStationsParams) (*DatabaseGetMetroStationsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "database.getMetroStations", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DatabaseGetMetroStationsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -380,6 +446,7 @@ type DatabaseGetMetroStationsByIDParams struct {
 }
 
 // DatabaseGetMetroStationsByIDResponse is response for Database.GetMetroStationsByID
+//
 //easyjson:json
 type DatabaseGetMetroStationsByIDResponse []struct {
 	// City ID
@@ -394,13 +461,18 @@ type DatabaseGetMetroStationsByIDResponse []struct {
 
 // GetMetroStationsByID Get metro station by his id
 func (v Database) GetMetroStationsByID(params DatabaseGetMetroStationsByIDParams) (DatabaseGetMetroStationsByIDResponse, error) {
-	r, err := v.API.Request("database.getMetroStationsById", params)
+	return v.GetMetroStationsByIDContext(context.Background(), params)
+}
+
+// GetMetroStationsByIDContext is GetMetroStationsByID with context support
+func (v Database) GetMetroStationsByIDContext(ctx context.Context, params DatabaseGetMetroStationsByIDParams) (DatabaseGetMetroStationsByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "database.getMetroStationsById", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DatabaseGetMetroStationsByIDResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,121 @@
+package vkapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/mailru/easyjson"
+	"github.com/stek29/vk"
+)
+
+// easyjsonGenerated checks if easyjson code was generated with go generate
+func easyjsonGenerated() bool {
+	_, ok := interface{}(&UsersGetResponse{}).(easyjson.Unmarshaler)
+	return ok
+}
+
+func TestResponsesImplementEasyJSON(t *testing.T) {
+	// vk.Unmarshal falls back to encoding/json without generated code
+	if !easyjsonGenerated() {
+		t.Skip("easyjson code isn't generated, run go generate ./vkapi")
+	}
+
+	responses := []interface{}{
+		&MessagesGetHistoryResponse{},
+		&GroupsGetMembersResponse{},
+		&UsersGetResponse{},
+		&WallGetResponseNormal{},
+	}
+
+	for _, r := range responses {
+		if _, ok := r.(easyjson.Unmarshaler); !ok {
+			t.Errorf("%T doesn't implement easyjson.Unmarshaler, check its easyjson:json comment", r)
+		}
+	}
+}
+
+// historyPayload is messages.getHistory response with n messages
+func historyPayload(n int) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `{"response":{"count":%d,"items":[`, n)
+	for i := 0; i < n; i++ {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, `{"id":%d,"date":1577836800,"peer_id":2000000001,"from_id":%d,`+
+			`"text":"Message number %d with some text in it","conversation_message_id":%d,`+
+			`"fwd_messages":[],"important":false,"random_id":0,"attachments":[],"is_hidden":false}`, i+1, 1000+i, i, i+1)
+	}
+	b.WriteString(`],"profiles":[{"id":1000,"first_name":"Pavel","last_name":"Durov"}]}}`)
+	return b.Bytes()
+}
+
+// membersPayload is groups.getMembers response with n members
+func membersPayload(n int) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `{"response":{"count":%d,"items":[`, n)
+	for i := 0; i < n; i++ {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, "%d", i+1)
+	}
+	b.WriteString(`]}}`)
+	return b.Bytes()
+}
+
+// BenchmarkDecodeResponse compares decoding of envelope and typed
+// response with encoding/json and with vk.Unmarshal used by wrappers
+//
+// In both cases response is scanned twice: once as part of envelope,
+// and once when it's decoded into typed response
+func BenchmarkDecodeResponse(b *testing.B) {
+	payloads := []struct {
+		name    string
+		data    []byte
+		newResp func() interface{}
+	}{
+		{"messages.getHistory", historyPayload(200), func() interface{} { return &MessagesGetHistoryResponse{} }},
+		{"groups.getMembers", membersPayload(1000), func() interface{} { return &GroupsGetMembersResponse{} }},
+	}
+
+	for _, p := range payloads {
+		p := p
+
+		b.Run(p.name+"/encoding_json", func(b *testing.B) {
+			b.SetBytes(int64(len(p.data)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				var env struct {
+					Response json.RawMessage `json:"response"`
+				}
+				if err := json.Unmarshal(p.data, &env); err != nil {
+					b.Fatal(err)
+				}
+				if err := json.Unmarshal(env.Response, p.newResp()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		b.Run(p.name+"/easyjson", func(b *testing.B) {
+			if !easyjsonGenerated() {
+				b.Skip("easyjson code isn't generated, run go generate ./vkapi")
+			}
+
+			b.SetBytes(int64(len(p.data)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				var env vk.APIResponse
+				if err := vk.Unmarshal(p.data, &env); err != nil {
+					b.Fatal(err)
+				}
+				if err := vk.Unmarshal(env.Response, p.newResp()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -23,6 +23,7 @@ type DocsGetParams struct {
 }
 
 // DocsGetResponse is response for Docs.Get
+//
 //easyjson:json
 type DocsGetResponse struct {
 	// Total number
@@ -32,13 +33,18 @@ type DocsGetResponse struct {
 
 // Get Returns detailed information about user or community documents.
 func (v Docs) Get(params DocsGetParams) (*DocsGetResponse, error) {
-	r, err := v.API.Request("docs.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Docs) GetContext(ctx context.Context, params DocsGetParams) (*DocsGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "docs.get", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DocsGetResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -52,18 +58,24 @@ type DocsGetByIDParams struct {
 }
 
 // DocsGetByIDResponse is response for Docs.GetByID
+//
 //easyjson:json
 type DocsGetByIDResponse []vk.Document
 
 // GetByID Returns information about documents by their IDs.
 func (v Docs) GetByID(params DocsGetByIDParams) (DocsGetByIDResponse, error) {
-	r, err := v.API.Request("docs.getById", params)
+	return v.GetByIDContext(context.Background(), params)
+}
+
+// GetByIDContext is GetByID with context support
+func (v Docs) GetByIDContext(ctx context.Context, params DocsGetByIDParams) (DocsGetByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "docs.getById", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DocsGetByIDResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +89,7 @@ type DocsGetUploadServerParams struct {
 }
 
 // DocsGetUploadServerResponse is response for Docs.GetUploadServer
+//
 //easyjson:json
 type DocsGetUploadServerResponse struct {
 	// Upload URL
@@ -85,13 +98,18 @@ type DocsGetUploadServerResponse struct {
 
 // GetUploadServer Returns the server address for document upload.
 func (v Docs) GetUploadServer(params DocsGetUploadServerParams) (*DocsGetUploadServerResponse, error) {
-	r, err := v.API.Request("docs.getUploadServer", params)
+	return v.GetUploadServerContext(context.Background(), params)
+}
+
+// GetUploadServerContext is GetUploadServer with context support
+func (v Docs) GetUploadServerContext(ctx context.Context, params DocsGetUploadServerParams) (*DocsGetUploadServerResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "docs.getUploadServer", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DocsGetUploadServerResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +123,7 @@ type DocsGetWallUploadServerParams struct {
 }
 
 // DocsGetWallUploadServerResponse is response for Docs.GetWallUploadServer
+//
 //easyjson:json
 type DocsGetWallUploadServerResponse struct {
 	// Upload URL
@@ -113,13 +132,18 @@ type DocsGetWallUploadServerResponse struct {
 
 // GetWallUploadServer Returns the server address for document upload onto a user's or community's wall.
 func (v Docs) GetWallUploadServer(params DocsGetWallUploadServerParams) (*DocsGetWallUploadServerResponse, error) {
-	r, err := v.API.Request("docs.getWallUploadServer", params)
+	return v.GetWallUploadServerContext(context.Background(), params)
+}
+
+// GetWallUploadServerContext is GetWallUploadServer with context support
+func (v Docs) GetWallUploadServerContext(ctx context.Context, params DocsGetWallUploadServerParams) (*DocsGetWallUploadServerResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "docs.getWallUploadServer", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DocsGetWallUploadServerResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +159,7 @@ type DocsGetMessagesUploadServerParams struct {
 }
 
 // DocsGetMessagesUploadServerResponse is response for Docs.GetMessagesUploadServer
+//
 //easyjson:json
 type DocsGetMessagesUploadServerResponse struct {
 	// Upload URL
@@ -143,13 +168,18 @@ type DocsGetMessagesUploadServerResponse struct {
 
 // GetMessagesUploadServer Returns the server address for document upload.
 func (v Docs) GetMessagesUploadServer(params DocsGetMessagesUploadServerParams) (*DocsGetMessagesUploadServerResponse, error) {
-	r, err := v.API.Request("docs.getMessagesUploadServer", params)
+	return v.GetMessagesUploadServerContext(context.Background(), params)
+}
+
+// GetMessagesUploadServerContext is GetMessagesUploadServer with context support
+func (v Docs) GetMessagesUploadServerContext(ctx context.Context, params DocsGetMessagesUploadServerParams) (*DocsGetMessagesUploadServerResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "docs.getMessagesUploadServer", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DocsGetMessagesUploadServerResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -167,18 +197,24 @@ type DocsSaveParams struct {
 }
 
 // DocsSaveResponse is response for Docs.Save
+//
 //easyjson:json
 type DocsSaveResponse []vk.Document
 
 // Save Saves a document after [vk.com/dev/upload_files_2|uploading it to a server].
 func (v Docs) Save(params DocsSaveParams) (DocsSaveResponse, error) {
-	r, err := v.API.Request("docs.save", params)
+	return v.SaveContext(context.Background(), params)
+}
+
+// SaveContext is Save with context support
+func (v Docs) SaveContext(ctx context.Context, params DocsSaveParams) (DocsSaveResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "docs.save", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DocsSaveResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -195,7 +231,12 @@ type DocsDeleteParams struct {
 
 // Delete Deletes a user or community document.
 func (v Docs) Delete(params DocsDeleteParams) (bool, error) {
-	r, err := v.API.Request("docs.delete", params)
+	return v.DeleteContext(context.Background(), params)
+}
+
+// DeleteContext is Delete with context support
+func (v Docs) DeleteContext(ctx context.Context, params DocsDeleteParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "docs.delete", params)
 	if err != nil {
 		return false, err
 	}
@@ -214,6 +255,7 @@ type DocsAddParams struct {
 }
 
 // DocsAddResponse is response for Docs.Add
+//
 //easyjson:json
 type DocsAddResponse struct {
 	// Doc ID
@@ -222,13 +264,18 @@ type DocsAddResponse struct {
 
 // Add Copies a document to a user's or community's document list.
 func (v Docs) Add(params DocsAddParams) (*DocsAddResponse, error) {
-	r, err := v.API.Request("docs.add", params)
+	return v.AddContext(context.Background(), params)
+}
+
+// AddContext is Add with context support
+func (v Docs) AddContext(ctx context.Context, params DocsAddParams) (*DocsAddResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "docs.add", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DocsAddResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -242,6 +289,7 @@ type DocsGetTypesParams struct {
 }
 
 // DocsGetTypesResponse is response for Docs.GetTypes
+//
 //easyjson:json
 type DocsGetTypesResponse struct {
 	// Total number
@@ -258,13 +306,18 @@ type DocsGetTypesResponse struct {
 
 // GetTypes Returns documents types available for current user.
 func (v Docs) GetTypes(params DocsGetTypesParams) (*DocsGetTypesResponse, error) {
-	r, err := v.API.Request("docs.getTypes", params)
+	return v.GetTypesContext(context.Background(), params)
+}
+
+// GetTypesContext is GetTypes with context support
+func (v Docs) GetTypesContext(ctx context.Context, params DocsGetTypesParams) (*DocsGetTypesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "docs.getTypes", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DocsGetTypesResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -283,6 +336,7 @@ type DocsSearchParams struct {
 }
 
 // DocsSearchResponse is response for Docs.Search
+//
 //easyjson:json
 type DocsSearchResponse struct {
 	// Total number
@@ -292,13 +346,18 @@ type DocsSearchResponse struct {
 
 // Search Returns a list of documents matching the search criteria.
 func (v Docs) Search(params DocsSearchParams) (*DocsSearchResponse, error) {
-	r, err := v.API.Request("docs.search", params)
+	return v.SearchContext(context.Background(), params)
+}
+
+// SearchContext is Search with context support
+func (v Docs) SearchContext(ctx context.Context, params DocsSearchParams) (*DocsSearchResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "docs.search", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp DocsSearchResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -319,7 +378,12 @@ type DocsEditParams struct {
 
 // Edit Edits a document.
 func (v Docs) Edit(params DocsEditParams) (bool, error) {
-	r, err := v.API.Request("docs.edit", params)
+	return v.EditContext(context.Background(), params)
+}
+
+// EditContext is Edit with context support
+func (v Docs) EditContext(ctx context.Context, params DocsEditParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "docs.edit", params)
 	if err != nil {
 		return false, err
 	}
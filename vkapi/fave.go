@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -20,6 +20,7 @@ type FaveGetUsersParams struct {
 }
 
 // FaveGetUsersResponse is response for Fave.GetUsers
+//
 //easyjson:json
 type FaveGetUsersResponse struct {
 	// Total number
@@ -29,13 +30,18 @@ type FaveGetUsersResponse struct {
 
 // GetUsers Returns a list of users whom the current user has bookmarked.
 func (v Fave) GetUsers(params FaveGetUsersParams) (*FaveGetUsersResponse, error) {
-	r, err := v.API.Request("fave.getUsers", params)
+	return v.GetUsersContext(context.Background(), params)
+}
+
+// GetUsersContext is GetUsers with context support
+func (v Fave) GetUsersContext(ctx context.Context, params FaveGetUsersParams) (*FaveGetUsersResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "fave.getUsers", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FaveGetUsersResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -51,6 +57,7 @@ type FaveGetPhotosParams struct {
 }
 
 // FaveGetPhotosResponse is response for Fave.GetPhotos
+//
 //easyjson:json
 type FaveGetPhotosResponse struct {
 	// Total number
@@ -60,13 +67,18 @@ type FaveGetPhotosResponse struct {
 
 // GetPhotos Returns a list of photos that the current user has liked.
 func (v Fave) GetPhotos(params FaveGetPhotosParams) (*FaveGetPhotosResponse, error) {
-	r, err := v.API.Request("fave.getPhotos", params)
+	return v.GetPhotosContext(context.Background(), params)
+}
+
+// GetPhotosContext is GetPhotos with context support
+func (v Fave) GetPhotosContext(ctx context.Context, params FaveGetPhotosParams) (*FaveGetPhotosResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "fave.getPhotos", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FaveGetPhotosResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +96,7 @@ type FaveGetPostsParams struct {
 }
 
 // FaveGetPostsResponse is response for Fave.GetPosts
+//
 //easyjson:json
 type FaveGetPostsResponse struct {
 	// Total number
@@ -93,13 +106,18 @@ type FaveGetPostsResponse struct {
 
 // GetPosts Returns a list of wall posts that the current user has liked.
 func (v Fave) GetPosts(params FaveGetPostsParams) (*FaveGetPostsResponse, error) {
-	r, err := v.API.Request("fave.getPosts", params)
+	return v.GetPostsContext(context.Background(), params)
+}
+
+// GetPostsContext is GetPosts with context support
+func (v Fave) GetPostsContext(ctx context.Context, params FaveGetPostsParams) (*FaveGetPostsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "fave.getPosts", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FaveGetPostsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +135,7 @@ type FaveGetVideosParams struct {
 }
 
 // FaveGetVideosResponse is response for Fave.GetVideos
+//
 //easyjson:json
 type FaveGetVideosResponse struct {
 	// Total number
@@ -126,13 +145,18 @@ type FaveGetVideosResponse struct {
 
 // GetVideos Returns a list of videos that the current user has liked.
 func (v Fave) GetVideos(params FaveGetVideosParams) (*FaveGetVideosResponse, error) {
-	r, err := v.API.Request("fave.getVideos", params)
+	return v.GetVideosContext(context.Background(), params)
+}
+
+// GetVideosContext is GetVideos with context support
+func (v Fave) GetVideosContext(ctx context.Context, params FaveGetVideosParams) (*FaveGetVideosResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "fave.getVideos", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FaveGetVideosResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -148,6 +172,7 @@ type FaveGetLinksParams struct {
 }
 
 // FaveGetLinksResponse is response for Fave.GetLinks
+//
 //easyjson:json
 type FaveGetLinksResponse struct {
 	// Total number
@@ -157,13 +182,18 @@ type FaveGetLinksResponse struct {
 
 // GetLinks Returns a list of links that the current user has bookmarked.
 func (v Fave) GetLinks(params FaveGetLinksParams) (*FaveGetLinksResponse, error) {
-	r, err := v.API.Request("fave.getLinks", params)
+	return v.GetLinksContext(context.Background(), params)
+}
+
+// GetLinksContext is GetLinks with context support
+func (v Fave) GetLinksContext(ctx context.Context, params FaveGetLinksParams) (*FaveGetLinksResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "fave.getLinks", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FaveGetLinksResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -180,6 +210,7 @@ type FaveGetMarketItemsParams struct {
 }
 
 // FaveGetMarketItemsResponse is response for Fave.GetMarketItems
+//
 //easyjson:json
 type FaveGetMarketItemsResponse struct {
 	// Total number
@@ -189,13 +220,18 @@ type FaveGetMarketItemsResponse struct {
 
 // GetMarketItems Returns market items bookmarked by current user.
 func (v Fave) GetMarketItems(params FaveGetMarketItemsParams) (*FaveGetMarketItemsResponse, error) {
-	r, err := v.API.Request("fave.getMarketItems", params)
+	return v.GetMarketItemsContext(context.Background(), params)
+}
+
+// GetMarketItemsContext is GetMarketItems with context support
+func (v Fave) GetMarketItemsContext(ctx context.Context, params FaveGetMarketItemsParams) (*FaveGetMarketItemsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "fave.getMarketItems", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FaveGetMarketItemsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -210,7 +246,12 @@ type FaveAddUserParams struct {
 
 // AddUser Adds a profile to user faves.
 func (v Fave) AddUser(params FaveAddUserParams) (bool, error) {
-	r, err := v.API.Request("fave.addUser", params)
+	return v.AddUserContext(context.Background(), params)
+}
+
+// AddUserContext is AddUser with context support
+func (v Fave) AddUserContext(ctx context.Context, params FaveAddUserParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "fave.addUser", params)
 	if err != nil {
 		return false, err
 	}
@@ -226,7 +267,12 @@ type FaveRemoveUserParams struct {
 
 // RemoveUser Removes a profile from user faves.
 func (v Fave) RemoveUser(params FaveRemoveUserParams) (bool, error) {
-	r, err := v.API.Request("fave.removeUser", params)
+	return v.RemoveUserContext(context.Background(), params)
+}
+
+// RemoveUserContext is RemoveUser with context support
+func (v Fave) RemoveUserContext(ctx context.Context, params FaveRemoveUserParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "fave.removeUser", params)
 	if err != nil {
 		return false, err
 	}
@@ -242,7 +288,12 @@ type FaveAddGroupParams struct {
 
 // AddGroup Adds a community to user faves.
 func (v Fave) AddGroup(params FaveAddGroupParams) (bool, error) {
-	r, err := v.API.Request("fave.addGroup", params)
+	return v.AddGroupContext(context.Background(), params)
+}
+
+// AddGroupContext is AddGroup with context support
+func (v Fave) AddGroupContext(ctx context.Context, params FaveAddGroupParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "fave.addGroup", params)
 	if err != nil {
 		return false, err
 	}
@@ -258,7 +309,12 @@ type FaveRemoveGroupParams struct {
 
 // RemoveGroup Removes a community from user faves.
 func (v Fave) RemoveGroup(params FaveRemoveGroupParams) (bool, error) {
-	r, err := v.API.Request("fave.removeGroup", params)
+	return v.RemoveGroupContext(context.Background(), params)
+}
+
+// RemoveGroupContext is RemoveGroup with context support
+func (v Fave) RemoveGroupContext(ctx context.Context, params FaveRemoveGroupParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "fave.removeGroup", params)
 	if err != nil {
 		return false, err
 	}
@@ -274,7 +330,12 @@ type FaveAddLinkParams struct {
 
 // AddLink Adds a link to user faves.
 func (v Fave) AddLink(params FaveAddLinkParams) (bool, error) {
-	r, err := v.API.Request("fave.addLink", params)
+	return v.AddLinkContext(context.Background(), params)
+}
+
+// AddLinkContext is AddLink with context support
+func (v Fave) AddLinkContext(ctx context.Context, params FaveAddLinkParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "fave.addLink", params)
 	if err != nil {
 		return false, err
 	}
@@ -290,7 +351,12 @@ type FaveRemoveLinkParams struct {
 
 // RemoveLink Removes link from the user's faves.
 func (v Fave) RemoveLink(params FaveRemoveLinkParams) (bool, error) {
-	r, err := v.API.Request("fave.removeLink", params)
+	return v.RemoveLinkContext(context.Background(), params)
+}
+
+// RemoveLinkContext is RemoveLink with context support
+func (v Fave) RemoveLinkContext(ctx context.Context, params FaveRemoveLinkParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "fave.removeLink", params)
 	if err != nil {
 		return false, err
 	}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 	"strconv"
 
 	"github.com/stek29/vk"
@@ -31,6 +31,7 @@ type FriendsGetParams struct {
 }
 
 // FriendsGetResponse is response for Friends.Get
+//
 //easyjson:json
 type FriendsGetResponse struct {
 	// Total friends number
@@ -41,13 +42,18 @@ type FriendsGetResponse struct {
 
 // Get Returns a list of user IDs or detailed information about a user's friends.
 func (v Friends) Get(params FriendsGetParams) (*FriendsGetResponse, error) {
-	r, err := v.API.Request("friends.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Friends) GetContext(ctx context.Context, params FriendsGetParams) (*FriendsGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.get", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FriendsGetResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -72,18 +78,24 @@ type FriendsGetOnlineParams struct {
 
 // FriendsGetOnlineResponse is response for Friends.GetOnline
 // User ID
+//
 //easyjson:json
 type FriendsGetOnlineResponse []int
 
 // GetOnline Returns a list of user IDs of a user's friends who are online.
 func (v Friends) GetOnline(params FriendsGetOnlineParams) (FriendsGetOnlineResponse, error) {
-	r, err := v.API.Request("friends.getOnline", params)
+	return v.GetOnlineContext(context.Background(), params)
+}
+
+// GetOnlineContext is GetOnline with context support
+func (v Friends) GetOnlineContext(ctx context.Context, params FriendsGetOnlineParams) (FriendsGetOnlineResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.getOnline", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FriendsGetOnlineResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -108,18 +120,24 @@ type FriendsGetMutualParams struct {
 
 // FriendsGetMutualResponse is response for Friends.GetMutual
 // User ID
+//
 //easyjson:json
 type FriendsGetMutualResponse []int
 
 // GetMutual Returns a list of user IDs of the mutual friends of two users.
 func (v Friends) GetMutual(params FriendsGetMutualParams) (FriendsGetMutualResponse, error) {
-	r, err := v.API.Request("friends.getMutual", params)
+	return v.GetMutualContext(context.Background(), params)
+}
+
+// GetMutualContext is GetMutual with context support
+func (v Friends) GetMutualContext(ctx context.Context, params FriendsGetMutualParams) (FriendsGetMutualResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.getMutual", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FriendsGetMutualResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -134,18 +152,24 @@ type FriendsGetRecentParams struct {
 
 // FriendsGetRecentResponse is response for Friends.GetRecent
 // User ID
+//
 //easyjson:json
 type FriendsGetRecentResponse []int
 
 // GetRecent Returns a list of user IDs of the current user's recently added friends.
 func (v Friends) GetRecent(params FriendsGetRecentParams) (FriendsGetRecentResponse, error) {
-	r, err := v.API.Request("friends.getRecent", params)
+	return v.GetRecentContext(context.Background(), params)
+}
+
+// GetRecentContext is GetRecent with context support
+func (v Friends) GetRecentContext(ctx context.Context, params FriendsGetRecentParams) (FriendsGetRecentResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.getRecent", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FriendsGetRecentResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -178,6 +202,7 @@ type FriendsGetRequestsResponse interface {
 }
 
 // FriendsGetRequestsResponseNormal is non-extended version of FriendsGetRequestsResponse
+//
 //easyjson:json
 type FriendsGetRequestsResponseNormal struct {
 	// Total requests number
@@ -191,6 +216,7 @@ type FriendsGetRequestsResponseNormal struct {
 func (FriendsGetRequestsResponseNormal) isFriendsGetRequests() {}
 
 // FriendsGetRequestsResponseExtended is extended version of FriendsGetRequestsResponse
+//
 //easyjson:json
 type FriendsGetRequestsResponseExtended struct {
 	// Total requests number
@@ -215,7 +241,12 @@ func (FriendsGetRequestsResponseExtended) isFriendsGetRequests() {}
 
 // GetRequests Returns information about the current user's incoming and outgoing friend requests.
 func (v Friends) GetRequests(params FriendsGetRequestsParams) (FriendsGetRequestsResponse, error) {
-	r, err := v.API.Request("friends.getRequests", params)
+	return v.GetRequestsContext(context.Background(), params)
+}
+
+// GetRequestsContext is GetRequests with context support
+func (v Friends) GetRequestsContext(ctx context.Context, params FriendsGetRequestsParams) (FriendsGetRequestsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.getRequests", params)
 	if err != nil {
 		return nil, err
 	}
@@ -223,11 +254,11 @@ func (v Friends) GetRequests(params FriendsGetRequestsParams) (FriendsGetRequest
 	var resp FriendsGetRequestsResponse
 	if params.Extended {
 		var tmp FriendsGetRequestsResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp FriendsGetRequestsResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -252,7 +283,12 @@ type FriendsAddResponse int
 
 // Add Approves or creates a friend request.
 func (v Friends) Add(params FriendsAddParams) (FriendsAddResponse, error) {
-	r, err := v.API.Request("friends.add", params)
+	return v.AddContext(context.Background(), params)
+}
+
+// AddContext is Add with context support
+func (v Friends) AddContext(ctx context.Context, params FriendsAddParams) (FriendsAddResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.add", params)
 	if err != nil {
 		return 0, err
 	}
@@ -279,7 +315,12 @@ type FriendsEditParams struct {
 
 // Edit Edits the friend lists of the selected user.
 func (v Friends) Edit(params FriendsEditParams) (bool, error) {
-	r, err := v.API.Request("friends.edit", params)
+	return v.EditContext(context.Background(), params)
+}
+
+// EditContext is Edit with context support
+func (v Friends) EditContext(ctx context.Context, params FriendsEditParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.edit", params)
 	if err != nil {
 		return false, err
 	}
@@ -294,6 +335,7 @@ type FriendsDeleteParams struct {
 }
 
 // FriendsDeleteResponse is response for Friends.Delete
+//
 //easyjson:json
 type FriendsDeleteResponse struct {
 	Success vk.BoolInt `json:"success,omitempty"`
@@ -309,13 +351,18 @@ type FriendsDeleteResponse struct {
 
 // Delete Declines a friend request or deletes a user from the current user's friend list.
 func (v Friends) Delete(params FriendsDeleteParams) (*FriendsDeleteResponse, error) {
-	r, err := v.API.Request("friends.delete", params)
+	return v.DeleteContext(context.Background(), params)
+}
+
+// DeleteContext is Delete with context support
+func (v Friends) DeleteContext(ctx context.Context, params FriendsDeleteParams) (*FriendsDeleteResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.delete", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FriendsDeleteResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -331,6 +378,7 @@ type FriendsGetListsParams struct {
 }
 
 // FriendsGetListsResponse is response for Friends.GetLists
+//
 //easyjson:json
 type FriendsGetListsResponse struct {
 	// Total communities number
@@ -345,13 +393,18 @@ type FriendsGetListsResponse struct {
 
 // GetLists Returns a list of the user's friend lists.
 func (v Friends) GetLists(params FriendsGetListsParams) (*FriendsGetListsResponse, error) {
-	r, err := v.API.Request("friends.getLists", params)
+	return v.GetListsContext(context.Background(), params)
+}
+
+// GetListsContext is GetLists with context support
+func (v Friends) GetListsContext(ctx context.Context, params FriendsGetListsParams) (*FriendsGetListsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.getLists", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FriendsGetListsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -367,6 +420,7 @@ type FriendsAddListParams struct {
 }
 
 // FriendsAddListResponse is response for Friends.AddList
+//
 //easyjson:json
 type FriendsAddListResponse struct {
 	// List ID
@@ -375,13 +429,18 @@ type FriendsAddListResponse struct {
 
 // AddList Creates a new friend list for the current user.
 func (v Friends) AddList(params FriendsAddListParams) (*FriendsAddListResponse, error) {
-	r, err := v.API.Request("friends.addList", params)
+	return v.AddListContext(context.Background(), params)
+}
+
+// AddListContext is AddList with context support
+func (v Friends) AddListContext(ctx context.Context, params FriendsAddListParams) (*FriendsAddListResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.addList", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FriendsAddListResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -404,7 +463,12 @@ type FriendsEditListParams struct {
 
 // EditList Edits a friend list of the current user.
 func (v Friends) EditList(params FriendsEditListParams) (bool, error) {
-	r, err := v.API.Request("friends.editList", params)
+	return v.EditListContext(context.Background(), params)
+}
+
+// EditListContext is EditList with context support
+func (v Friends) EditListContext(ctx context.Context, params FriendsEditListParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.editList", params)
 	if err != nil {
 		return false, err
 	}
@@ -420,7 +484,12 @@ type FriendsDeleteListParams struct {
 
 // DeleteList Deletes a friend list of the current user.
 func (v Friends) DeleteList(params FriendsDeleteListParams) (bool, error) {
-	r, err := v.API.Request("friends.deleteList", params)
+	return v.DeleteListContext(context.Background(), params)
+}
+
+// DeleteListContext is DeleteList with context support
+func (v Friends) DeleteListContext(ctx context.Context, params FriendsDeleteListParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.deleteList", params)
 	if err != nil {
 		return false, err
 	}
@@ -430,18 +499,24 @@ func (v Friends) DeleteList(params FriendsDeleteListParams) (bool, error) {
 
 // FriendsGetAppUsersResponse is response for Friends.GetAppUsers
 // User ID
+//
 //easyjson:json
 type FriendsGetAppUsersResponse []int
 
 // GetAppUsers Returns a list of IDs of the current user's friends who installed the application.
 func (v Friends) GetAppUsers() (FriendsGetAppUsersResponse, error) {
-	r, err := v.API.Request("friends.getAppUsers", nil)
+	return v.GetAppUsersContext(context.Background())
+}
+
+// GetAppUsersContext is GetAppUsers with context support
+func (v Friends) GetAppUsersContext(ctx context.Context) (FriendsGetAppUsersResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.getAppUsers", nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FriendsGetAppUsersResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -457,18 +532,24 @@ type FriendsGetByPhonesParams struct {
 }
 
 // FriendsGetByPhonesResponse is response for Friends.GetByPhones
+//
 //easyjson:json
 type FriendsGetByPhonesResponse []vk.User
 
 // GetByPhones Returns a list of the current user's friends whose phone numbers, validated or specified in a profile, are in a given list.
 func (v Friends) GetByPhones(params FriendsGetByPhonesParams) (FriendsGetByPhonesResponse, error) {
-	r, err := v.API.Request("friends.getByPhones", params)
+	return v.GetByPhonesContext(context.Background(), params)
+}
+
+// GetByPhonesContext is GetByPhones with context support
+func (v Friends) GetByPhonesContext(ctx context.Context, params FriendsGetByPhonesParams) (FriendsGetByPhonesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.getByPhones", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FriendsGetByPhonesResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -477,7 +558,12 @@ func (v Friends) GetByPhones(params FriendsGetByPhonesParams) (FriendsGetByPhone
 
 // DeleteAllRequests Marks all incoming friend requests as viewed.
 func (v Friends) DeleteAllRequests() (bool, error) {
-	r, err := v.API.Request("friends.deleteAllRequests", nil)
+	return v.DeleteAllRequestsContext(context.Background())
+}
+
+// DeleteAllRequestsContext is DeleteAllRequests with context support
+func (v Friends) DeleteAllRequestsContext(ctx context.Context) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.deleteAllRequests", nil)
 	if err != nil {
 		return false, err
 	}
@@ -500,6 +586,7 @@ type FriendsGetSuggestionsParams struct {
 }
 
 // FriendsGetSuggestionsResponse is response for Friends.GetSuggestions
+//
 //easyjson:json
 type FriendsGetSuggestionsResponse struct {
 	// Total results number
@@ -509,13 +596,18 @@ type FriendsGetSuggestionsResponse struct {
 
 // GetSuggestions Returns a list of profiles of users whom the current user may know.
 func (v Friends) GetSuggestions(params FriendsGetSuggestionsParams) (*FriendsGetSuggestionsResponse, error) {
-	r, err := v.API.Request("friends.getSuggestions", params)
+	return v.GetSuggestionsContext(context.Background(), params)
+}
+
+// GetSuggestionsContext is GetSuggestions with context support
+func (v Friends) GetSuggestionsContext(ctx context.Context, params FriendsGetSuggestionsParams) (*FriendsGetSuggestionsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.getSuggestions", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FriendsGetSuggestionsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -531,6 +623,7 @@ type FriendsAreFriendsParams struct {
 }
 
 // FriendsAreFriendsResponse is response for Friends.AreFriends
+//
 //easyjson:json
 type FriendsAreFriendsResponse []struct {
 	// User ID
@@ -547,13 +640,18 @@ type FriendsAreFriendsResponse []struct {
 
 // AreFriends Checks the current user's friendship status with other specified users.
 func (v Friends) AreFriends(params FriendsAreFriendsParams) (FriendsAreFriendsResponse, error) {
-	r, err := v.API.Request("friends.areFriends", params)
+	return v.AreFriendsContext(context.Background(), params)
+}
+
+// AreFriendsContext is AreFriends with context support
+func (v Friends) AreFriendsContext(ctx context.Context, params FriendsAreFriendsParams) (FriendsAreFriendsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.areFriends", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FriendsAreFriendsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -577,6 +675,7 @@ type FriendsSearchParams struct {
 }
 
 // FriendsSearchResponse is response for Friends.Search
+//
 //easyjson:json
 type FriendsSearchResponse struct {
 	// Total number
@@ -586,13 +685,18 @@ type FriendsSearchResponse struct {
 
 // Search Returns a list of friends matching the search criteria.
 func (v Friends) Search(params FriendsSearchParams) (*FriendsSearchResponse, error) {
-	r, err := v.API.Request("friends.search", params)
+	return v.SearchContext(context.Background(), params)
+}
+
+// SearchContext is Search with context support
+func (v Friends) SearchContext(ctx context.Context, params FriendsSearchParams) (*FriendsSearchResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "friends.search", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FriendsSearchResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -22,6 +22,7 @@ type GiftsGetParams struct {
 }
 
 // GiftsGetResponse is response for Gifts.Get
+//
 //easyjson:json
 type GiftsGetResponse struct {
 	// Total number
@@ -31,13 +32,18 @@ type GiftsGetResponse struct {
 
 // Get Returns a list of user gifts.
 func (v Gifts) Get(params GiftsGetParams) (*GiftsGetResponse, error) {
-	r, err := v.API.Request("gifts.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Gifts) GetContext(ctx context.Context, params GiftsGetParams) (*GiftsGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "gifts.get", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GiftsGetResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -31,12 +31,12 @@ type GroupsIsMemberResponse interface {
 
 // GroupsIsMemberResponseNormal is non-extended version of GroupsIsMemberResponse
 // Information whether user is a member of the group
-//easyjson:json
 type GroupsIsMemberResponseNormal vk.BoolInt
 
 func (GroupsIsMemberResponseNormal) isGroupsIsMember() {}
 
 // GroupsIsMemberResponseExtended is extended version of GroupsIsMemberResponse
+//
 //easyjson:json
 type GroupsIsMemberResponseExtended struct {
 	// Information whether user is a member of the group
@@ -51,7 +51,12 @@ func (GroupsIsMemberResponseExtended) isGroupsIsMember() {}
 
 // IsMember Returns information specifying whether a user is a member of a community.
 func (v Groups) IsMember(params GroupsIsMemberParams) (GroupsIsMemberResponse, error) {
-	r, err := v.API.Request("groups.isMember", params)
+	return v.IsMemberContext(context.Background(), params)
+}
+
+// IsMemberContext is IsMember with context support
+func (v Groups) IsMemberContext(ctx context.Context, params GroupsIsMemberParams) (GroupsIsMemberResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.isMember", params)
 	if err != nil {
 		return nil, err
 	}
@@ -59,11 +64,11 @@ func (v Groups) IsMember(params GroupsIsMemberParams) (GroupsIsMemberResponse, e
 	var resp GroupsIsMemberResponse
 	if params.Extended {
 		var tmp GroupsIsMemberResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp GroupsIsMemberResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -83,18 +88,24 @@ type GroupsGetByIDParams struct {
 }
 
 // GroupsGetByIDResponse is response for Groups.GetByID
+//
 //easyjson:json
 type GroupsGetByIDResponse []vk.Group
 
 // GetByID Returns information about communities by their IDs.
 func (v Groups) GetByID(params GroupsGetByIDParams) (GroupsGetByIDResponse, error) {
-	r, err := v.API.Request("groups.getById", params)
+	return v.GetByIDContext(context.Background(), params)
+}
+
+// GetByIDContext is GetByID with context support
+func (v Groups) GetByIDContext(ctx context.Context, params GroupsGetByIDParams) (GroupsGetByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.getById", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsGetByIDResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -124,6 +135,7 @@ type GroupsGetResponse interface {
 }
 
 // GroupsGetResponseNormal is non-extended version of GroupsGetResponse
+//
 //easyjson:json
 type GroupsGetResponseNormal struct {
 	// Total communities number
@@ -135,6 +147,7 @@ type GroupsGetResponseNormal struct {
 func (GroupsGetResponseNormal) isGroupsGet() {}
 
 // GroupsGetResponseExtended is extended version of GroupsGetResponse
+//
 //easyjson:json
 type GroupsGetResponseExtended struct {
 	// Total communities number
@@ -146,7 +159,12 @@ func (GroupsGetResponseExtended) isGroupsGet() {}
 
 // Get Returns a list of the communities to which a user belongs.
 func (v Groups) Get(params GroupsGetParams) (GroupsGetResponse, error) {
-	r, err := v.API.Request("groups.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Groups) GetContext(ctx context.Context, params GroupsGetParams) (GroupsGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.get", params)
 	if err != nil {
 		return nil, err
 	}
@@ -154,11 +172,11 @@ func (v Groups) Get(params GroupsGetParams) (GroupsGetResponse, error) {
 	var resp GroupsGetResponse
 	if params.Extended {
 		var tmp GroupsGetResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp GroupsGetResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -184,6 +202,7 @@ type GroupsGetMembersParams struct {
 }
 
 // GroupsGetMembersResponse is response for Groups.GetMembers
+//
 //easyjson:json
 type GroupsGetMembersResponse struct {
 	// Total members number
@@ -194,13 +213,18 @@ type GroupsGetMembersResponse struct {
 
 // GetMembers Returns a list of community members.
 func (v Groups) GetMembers(params GroupsGetMembersParams) (*GroupsGetMembersResponse, error) {
-	r, err := v.API.Request("groups.getMembers", params)
+	return v.GetMembersContext(context.Background(), params)
+}
+
+// GetMembersContext is GetMembers with context support
+func (v Groups) GetMembersContext(ctx context.Context, params GroupsGetMembersParams) (*GroupsGetMembersResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.getMembers", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsGetMembersResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -217,7 +241,12 @@ type GroupsJoinParams struct {
 
 // Join With this method you can join the group or public page, and also confirm your participation in an event.
 func (v Groups) Join(params GroupsJoinParams) (bool, error) {
-	r, err := v.API.Request("groups.join", params)
+	return v.JoinContext(context.Background(), params)
+}
+
+// JoinContext is Join with context support
+func (v Groups) JoinContext(ctx context.Context, params GroupsJoinParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.join", params)
 	if err != nil {
 		return false, err
 	}
@@ -233,7 +262,12 @@ type GroupsLeaveParams struct {
 
 // Leave With this method you can leave a group, public page, or event.
 func (v Groups) Leave(params GroupsLeaveParams) (bool, error) {
-	r, err := v.API.Request("groups.leave", params)
+	return v.LeaveContext(context.Background(), params)
+}
+
+// LeaveContext is Leave with context support
+func (v Groups) LeaveContext(ctx context.Context, params GroupsLeaveParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.leave", params)
 	if err != nil {
 		return false, err
 	}
@@ -264,6 +298,7 @@ type GroupsSearchParams struct {
 }
 
 // GroupsSearchResponse is response for Groups.Search
+//
 //easyjson:json
 type GroupsSearchResponse struct {
 	// Total communities number
@@ -273,13 +308,18 @@ type GroupsSearchResponse struct {
 
 // Search Returns a list of communities matching the search criteria.
 func (v Groups) Search(params GroupsSearchParams) (*GroupsSearchResponse, error) {
-	r, err := v.API.Request("groups.search", params)
+	return v.SearchContext(context.Background(), params)
+}
+
+// SearchContext is Search with context support
+func (v Groups) SearchContext(ctx context.Context, params GroupsSearchParams) (*GroupsSearchResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.search", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsSearchResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -295,6 +335,7 @@ type GroupsGetCatalogParams struct {
 }
 
 // GroupsGetCatalogResponse is response for Groups.GetCatalog
+//
 //easyjson:json
 type GroupsGetCatalogResponse struct {
 	// Total communities number
@@ -304,13 +345,18 @@ type GroupsGetCatalogResponse struct {
 
 // GetCatalog Returns communities list for a catalog category.
 func (v Groups) GetCatalog(params GroupsGetCatalogParams) (*GroupsGetCatalogResponse, error) {
-	r, err := v.API.Request("groups.getCatalog", params)
+	return v.GetCatalogContext(context.Background(), params)
+}
+
+// GetCatalogContext is GetCatalog with context support
+func (v Groups) GetCatalogContext(ctx context.Context, params GroupsGetCatalogParams) (*GroupsGetCatalogResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.getCatalog", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsGetCatalogResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -332,6 +378,7 @@ type GroupsGetCatalogInfoResponse interface {
 }
 
 // GroupsGetCatalogInfoResponseNormal is non-extended version of GroupsGetCatalogInfoResponse
+//
 //easyjson:json
 type GroupsGetCatalogInfoResponseNormal struct {
 	// Information whether catalog is enabled for current user
@@ -342,6 +389,7 @@ type GroupsGetCatalogInfoResponseNormal struct {
 func (GroupsGetCatalogInfoResponseNormal) isGroupsGetCatalogInfo() {}
 
 // GroupsGetCatalogInfoResponseExtended is extended version of GroupsGetCatalogInfoResponse
+//
 //easyjson:json
 type GroupsGetCatalogInfoResponseExtended struct {
 	// Information whether catalog is enabled for current user
@@ -353,7 +401,12 @@ func (GroupsGetCatalogInfoResponseExtended) isGroupsGetCatalogInfo() {}
 
 // GetCatalogInfo Returns categories list for communities catalog
 func (v Groups) GetCatalogInfo(params GroupsGetCatalogInfoParams) (GroupsGetCatalogInfoResponse, error) {
-	r, err := v.API.Request("groups.getCatalogInfo", params)
+	return v.GetCatalogInfoContext(context.Background(), params)
+}
+
+// GetCatalogInfoContext is GetCatalogInfo with context support
+func (v Groups) GetCatalogInfoContext(ctx context.Context, params GroupsGetCatalogInfoParams) (GroupsGetCatalogInfoResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.getCatalogInfo", params)
 	if err != nil {
 		return nil, err
 	}
@@ -361,11 +414,11 @@ func (v Groups) GetCatalogInfo(params GroupsGetCatalogInfoParams) (GroupsGetCata
 	var resp GroupsGetCatalogInfoResponse
 	if params.Extended {
 		var tmp GroupsGetCatalogInfoResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp GroupsGetCatalogInfoResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -391,6 +444,7 @@ type GroupsGetInvitesResponse interface {
 }
 
 // GroupsGetInvitesResponseNormal is non-extended version of GroupsGetInvitesResponse
+//
 //easyjson:json
 type GroupsGetInvitesResponseNormal struct {
 	// Total communities number
@@ -401,6 +455,7 @@ type GroupsGetInvitesResponseNormal struct {
 func (GroupsGetInvitesResponseNormal) isGroupsGetInvites() {}
 
 // GroupsGetInvitesResponseExtended is extended version of GroupsGetInvitesResponse
+//
 //easyjson:json
 type GroupsGetInvitesResponseExtended struct {
 	// Total communities number
@@ -413,7 +468,12 @@ func (GroupsGetInvitesResponseExtended) isGroupsGetInvites() {}
 
 // GetInvites Returns a list of invitations to join communities and events.
 func (v Groups) GetInvites(params GroupsGetInvitesParams) (GroupsGetInvitesResponse, error) {
-	r, err := v.API.Request("groups.getInvites", params)
+	return v.GetInvitesContext(context.Background(), params)
+}
+
+// GetInvitesContext is GetInvites with context support
+func (v Groups) GetInvitesContext(ctx context.Context, params GroupsGetInvitesParams) (GroupsGetInvitesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.getInvites", params)
 	if err != nil {
 		return nil, err
 	}
@@ -421,11 +481,11 @@ func (v Groups) GetInvites(params GroupsGetInvitesParams) (GroupsGetInvitesRespo
 	var resp GroupsGetInvitesResponse
 	if params.Extended {
 		var tmp GroupsGetInvitesResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp GroupsGetInvitesResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -449,6 +509,7 @@ type GroupsGetInvitedUsersParams struct {
 }
 
 // GroupsGetInvitedUsersResponse is response for Groups.GetInvitedUsers
+//
 //easyjson:json
 type GroupsGetInvitedUsersResponse struct {
 	// Total communities number
@@ -458,13 +519,18 @@ type GroupsGetInvitedUsersResponse struct {
 
 // GetInvitedUsers Returns invited users list of a community
 func (v Groups) GetInvitedUsers(params GroupsGetInvitedUsersParams) (*GroupsGetInvitedUsersResponse, error) {
-	r, err := v.API.Request("groups.getInvitedUsers", params)
+	return v.GetInvitedUsersContext(context.Background(), params)
+}
+
+// GetInvitedUsersContext is GetInvitedUsers with context support
+func (v Groups) GetInvitedUsersContext(ctx context.Context, params GroupsGetInvitedUsersParams) (*GroupsGetInvitedUsersResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.getInvitedUsers", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsGetInvitedUsersResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -484,6 +550,7 @@ type GroupsGetBannedParams struct {
 }
 
 // GroupsGetBannedResponse is response for Groups.GetBanned
+//
 //easyjson:json
 type GroupsGetBannedResponse struct {
 	// Total users number
@@ -512,13 +579,18 @@ type GroupsGetBannedResponse struct {
 
 // GetBanned Returns a list of users on a community blacklist.
 func (v Groups) GetBanned(params GroupsGetBannedParams) (*GroupsGetBannedResponse, error) {
-	r, err := v.API.Request("groups.getBanned", params)
+	return v.GetBannedContext(context.Background(), params)
+}
+
+// GetBannedContext is GetBanned with context support
+func (v Groups) GetBannedContext(ctx context.Context, params GroupsGetBannedParams) (*GroupsGetBannedResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.getBanned", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsGetBannedResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -540,18 +612,24 @@ type GroupsCreateParams struct {
 }
 
 // GroupsCreateResponse is response for Groups.Create
+//
 //easyjson:json
 type GroupsCreateResponse vk.Group
 
 // Create Creates a new community.
 func (v Groups) Create(params GroupsCreateParams) (*GroupsCreateResponse, error) {
-	r, err := v.API.Request("groups.create", params)
+	return v.CreateContext(context.Background(), params)
+}
+
+// CreateContext is Create with context support
+func (v Groups) CreateContext(ctx context.Context, params GroupsCreateParams) (*GroupsCreateResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.create", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsCreateResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -650,7 +728,12 @@ type GroupsEditParams struct {
 
 // Edit Edits a community.
 func (v Groups) Edit(params GroupsEditParams) (bool, error) {
-	r, err := v.API.Request("groups.edit", params)
+	return v.EditContext(context.Background(), params)
+}
+
+// EditContext is Edit with context support
+func (v Groups) EditContext(ctx context.Context, params GroupsEditParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.edit", params)
 	if err != nil {
 		return false, err
 	}
@@ -665,6 +748,7 @@ type GroupsGetSettingsParams struct {
 }
 
 // GroupsGetSettingsResponse is response for Groups.GetSettings
+//
 //easyjson:json
 type GroupsGetSettingsResponse struct {
 	// Community title
@@ -711,13 +795,18 @@ type GroupsGetSettingsResponse struct {
 
 // GetSettings Returns community settings.
 func (v Groups) GetSettings(params GroupsGetSettingsParams) (*GroupsGetSettingsResponse, error) {
-	r, err := v.API.Request("groups.getSettings", params)
+	return v.GetSettingsContext(context.Background(), params)
+}
+
+// GetSettingsContext is GetSettings with context support
+func (v Groups) GetSettingsContext(ctx context.Context, params GroupsGetSettingsParams) (*GroupsGetSettingsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.getSettings", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsGetSettingsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -737,6 +826,7 @@ type GroupsGetRequestsParams struct {
 }
 
 // GroupsGetRequestsResponse is response for Groups.GetRequests
+//
 //easyjson:json
 type GroupsGetRequestsResponse struct {
 	// Total communities number
@@ -747,13 +837,18 @@ type GroupsGetRequestsResponse struct {
 
 // GetRequests Returns a list of requests to the community.
 func (v Groups) GetRequests(params GroupsGetRequestsParams) (*GroupsGetRequestsResponse, error) {
-	r, err := v.API.Request("groups.getRequests", params)
+	return v.GetRequestsContext(context.Background(), params)
+}
+
+// GetRequestsContext is GetRequests with context support
+func (v Groups) GetRequestsContext(ctx context.Context, params GroupsGetRequestsParams) (*GroupsGetRequestsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.getRequests", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsGetRequestsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -780,7 +875,12 @@ type GroupsEditManagerParams struct {
 
 // EditManager Allows to add, remove or edit the community manager.
 func (v Groups) EditManager(params GroupsEditManagerParams) (bool, error) {
-	r, err := v.API.Request("groups.editManager", params)
+	return v.EditManagerContext(context.Background(), params)
+}
+
+// EditManagerContext is EditManager with context support
+func (v Groups) EditManagerContext(ctx context.Context, params GroupsEditManagerParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.editManager", params)
 	if err != nil {
 		return false, err
 	}
@@ -798,7 +898,12 @@ type GroupsInviteParams struct {
 
 // Invite Allows to invite friends to the community.
 func (v Groups) Invite(params GroupsInviteParams) (bool, error) {
-	r, err := v.API.Request("groups.invite", params)
+	return v.InviteContext(context.Background(), params)
+}
+
+// InviteContext is Invite with context support
+func (v Groups) InviteContext(ctx context.Context, params GroupsInviteParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.invite", params)
 	if err != nil {
 		return false, err
 	}
@@ -817,6 +922,7 @@ type GroupsAddLinkParams struct {
 }
 
 // GroupsAddLinkResponse is response for Groups.AddLink
+//
 //easyjson:json
 type GroupsAddLinkResponse struct {
 	// Link ID
@@ -833,13 +939,18 @@ type GroupsAddLinkResponse struct {
 
 // AddLink Allows to add a link to the community.
 func (v Groups) AddLink(params GroupsAddLinkParams) (*GroupsAddLinkResponse, error) {
-	r, err := v.API.Request("groups.addLink", params)
+	return v.AddLinkContext(context.Background(), params)
+}
+
+// AddLinkContext is AddLink with context support
+func (v Groups) AddLinkContext(ctx context.Context, params GroupsAddLinkParams) (*GroupsAddLinkResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.addLink", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsAddLinkResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -856,7 +967,12 @@ type GroupsDeleteLinkParams struct {
 
 // DeleteLink Allows to delete a link from the community.
 func (v Groups) DeleteLink(params GroupsDeleteLinkParams) (bool, error) {
-	r, err := v.API.Request("groups.deleteLink", params)
+	return v.DeleteLinkContext(context.Background(), params)
+}
+
+// DeleteLinkContext is DeleteLink with context support
+func (v Groups) DeleteLinkContext(ctx context.Context, params GroupsDeleteLinkParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.deleteLink", params)
 	if err != nil {
 		return false, err
 	}
@@ -876,7 +992,12 @@ type GroupsEditLinkParams struct {
 
 // EditLink Allows to edit a link in the community.
 func (v Groups) EditLink(params GroupsEditLinkParams) (bool, error) {
-	r, err := v.API.Request("groups.editLink", params)
+	return v.EditLinkContext(context.Background(), params)
+}
+
+// EditLinkContext is EditLink with context support
+func (v Groups) EditLinkContext(ctx context.Context, params GroupsEditLinkParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.editLink", params)
 	if err != nil {
 		return false, err
 	}
@@ -896,7 +1017,12 @@ type GroupsReorderLinkParams struct {
 
 // ReorderLink Allows to reorder links in the community.
 func (v Groups) ReorderLink(params GroupsReorderLinkParams) (bool, error) {
-	r, err := v.API.Request("groups.reorderLink", params)
+	return v.ReorderLinkContext(context.Background(), params)
+}
+
+// ReorderLinkContext is ReorderLink with context support
+func (v Groups) ReorderLinkContext(ctx context.Context, params GroupsReorderLinkParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.reorderLink", params)
 	if err != nil {
 		return false, err
 	}
@@ -914,7 +1040,12 @@ type GroupsRemoveUserParams struct {
 
 // RemoveUser Removes a user from the community.
 func (v Groups) RemoveUser(params GroupsRemoveUserParams) (bool, error) {
-	r, err := v.API.Request("groups.removeUser", params)
+	return v.RemoveUserContext(context.Background(), params)
+}
+
+// RemoveUserContext is RemoveUser with context support
+func (v Groups) RemoveUserContext(ctx context.Context, params GroupsRemoveUserParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.removeUser", params)
 	if err != nil {
 		return false, err
 	}
@@ -932,7 +1063,12 @@ type GroupsApproveRequestParams struct {
 
 // ApproveRequest Allows to approve join request to the community.
 func (v Groups) ApproveRequest(params GroupsApproveRequestParams) (bool, error) {
-	r, err := v.API.Request("groups.approveRequest", params)
+	return v.ApproveRequestContext(context.Background(), params)
+}
+
+// ApproveRequestContext is ApproveRequest with context support
+func (v Groups) ApproveRequestContext(ctx context.Context, params GroupsApproveRequestParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.approveRequest", params)
 	if err != nil {
 		return false, err
 	}
@@ -947,6 +1083,7 @@ type GroupsGetCallbackConfirmationCodeParams struct {
 }
 
 // GroupsGetCallbackConfirmationCodeResponse is response for Groups.GetCallbackConfirmationCode
+//
 //easyjson:json
 type GroupsGetCallbackConfirmationCodeResponse struct {
 	// Confirmation code
@@ -955,13 +1092,18 @@ type GroupsGetCallbackConfirmationCodeResponse struct {
 
 // GetCallbackConfirmationCode Returns Callback API confirmation code for the community.
 func (v Groups) GetCallbackConfirmationCode(params GroupsGetCallbackConfirmationCodeParams) (*GroupsGetCallbackConfirmationCodeResponse, error) {
-	r, err := v.API.Request("groups.getCallbackConfirmationCode", params)
+	return v.GetCallbackConfirmationCodeContext(context.Background(), params)
+}
+
+// GetCallbackConfirmationCodeContext is GetCallbackConfirmationCode with context support
+func (v Groups) GetCallbackConfirmationCodeContext(ctx context.Context, params GroupsGetCallbackConfirmationCodeParams) (*GroupsGetCallbackConfirmationCodeResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.getCallbackConfirmationCode", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsGetCallbackConfirmationCodeResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -977,6 +1119,7 @@ type GroupsGetCallbackSettingsParams struct {
 }
 
 // GroupsGetCallbackSettingsResponse is response for Groups.GetCallbackSettings
+//
 //easyjson:json
 type GroupsGetCallbackSettingsResponse struct {
 	// API version used for the events
@@ -1027,13 +1170,18 @@ type GroupsGetCallbackSettingsResponse struct {
 
 // GetCallbackSettings Returns [vk.com/dev/callback_api|Callback API] notifications settings.
 func (v Groups) GetCallbackSettings(params GroupsGetCallbackSettingsParams) (*GroupsGetCallbackSettingsResponse, error) {
-	r, err := v.API.Request("groups.getCallbackSettings", params)
+	return v.GetCallbackSettingsContext(context.Background(), params)
+}
+
+// GetCallbackSettingsContext is GetCallbackSettings with context support
+func (v Groups) GetCallbackSettingsContext(ctx context.Context, params GroupsGetCallbackSettingsParams) (*GroupsGetCallbackSettingsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.getCallbackSettings", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsGetCallbackSettingsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1125,7 +1273,12 @@ type GroupsSetCallbackSettingsParams struct {
 
 // SetCallbackSettings Allow to set notifications settings for group.
 func (v Groups) SetCallbackSettings(params GroupsSetCallbackSettingsParams) (bool, error) {
-	r, err := v.API.Request("groups.setCallbackSettings", params)
+	return v.SetCallbackSettingsContext(context.Background(), params)
+}
+
+// SetCallbackSettingsContext is SetCallbackSettings with context support
+func (v Groups) SetCallbackSettingsContext(ctx context.Context, params GroupsSetCallbackSettingsParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.setCallbackSettings", params)
 	if err != nil {
 		return false, err
 	}
@@ -1140,6 +1293,7 @@ type GroupsGetLongPollServerParams struct {
 }
 
 // GroupsGetLongPollServerResponse is response for Groups.GetLongPollServer
+//
 //easyjson:json
 type GroupsGetLongPollServerResponse struct {
 	// Long Poll key
@@ -1152,13 +1306,18 @@ type GroupsGetLongPollServerResponse struct {
 
 // GetLongPollServer Returns the data needed to query a Long Poll server for events
 func (v Groups) GetLongPollServer(params GroupsGetLongPollServerParams) (*GroupsGetLongPollServerResponse, error) {
-	r, err := v.API.Request("groups.getLongPollServer", params)
+	return v.GetLongPollServerContext(context.Background(), params)
+}
+
+// GetLongPollServerContext is GetLongPollServer with context support
+func (v Groups) GetLongPollServerContext(ctx context.Context, params GroupsGetLongPollServerParams) (*GroupsGetLongPollServerResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.getLongPollServer", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsGetLongPollServerResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1172,6 +1331,7 @@ type GroupsGetLongPollSettingsParams struct {
 }
 
 // GroupsGetLongPollSettingsResponse is response for Groups.GetLongPollSettings
+//
 //easyjson:json
 type GroupsGetLongPollSettingsResponse struct {
 	// Shows whether Long Poll is enabled
@@ -1224,13 +1384,18 @@ type GroupsGetLongPollSettingsResponse struct {
 
 // GetLongPollSettings Returns Long Poll notification settings
 func (v Groups) GetLongPollSettings(params GroupsGetLongPollSettingsParams) (*GroupsGetLongPollSettingsResponse, error) {
-	r, err := v.API.Request("groups.getLongPollSettings", params)
+	return v.GetLongPollSettingsContext(context.Background(), params)
+}
+
+// GetLongPollSettingsContext is GetLongPollSettings with context support
+func (v Groups) GetLongPollSettingsContext(ctx context.Context, params GroupsGetLongPollSettingsParams) (*GroupsGetLongPollSettingsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.getLongPollSettings", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsGetLongPollSettingsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1321,7 +1486,12 @@ type GroupsSetLongPollSettingsParams struct {
 
 // SetLongPollSettings Sets Long Poll notification settings
 func (v Groups) SetLongPollSettings(params GroupsSetLongPollSettingsParams) (bool, error) {
-	r, err := v.API.Request("groups.setLongPollSettings", params)
+	return v.SetLongPollSettingsContext(context.Background(), params)
+}
+
+// SetLongPollSettingsContext is SetLongPollSettings with context support
+func (v Groups) SetLongPollSettingsContext(ctx context.Context, params GroupsSetLongPollSettingsParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.setLongPollSettings", params)
 	if err != nil {
 		return false, err
 	}
@@ -1347,6 +1517,7 @@ type GroupsGetAddressesParams struct {
 }
 
 // GroupsGetAddressesResponse is response for Groups.GetAddresses
+//
 //easyjson:json
 type GroupsGetAddressesResponse struct {
 	// Total count of addresses
@@ -1356,13 +1527,18 @@ type GroupsGetAddressesResponse struct {
 
 // GetAddresses Returns a list of community addresses.
 func (v Groups) GetAddresses(params GroupsGetAddressesParams) (*GroupsGetAddressesResponse, error) {
-	r, err := v.API.Request("groups.getAddresses", params)
+	return v.GetAddressesContext(context.Background(), params)
+}
+
+// GetAddressesContext is GetAddresses with context support
+func (v Groups) GetAddressesContext(ctx context.Context, params GroupsGetAddressesParams) (*GroupsGetAddressesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.getAddresses", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsGetAddressesResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1381,7 +1557,12 @@ type GroupsBanParams struct {
 
 // Ban does groups.ban
 func (v Groups) Ban(params GroupsBanParams) (bool, error) {
-	r, err := v.API.Request("groups.ban", params)
+	return v.BanContext(context.Background(), params)
+}
+
+// BanContext is Ban with context support
+func (v Groups) BanContext(ctx context.Context, params GroupsBanParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.ban", params)
 	if err != nil {
 		return false, err
 	}
@@ -1397,7 +1578,12 @@ type GroupsDeleteCallbackServerParams struct {
 
 // DeleteCallbackServer does groups.deleteCallbackServer
 func (v Groups) DeleteCallbackServer(params GroupsDeleteCallbackServerParams) (bool, error) {
-	r, err := v.API.Request("groups.deleteCallbackServer", params)
+	return v.DeleteCallbackServerContext(context.Background(), params)
+}
+
+// DeleteCallbackServerContext is DeleteCallbackServer with context support
+func (v Groups) DeleteCallbackServerContext(ctx context.Context, params GroupsDeleteCallbackServerParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.deleteCallbackServer", params)
 	if err != nil {
 		return false, err
 	}
@@ -1412,7 +1598,12 @@ type GroupsDisableOnlineParams struct {
 
 // DisableOnline does groups.disableOnline
 func (v Groups) DisableOnline(params GroupsDisableOnlineParams) (bool, error) {
-	r, err := v.API.Request("groups.disableOnline", params)
+	return v.DisableOnlineContext(context.Background(), params)
+}
+
+// DisableOnlineContext is DisableOnline with context support
+func (v Groups) DisableOnlineContext(ctx context.Context, params GroupsDisableOnlineParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.disableOnline", params)
 	if err != nil {
 		return false, err
 	}
@@ -1431,7 +1622,12 @@ type GroupsEditCallbackServerParams struct {
 
 // EditCallbackServer does groups.editCallbackServer
 func (v Groups) EditCallbackServer(params GroupsEditCallbackServerParams) (bool, error) {
-	r, err := v.API.Request("groups.editCallbackServer", params)
+	return v.EditCallbackServerContext(context.Background(), params)
+}
+
+// EditCallbackServerContext is EditCallbackServer with context support
+func (v Groups) EditCallbackServerContext(ctx context.Context, params GroupsEditCallbackServerParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.editCallbackServer", params)
 	if err != nil {
 		return false, err
 	}
@@ -1446,7 +1642,12 @@ type GroupsEnableOnlineParams struct {
 
 // EnableOnline does groups.enableOnline
 func (v Groups) EnableOnline(params GroupsEnableOnlineParams) (bool, error) {
-	r, err := v.API.Request("groups.enableOnline", params)
+	return v.EnableOnlineContext(context.Background(), params)
+}
+
+// EnableOnlineContext is EnableOnline with context support
+func (v Groups) EnableOnlineContext(ctx context.Context, params GroupsEnableOnlineParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.enableOnline", params)
 	if err != nil {
 		return false, err
 	}
@@ -1462,7 +1663,12 @@ type GroupsUnbanParams struct {
 
 // Unban does groups.unban
 func (v Groups) Unban(params GroupsUnbanParams) (bool, error) {
-	r, err := v.API.Request("groups.unban", params)
+	return v.UnbanContext(context.Background(), params)
+}
+
+// UnbanContext is Unban with context support
+func (v Groups) UnbanContext(ctx context.Context, params GroupsUnbanParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.unban", params)
 	if err != nil {
 		return false, err
 	}
@@ -1488,18 +1694,24 @@ type GroupsAddAddressParams struct {
 }
 
 // GroupsAddAddressResponse is response for Groups.AddAddress
+//
 //easyjson:json
 type GroupsAddAddressResponse vk.GroupAddress
 
 // AddAddress does groups.addAddress
 func (v Groups) AddAddress(params GroupsAddAddressParams) (*GroupsAddAddressResponse, error) {
-	r, err := v.API.Request("groups.addAddress", params)
+	return v.AddAddressContext(context.Background(), params)
+}
+
+// AddAddressContext is AddAddress with context support
+func (v Groups) AddAddressContext(ctx context.Context, params GroupsAddAddressParams) (*GroupsAddAddressResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.addAddress", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsAddAddressResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1526,18 +1738,24 @@ type GroupsEditAddressParams struct {
 
 // GroupsEditAddressResponse is response for Groups.EditAddress
 // Result
+//
 //easyjson:json
 type GroupsEditAddressResponse vk.GroupAddress
 
 // EditAddress does groups.editAddress
 func (v Groups) EditAddress(params GroupsEditAddressParams) (*GroupsEditAddressResponse, error) {
-	r, err := v.API.Request("groups.editAddress", params)
+	return v.EditAddressContext(context.Background(), params)
+}
+
+// EditAddressContext is EditAddress with context support
+func (v Groups) EditAddressContext(ctx context.Context, params GroupsEditAddressParams) (*GroupsEditAddressResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "groups.editAddress", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp GroupsEditAddressResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,138 @@
+package vkapi
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/stek29/vk"
+)
+
+// Maximal page sizes of paginated methods
+const (
+	GroupsGetMembersMaxCount = 1000
+	FriendsGetMaxCount       = 5000
+	WallGetMaxCount          = 100
+	PhotosGetAllMaxCount     = 200
+	NewsfeedGetMaxCount      = 100
+	NewsfeedSearchMaxCount   = 200
+)
+
+// UserIterator is Paginator over users
+type UserIterator struct {
+	*Paginator
+	user vk.User
+}
+
+// Next advances iterator to next user, see Paginator.Next
+func (it *UserIterator) Next() bool {
+	it.user = vk.User{}
+	if !it.Paginator.Next() {
+		return false
+	}
+
+	// methods return only IDs unless fields are requested
+	item := it.Item()
+	if len(item) != 0 && item[0] != '{' {
+		id, err := strconv.Atoi(string(item))
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.user.ID = id
+		return true
+	}
+
+	return it.scan(&it.user)
+}
+
+// User returns current user, only ID is set if fields weren't requested
+func (it *UserIterator) User() vk.User {
+	return it.user
+}
+
+// PostIterator is Paginator over posts
+type PostIterator struct {
+	*Paginator
+	post vk.Post
+}
+
+// Next advances iterator to next post, see Paginator.Next
+func (it *PostIterator) Next() bool {
+	it.post = vk.Post{}
+	return it.Paginator.Next() && it.scan(&it.post)
+}
+
+// Post returns current post
+func (it *PostIterator) Post() vk.Post {
+	return it.post
+}
+
+// PhotoIterator is Paginator over photos
+type PhotoIterator struct {
+	*Paginator
+	photo vk.Photo
+}
+
+// Next advances iterator to next photo, see Paginator.Next
+func (it *PhotoIterator) Next() bool {
+	it.photo = vk.Photo{}
+	return it.Paginator.Next() && it.scan(&it.photo)
+}
+
+// Photo returns current photo
+func (it *PhotoIterator) Photo() vk.Photo {
+	return it.photo
+}
+
+// NewsfeedIterator is Paginator over newsfeed items
+type NewsfeedIterator struct {
+	*Paginator
+	item vk.NewsfeedItem
+}
+
+// Next advances iterator to next newsfeed item, see Paginator.Next
+func (it *NewsfeedIterator) Next() bool {
+	it.item = vk.NewsfeedItem{}
+	return it.Paginator.Next() && it.scan(&it.item)
+}
+
+// NewsfeedItem returns current newsfeed item
+func (it *NewsfeedIterator) NewsfeedItem() vk.NewsfeedItem {
+	return it.item
+}
+
+// GetMembersIter iterates over every community member, see GetMembers
+func (v Groups) GetMembersIter(ctx context.Context, params GroupsGetMembersParams, cfg PaginatorConfig) *UserIterator {
+	return &UserIterator{Paginator: NewOffsetPaginator(ctx, v.API, "groups.getMembers", params, GroupsGetMembersMaxCount, cfg)}
+}
+
+// GetIter iterates over every friend, see Get
+func (v Friends) GetIter(ctx context.Context, params FriendsGetParams, cfg PaginatorConfig) *UserIterator {
+	return &UserIterator{Paginator: NewOffsetPaginator(ctx, v.API, "friends.get", params, FriendsGetMaxCount, cfg)}
+}
+
+// GetIter iterates over every post on the wall, see Get
+//
+// Profiles and groups of extended response can be found in Response
+func (v Wall) GetIter(ctx context.Context, params WallGetParams, cfg PaginatorConfig) *PostIterator {
+	return &PostIterator{Paginator: NewOffsetPaginator(ctx, v.API, "wall.get", params, WallGetMaxCount, cfg)}
+}
+
+// GetAllIter iterates over every photo of user or community, see GetAll
+func (v Photos) GetAllIter(ctx context.Context, params PhotosGetAllParams, cfg PaginatorConfig) *PhotoIterator {
+	return &PhotoIterator{Paginator: NewOffsetPaginator(ctx, v.API, "photos.getAll", params, PhotosGetAllMaxCount, cfg)}
+}
+
+// GetIter iterates over newsfeed, see Get
+//
+// Profiles and groups can be found in Response
+func (v Newsfeed) GetIter(ctx context.Context, params NewsfeedGetParams, cfg PaginatorConfig) *NewsfeedIterator {
+	return &NewsfeedIterator{Paginator: NewCursorPaginator(ctx, v.API, "newsfeed.get", params, NewsfeedGetMaxCount, cfg)}
+}
+
+// SearchIter iterates over search results, see Search
+//
+// Profiles and groups of extended response can be found in Response
+func (v Newsfeed) SearchIter(ctx context.Context, params NewsfeedSearchParams, cfg PaginatorConfig) *PostIterator {
+	return &PostIterator{Paginator: NewCursorPaginator(ctx, v.API, "newsfeed.search", params, NewsfeedSearchMaxCount, cfg)}
+}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -22,6 +22,7 @@ type LeadsCompleteParams struct {
 }
 
 // LeadsCompleteResponse is response for Leads.Complete
+//
 //easyjson:json
 type LeadsCompleteResponse struct {
 	// Offer limit
@@ -37,13 +38,18 @@ type LeadsCompleteResponse struct {
 
 // Complete Completes the lead started by user.
 func (v Leads) Complete(params LeadsCompleteParams) (*LeadsCompleteResponse, error) {
-	r, err := v.API.Request("leads.complete", params)
+	return v.CompleteContext(context.Background(), params)
+}
+
+// CompleteContext is Complete with context support
+func (v Leads) CompleteContext(ctx context.Context, params LeadsCompleteParams) (*LeadsCompleteResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "leads.complete", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp LeadsCompleteResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -63,6 +69,7 @@ type LeadsStartParams struct {
 }
 
 // LeadsStartResponse is response for Leads.Start
+//
 //easyjson:json
 type LeadsStartResponse struct {
 	// Information whether test mode is enabled
@@ -73,13 +80,18 @@ type LeadsStartResponse struct {
 
 // Start Creates new session for the user passing the offer.
 func (v Leads) Start(params LeadsStartParams) (*LeadsStartResponse, error) {
-	r, err := v.API.Request("leads.start", params)
+	return v.StartContext(context.Background(), params)
+}
+
+// StartContext is Start with context support
+func (v Leads) StartContext(ctx context.Context, params LeadsStartParams) (*LeadsStartResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "leads.start", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp LeadsStartResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +111,7 @@ type LeadsGetStatsParams struct {
 }
 
 // LeadsGetStatsResponse is response for Leads.GetStats
+//
 //easyjson:json
 type LeadsGetStatsResponse struct {
 	// Lead limit
@@ -127,13 +140,18 @@ type LeadsGetStatsResponse struct {
 
 // GetStats Returns lead stats data.
 func (v Leads) GetStats(params LeadsGetStatsParams) (*LeadsGetStatsResponse, error) {
-	r, err := v.API.Request("leads.getStats", params)
+	return v.GetStatsContext(context.Background(), params)
+}
+
+// GetStatsContext is GetStats with context support
+func (v Leads) GetStatsContext(ctx context.Context, params LeadsGetStatsParams) (*LeadsGetStatsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "leads.getStats", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp LeadsGetStatsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -157,6 +175,7 @@ type LeadsGetUsersParams struct {
 }
 
 // LeadsGetUsersResponse is response for Leads.GetUsers
+//
 //easyjson:json
 type LeadsGetUsersResponse []struct {
 	// User ID
@@ -179,13 +198,18 @@ type LeadsGetUsersResponse []struct {
 
 // GetUsers Returns a list of last user actions for the offer.
 func (v Leads) GetUsers(params LeadsGetUsersParams) (LeadsGetUsersResponse, error) {
-	r, err := v.API.Request("leads.getUsers", params)
+	return v.GetUsersContext(context.Background(), params)
+}
+
+// GetUsersContext is GetUsers with context support
+func (v Leads) GetUsersContext(ctx context.Context, params LeadsGetUsersParams) (LeadsGetUsersResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "leads.getUsers", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp LeadsGetUsersResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -207,6 +231,7 @@ type LeadsCheckUserParams struct {
 }
 
 // LeadsCheckUserResponse is response for Leads.CheckUser
+//
 //easyjson:json
 type LeadsCheckUserResponse struct {
 	// Information whether user can start the lead
@@ -221,13 +246,18 @@ type LeadsCheckUserResponse struct {
 
 // CheckUser Checks if the user can start the lead.
 func (v Leads) CheckUser(params LeadsCheckUserParams) (*LeadsCheckUserResponse, error) {
-	r, err := v.API.Request("leads.checkUser", params)
+	return v.CheckUserContext(context.Background(), params)
+}
+
+// CheckUserContext is CheckUser with context support
+func (v Leads) CheckUserContext(ctx context.Context, params LeadsCheckUserParams) (*LeadsCheckUserResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "leads.checkUser", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp LeadsCheckUserResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -241,6 +271,7 @@ type LeadsMetricHitParams struct {
 }
 
 // LeadsMetricHitResponse is response for Leads.MetricHit
+//
 //easyjson:json
 type LeadsMetricHitResponse struct {
 	// Information whether request has been processed successfully
@@ -251,13 +282,18 @@ type LeadsMetricHitResponse struct {
 
 // MetricHit Counts the metric event.
 func (v Leads) MetricHit(params LeadsMetricHitParams) (*LeadsMetricHitResponse, error) {
-	r, err := v.API.Request("leads.metricHit", params)
+	return v.MetricHitContext(context.Background(), params)
+}
+
+// MetricHitContext is MetricHit with context support
+func (v Leads) MetricHitContext(ctx context.Context, params LeadsMetricHitParams) (*LeadsMetricHitResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "leads.metricHit", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp LeadsMetricHitResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
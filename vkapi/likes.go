@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -41,6 +41,7 @@ type LikesGetListResponse interface {
 }
 
 // LikesGetListResponseNormal is non-extended version of LikesGetListResponse
+//
 //easyjson:json
 type LikesGetListResponseNormal struct {
 	// Total number
@@ -52,6 +53,7 @@ type LikesGetListResponseNormal struct {
 func (LikesGetListResponseNormal) isLikesGetList() {}
 
 // LikesGetListResponseExtended is extended version of LikesGetListResponse
+//
 //easyjson:json
 type LikesGetListResponseExtended struct {
 	// Total number
@@ -63,7 +65,12 @@ func (LikesGetListResponseExtended) isLikesGetList() {}
 
 // GetList Returns a list of IDs of users who added the specified object to their 'Likes' list.
 func (v Likes) GetList(params LikesGetListParams) (LikesGetListResponse, error) {
-	r, err := v.API.Request("likes.getList", params)
+	return v.GetListContext(context.Background(), params)
+}
+
+// GetListContext is GetList with context support
+func (v Likes) GetListContext(ctx context.Context, params LikesGetListParams) (LikesGetListResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "likes.getList", params)
 	if err != nil {
 		return nil, err
 	}
@@ -71,11 +78,11 @@ func (v Likes) GetList(params LikesGetListParams) (LikesGetListResponse, error)
 	var resp LikesGetListResponse
 	if params.Extended {
 		var tmp LikesGetListResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp LikesGetListResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -97,6 +104,7 @@ type LikesAddParams struct {
 }
 
 // LikesAddResponse is response for Likes.Add
+//
 //easyjson:json
 type LikesAddResponse struct {
 	// Total likes number
@@ -105,13 +113,18 @@ type LikesAddResponse struct {
 
 // Add Adds the specified object to the 'Likes' list of the current user.
 func (v Likes) Add(params LikesAddParams) (*LikesAddResponse, error) {
-	r, err := v.API.Request("likes.add", params)
+	return v.AddContext(context.Background(), params)
+}
+
+// AddContext is Add with context support
+func (v Likes) AddContext(ctx context.Context, params LikesAddParams) (*LikesAddResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "likes.add", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp LikesAddResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -129,6 +142,7 @@ type LikesDeleteParams struct {
 }
 
 // LikesDeleteResponse is response for Likes.Delete
+//
 //easyjson:json
 type LikesDeleteResponse struct {
 	// Total likes number
@@ -137,13 +151,18 @@ type LikesDeleteResponse struct {
 
 // Delete Deletes the specified object from the 'Likes' list of the current user.
 func (v Likes) Delete(params LikesDeleteParams) (*LikesDeleteResponse, error) {
-	r, err := v.API.Request("likes.delete", params)
+	return v.DeleteContext(context.Background(), params)
+}
+
+// DeleteContext is Delete with context support
+func (v Likes) DeleteContext(ctx context.Context, params LikesDeleteParams) (*LikesDeleteResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "likes.delete", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp LikesDeleteResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +182,7 @@ type LikesIsLikedParams struct {
 }
 
 // LikesIsLikedResponse is response for Likes.IsLiked
+//
 //easyjson:json
 type LikesIsLikedResponse struct {
 	// Information whether user liked the object
@@ -173,13 +193,18 @@ type LikesIsLikedResponse struct {
 
 // IsLiked Checks for the object in the 'Likes' list of the specified user.
 func (v Likes) IsLiked(params LikesIsLikedParams) (*LikesIsLikedResponse, error) {
-	r, err := v.API.Request("likes.isLiked", params)
+	return v.IsLikedContext(context.Background(), params)
+}
+
+// IsLikedContext is IsLiked with context support
+func (v Likes) IsLikedContext(ctx context.Context, params LikesIsLikedParams) (*LikesIsLikedResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "likes.isLiked", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp LikesIsLikedResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
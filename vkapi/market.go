@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 	"strconv"
 
 	"github.com/stek29/vk"
@@ -32,6 +32,7 @@ type MarketGetResponse interface {
 }
 
 // MarketGetResponseNormal is non-extended version of MarketGetResponse
+//
 //easyjson:json
 type MarketGetResponseNormal struct {
 	// Total number
@@ -42,6 +43,7 @@ type MarketGetResponseNormal struct {
 func (MarketGetResponseNormal) isMarketGet() {}
 
 // MarketGetResponseExtended is extended version of MarketGetResponse
+//
 //easyjson:json
 type MarketGetResponseExtended struct {
 	// Total number
@@ -53,7 +55,12 @@ func (MarketGetResponseExtended) isMarketGet() {}
 
 // Get Returns items list for a community.
 func (v Market) Get(params MarketGetParams) (MarketGetResponse, error) {
-	r, err := v.API.Request("market.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Market) GetContext(ctx context.Context, params MarketGetParams) (MarketGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.get", params)
 	if err != nil {
 		return nil, err
 	}
@@ -61,11 +68,11 @@ func (v Market) Get(params MarketGetParams) (MarketGetResponse, error) {
 	var resp MarketGetResponse
 	if params.Extended {
 		var tmp MarketGetResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp MarketGetResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -89,6 +96,7 @@ type MarketGetByIDResponse interface {
 }
 
 // MarketGetByIDResponseNormal is non-extended version of MarketGetByIDResponse
+//
 //easyjson:json
 type MarketGetByIDResponseNormal struct {
 	// Total number
@@ -99,6 +107,7 @@ type MarketGetByIDResponseNormal struct {
 func (MarketGetByIDResponseNormal) isMarketGetByID() {}
 
 // MarketGetByIDResponseExtended is extended version of MarketGetByIDResponse
+//
 //easyjson:json
 type MarketGetByIDResponseExtended struct {
 	// Total number
@@ -110,7 +119,12 @@ func (MarketGetByIDResponseExtended) isMarketGetByID() {}
 
 // GetByID Returns information about market items by their ids.
 func (v Market) GetByID(params MarketGetByIDParams) (MarketGetByIDResponse, error) {
-	r, err := v.API.Request("market.getById", params)
+	return v.GetByIDContext(context.Background(), params)
+}
+
+// GetByIDContext is GetByID with context support
+func (v Market) GetByIDContext(ctx context.Context, params MarketGetByIDParams) (MarketGetByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.getById", params)
 	if err != nil {
 		return nil, err
 	}
@@ -118,11 +132,11 @@ func (v Market) GetByID(params MarketGetByIDParams) (MarketGetByIDResponse, erro
 	var resp MarketGetByIDResponse
 	if params.Extended {
 		var tmp MarketGetByIDResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp MarketGetByIDResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -163,6 +177,7 @@ type MarketSearchResponse interface {
 }
 
 // MarketSearchResponseNormal is non-extended version of MarketSearchResponse
+//
 //easyjson:json
 type MarketSearchResponseNormal struct {
 	// Total number
@@ -173,6 +188,7 @@ type MarketSearchResponseNormal struct {
 func (MarketSearchResponseNormal) isMarketSearch() {}
 
 // MarketSearchResponseExtended is extended version of MarketSearchResponse
+//
 //easyjson:json
 type MarketSearchResponseExtended struct {
 	// Total number
@@ -184,7 +200,12 @@ func (MarketSearchResponseExtended) isMarketSearch() {}
 
 // Search Searches market items in a community's catalog
 func (v Market) Search(params MarketSearchParams) (MarketSearchResponse, error) {
-	r, err := v.API.Request("market.search", params)
+	return v.SearchContext(context.Background(), params)
+}
+
+// SearchContext is Search with context support
+func (v Market) SearchContext(ctx context.Context, params MarketSearchParams) (MarketSearchResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.search", params)
 	if err != nil {
 		return nil, err
 	}
@@ -192,11 +213,11 @@ func (v Market) Search(params MarketSearchParams) (MarketSearchResponse, error)
 	var resp MarketSearchResponse
 	if params.Extended {
 		var tmp MarketSearchResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp MarketSearchResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -216,6 +237,7 @@ type MarketGetAlbumsParams struct {
 }
 
 // MarketGetAlbumsResponse is response for Market.GetAlbums
+//
 //easyjson:json
 type MarketGetAlbumsResponse struct {
 	// Total number
@@ -225,13 +247,18 @@ type MarketGetAlbumsResponse struct {
 
 // GetAlbums Returns community's collections list.
 func (v Market) GetAlbums(params MarketGetAlbumsParams) (*MarketGetAlbumsResponse, error) {
-	r, err := v.API.Request("market.getAlbums", params)
+	return v.GetAlbumsContext(context.Background(), params)
+}
+
+// GetAlbumsContext is GetAlbums with context support
+func (v Market) GetAlbumsContext(ctx context.Context, params MarketGetAlbumsParams) (*MarketGetAlbumsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.getAlbums", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MarketGetAlbumsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -247,6 +274,7 @@ type MarketGetAlbumByIDParams struct {
 }
 
 // MarketGetAlbumByIDResponse is response for Market.GetAlbumByID
+//
 //easyjson:json
 type MarketGetAlbumByIDResponse struct {
 	// Total number
@@ -256,13 +284,18 @@ type MarketGetAlbumByIDResponse struct {
 
 // GetAlbumByID Returns items album's data
 func (v Market) GetAlbumByID(params MarketGetAlbumByIDParams) (*MarketGetAlbumByIDResponse, error) {
-	r, err := v.API.Request("market.getAlbumById", params)
+	return v.GetAlbumByIDContext(context.Background(), params)
+}
+
+// GetAlbumByIDContext is GetAlbumByID with context support
+func (v Market) GetAlbumByIDContext(ctx context.Context, params MarketGetAlbumByIDParams) (*MarketGetAlbumByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.getAlbumById", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MarketGetAlbumByIDResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -295,7 +328,12 @@ type MarketCreateCommentResponse int
 
 // CreateComment Creates a new comment for an item.
 func (v Market) CreateComment(params MarketCreateCommentParams) (MarketCreateCommentResponse, error) {
-	r, err := v.API.Request("market.createComment", params)
+	return v.CreateCommentContext(context.Background(), params)
+}
+
+// CreateCommentContext is CreateComment with context support
+func (v Market) CreateCommentContext(ctx context.Context, params MarketCreateCommentParams) (MarketCreateCommentResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.createComment", params)
 	if err != nil {
 		return 0, err
 	}
@@ -334,6 +372,7 @@ type MarketGetCommentsParams struct {
 }
 
 // MarketGetCommentsResponse is response for Market.GetComments
+//
 //easyjson:json
 type MarketGetCommentsResponse struct {
 	// Total number
@@ -343,13 +382,18 @@ type MarketGetCommentsResponse struct {
 
 // GetComments Returns comments list for an item.
 func (v Market) GetComments(params MarketGetCommentsParams) (*MarketGetCommentsResponse, error) {
-	r, err := v.API.Request("market.getComments", params)
+	return v.GetCommentsContext(context.Background(), params)
+}
+
+// GetCommentsContext is GetComments with context support
+func (v Market) GetCommentsContext(ctx context.Context, params MarketGetCommentsParams) (*MarketGetCommentsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.getComments", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MarketGetCommentsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -366,7 +410,12 @@ type MarketDeleteCommentParams struct {
 
 // DeleteComment Deletes an item's comment
 func (v Market) DeleteComment(params MarketDeleteCommentParams) (bool, error) {
-	r, err := v.API.Request("market.deleteComment", params)
+	return v.DeleteCommentContext(context.Background(), params)
+}
+
+// DeleteCommentContext is DeleteComment with context support
+func (v Market) DeleteCommentContext(ctx context.Context, params MarketDeleteCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.deleteComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -384,7 +433,12 @@ type MarketRestoreCommentParams struct {
 
 // RestoreComment Restores a recently deleted comment
 func (v Market) RestoreComment(params MarketRestoreCommentParams) (bool, error) {
-	r, err := v.API.Request("market.restoreComment", params)
+	return v.RestoreCommentContext(context.Background(), params)
+}
+
+// RestoreCommentContext is RestoreComment with context support
+func (v Market) RestoreCommentContext(ctx context.Context, params MarketRestoreCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.restoreComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -406,7 +460,12 @@ type MarketEditCommentParams struct {
 
 // EditComment Chages item comment's text
 func (v Market) EditComment(params MarketEditCommentParams) (bool, error) {
-	r, err := v.API.Request("market.editComment", params)
+	return v.EditCommentContext(context.Background(), params)
+}
+
+// EditCommentContext is EditComment with context support
+func (v Market) EditCommentContext(ctx context.Context, params MarketEditCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.editComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -426,7 +485,12 @@ type MarketReportCommentParams struct {
 
 // ReportComment Sends a complaint to the item's comment.
 func (v Market) ReportComment(params MarketReportCommentParams) (bool, error) {
-	r, err := v.API.Request("market.reportComment", params)
+	return v.ReportCommentContext(context.Background(), params)
+}
+
+// ReportCommentContext is ReportComment with context support
+func (v Market) ReportCommentContext(ctx context.Context, params MarketReportCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.reportComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -443,6 +507,7 @@ type MarketGetCategoriesParams struct {
 }
 
 // MarketGetCategoriesResponse is response for Market.GetCategories
+//
 //easyjson:json
 type MarketGetCategoriesResponse struct {
 	// Total number
@@ -452,13 +517,18 @@ type MarketGetCategoriesResponse struct {
 
 // GetCategories Returns a list of market categories.
 func (v Market) GetCategories(params MarketGetCategoriesParams) (*MarketGetCategoriesResponse, error) {
-	r, err := v.API.Request("market.getCategories", params)
+	return v.GetCategoriesContext(context.Background(), params)
+}
+
+// GetCategoriesContext is GetCategories with context support
+func (v Market) GetCategoriesContext(ctx context.Context, params MarketGetCategoriesParams) (*MarketGetCategoriesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.getCategories", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MarketGetCategoriesResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -477,7 +547,12 @@ type MarketReportParams struct {
 
 // Report Sends a complaint to the item.
 func (v Market) Report(params MarketReportParams) (bool, error) {
-	r, err := v.API.Request("market.report", params)
+	return v.ReportContext(context.Background(), params)
+}
+
+// ReportContext is Report with context support
+func (v Market) ReportContext(ctx context.Context, params MarketReportParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.report", params)
 	if err != nil {
 		return false, err
 	}
@@ -508,6 +583,7 @@ type MarketAddParams struct {
 }
 
 // MarketAddResponse is response for Market.Add
+//
 //easyjson:json
 type MarketAddResponse struct {
 	// Item ID
@@ -516,13 +592,18 @@ type MarketAddResponse struct {
 
 // Add Ads a new item to the market.
 func (v Market) Add(params MarketAddParams) (*MarketAddResponse, error) {
-	r, err := v.API.Request("market.add", params)
+	return v.AddContext(context.Background(), params)
+}
+
+// AddContext is Add with context support
+func (v Market) AddContext(ctx context.Context, params MarketAddParams) (*MarketAddResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.add", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MarketAddResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -555,7 +636,12 @@ type MarketEditParams struct {
 
 // Edit Edits an item.
 func (v Market) Edit(params MarketEditParams) (bool, error) {
-	r, err := v.API.Request("market.edit", params)
+	return v.EditContext(context.Background(), params)
+}
+
+// EditContext is Edit with context support
+func (v Market) EditContext(ctx context.Context, params MarketEditParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.edit", params)
 	if err != nil {
 		return false, err
 	}
@@ -573,7 +659,12 @@ type MarketDeleteParams struct {
 
 // Delete Deletes an item.
 func (v Market) Delete(params MarketDeleteParams) (bool, error) {
-	r, err := v.API.Request("market.delete", params)
+	return v.DeleteContext(context.Background(), params)
+}
+
+// DeleteContext is Delete with context support
+func (v Market) DeleteContext(ctx context.Context, params MarketDeleteParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.delete", params)
 	if err != nil {
 		return false, err
 	}
@@ -591,7 +682,12 @@ type MarketRestoreParams struct {
 
 // Restore Restores recently deleted item
 func (v Market) Restore(params MarketRestoreParams) (bool, error) {
-	r, err := v.API.Request("market.restore", params)
+	return v.RestoreContext(context.Background(), params)
+}
+
+// RestoreContext is Restore with context support
+func (v Market) RestoreContext(ctx context.Context, params MarketRestoreParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.restore", params)
 	if err != nil {
 		return false, err
 	}
@@ -615,7 +711,12 @@ type MarketReorderItemsParams struct {
 
 // ReorderItems Changes item place in a collection.
 func (v Market) ReorderItems(params MarketReorderItemsParams) (bool, error) {
-	r, err := v.API.Request("market.reorderItems", params)
+	return v.ReorderItemsContext(context.Background(), params)
+}
+
+// ReorderItemsContext is ReorderItems with context support
+func (v Market) ReorderItemsContext(ctx context.Context, params MarketReorderItemsParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.reorderItems", params)
 	if err != nil {
 		return false, err
 	}
@@ -637,7 +738,12 @@ type MarketReorderAlbumsParams struct {
 
 // ReorderAlbums Reorders the collections list.
 func (v Market) ReorderAlbums(params MarketReorderAlbumsParams) (bool, error) {
-	r, err := v.API.Request("market.reorderAlbums", params)
+	return v.ReorderAlbumsContext(context.Background(), params)
+}
+
+// ReorderAlbumsContext is ReorderAlbums with context support
+func (v Market) ReorderAlbumsContext(ctx context.Context, params MarketReorderAlbumsParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.reorderAlbums", params)
 	if err != nil {
 		return false, err
 	}
@@ -658,6 +764,7 @@ type MarketAddAlbumParams struct {
 }
 
 // MarketAddAlbumResponse is response for Market.AddAlbum
+//
 //easyjson:json
 type MarketAddAlbumResponse struct {
 	// Album ID
@@ -666,13 +773,18 @@ type MarketAddAlbumResponse struct {
 
 // AddAlbum Creates new collection of items
 func (v Market) AddAlbum(params MarketAddAlbumParams) (*MarketAddAlbumResponse, error) {
-	r, err := v.API.Request("market.addAlbum", params)
+	return v.AddAlbumContext(context.Background(), params)
+}
+
+// AddAlbumContext is AddAlbum with context support
+func (v Market) AddAlbumContext(ctx context.Context, params MarketAddAlbumParams) (*MarketAddAlbumResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.addAlbum", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MarketAddAlbumResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -695,7 +807,12 @@ type MarketEditAlbumParams struct {
 
 // EditAlbum Edits a collection of items
 func (v Market) EditAlbum(params MarketEditAlbumParams) (bool, error) {
-	r, err := v.API.Request("market.editAlbum", params)
+	return v.EditAlbumContext(context.Background(), params)
+}
+
+// EditAlbumContext is EditAlbum with context support
+func (v Market) EditAlbumContext(ctx context.Context, params MarketEditAlbumParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.editAlbum", params)
 	if err != nil {
 		return false, err
 	}
@@ -713,7 +830,12 @@ type MarketDeleteAlbumParams struct {
 
 // DeleteAlbum Deletes a collection of items.
 func (v Market) DeleteAlbum(params MarketDeleteAlbumParams) (bool, error) {
-	r, err := v.API.Request("market.deleteAlbum", params)
+	return v.DeleteAlbumContext(context.Background(), params)
+}
+
+// DeleteAlbumContext is DeleteAlbum with context support
+func (v Market) DeleteAlbumContext(ctx context.Context, params MarketDeleteAlbumParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.deleteAlbum", params)
 	if err != nil {
 		return false, err
 	}
@@ -733,7 +855,12 @@ type MarketRemoveFromAlbumParams struct {
 
 // RemoveFromAlbum Removes an item from one or multiple collections.
 func (v Market) RemoveFromAlbum(params MarketRemoveFromAlbumParams) (bool, error) {
-	r, err := v.API.Request("market.removeFromAlbum", params)
+	return v.RemoveFromAlbumContext(context.Background(), params)
+}
+
+// RemoveFromAlbumContext is RemoveFromAlbum with context support
+func (v Market) RemoveFromAlbumContext(ctx context.Context, params MarketRemoveFromAlbumParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.removeFromAlbum", params)
 	if err != nil {
 		return false, err
 	}
@@ -753,7 +880,12 @@ type MarketAddToAlbumParams struct {
 
 // AddToAlbum Adds an item to one or multiple collections.
 func (v Market) AddToAlbum(params MarketAddToAlbumParams) (bool, error) {
-	r, err := v.API.Request("market.addToAlbum", params)
+	return v.AddToAlbumContext(context.Background(), params)
+}
+
+// AddToAlbumContext is AddToAlbum with context support
+func (v Market) AddToAlbumContext(ctx context.Context, params MarketAddToAlbumParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "market.addToAlbum", params)
 	if err != nil {
 		return false, err
 	}
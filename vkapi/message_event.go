@@ -0,0 +1,89 @@
+package vkapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"unicode/utf8"
+)
+
+// Possible EventData types
+const (
+	EventDataShowSnackbar = "show_snackbar"
+	EventDataOpenLink     = "open_link"
+	EventDataOpenApp      = "open_app"
+)
+
+const maxSnackbarText = 90
+
+// EventData is action performed in client when message_event is answered
+//
+// EventData conforms to query.Encoder interface
+type EventData struct {
+	// Type is one of EventData* constants
+	Type string `json:"type"`
+	// Text of snackbar, up to 90 characters
+	Text string `json:"text,omitempty"`
+	// Link to be opened
+	Link string `json:"link,omitempty"`
+	// AppID of VK Mini App to be opened
+	AppID int `json:"app_id,omitempty"`
+	// OwnerID of community app is opened in, optional
+	OwnerID int `json:"owner_id,omitempty"`
+	// Hash is navigation hash of app, optional
+	Hash string `json:"hash,omitempty"`
+}
+
+// ShowSnackbar returns EventData which shows text in snackbar
+func ShowSnackbar(text string) *EventData {
+	return &EventData{Type: EventDataShowSnackbar, Text: text}
+}
+
+// OpenLink returns EventData which opens link
+func OpenLink(link string) *EventData {
+	return &EventData{Type: EventDataOpenLink, Link: link}
+}
+
+// OpenApp returns EventData which opens VK Mini App,
+// ownerID and hash are optional
+func OpenApp(appID, ownerID int, hash string) *EventData {
+	return &EventData{Type: EventDataOpenApp, AppID: appID, OwnerID: ownerID, Hash: hash}
+}
+
+// Validate checks that required fields of d are set
+func (d *EventData) Validate() error {
+	switch d.Type {
+	case EventDataShowSnackbar:
+		if d.Text == "" || utf8.RuneCountInString(d.Text) > maxSnackbarText {
+			return fmt.Errorf("vkapi: snackbar text should be 1 to %d characters long", maxSnackbarText)
+		}
+	case EventDataOpenLink:
+		if d.Link == "" {
+			return errors.New("vkapi: link is required")
+		}
+	case EventDataOpenApp:
+		if d.AppID == 0 {
+			return errors.New("vkapi: app ID is required")
+		}
+	default:
+		return fmt.Errorf("vkapi: unknown event data type %q", d.Type)
+	}
+
+	return nil
+}
+
+// EncodeValues conforms to query.Encoder interface
+func (d *EventData) EncodeValues(key string, v *url.Values) error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+
+	v.Set(key, string(encoded))
+	return nil
+}
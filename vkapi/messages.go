@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 	"strconv"
 
 	"github.com/stek29/vk"
@@ -19,6 +19,7 @@ type MessagesJoinChatByInviteLinkParams struct {
 }
 
 // MessagesJoinChatByInviteLinkResponse is response for Messages.JoinChatByInviteLink
+//
 //easyjson:json
 type MessagesJoinChatByInviteLinkResponse struct {
 	ChatID int `json:"chat_id,omitempty"`
@@ -26,13 +27,18 @@ type MessagesJoinChatByInviteLinkResponse struct {
 
 // JoinChatByInviteLink does messages.joinChatByInviteLink
 func (v Messages) JoinChatByInviteLink(params MessagesJoinChatByInviteLinkParams) (*MessagesJoinChatByInviteLinkResponse, error) {
-	r, err := v.API.Request("messages.joinChatByInviteLink", params)
+	return v.JoinChatByInviteLinkContext(context.Background(), params)
+}
+
+// JoinChatByInviteLinkContext is JoinChatByInviteLink with context support
+func (v Messages) JoinChatByInviteLinkContext(ctx context.Context, params MessagesJoinChatByInviteLinkParams) (*MessagesJoinChatByInviteLinkResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.joinChatByInviteLink", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesJoinChatByInviteLinkResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -50,6 +56,7 @@ type MessagesGetInviteLinkParams struct {
 }
 
 // MessagesGetInviteLinkResponse is response for Messages.GetInviteLink
+//
 //easyjson:json
 type MessagesGetInviteLinkResponse struct {
 	Link string `json:"link,omitempty"`
@@ -57,13 +64,18 @@ type MessagesGetInviteLinkResponse struct {
 
 // GetInviteLink does messages.getInviteLink
 func (v Messages) GetInviteLink(params MessagesGetInviteLinkParams) (*MessagesGetInviteLinkResponse, error) {
-	r, err := v.API.Request("messages.getInviteLink", params)
+	return v.GetInviteLinkContext(context.Background(), params)
+}
+
+// GetInviteLinkContext is GetInviteLink with context support
+func (v Messages) GetInviteLinkContext(ctx context.Context, params MessagesGetInviteLinkParams) (*MessagesGetInviteLinkResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.getInviteLink", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesGetInviteLinkResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -89,6 +101,7 @@ type MessagesGetConversationsParams struct {
 }
 
 // MessagesGetConversationsResponse is response for Messages.GetConversations
+//
 //easyjson:json
 type MessagesGetConversationsResponse struct {
 	// Total number
@@ -105,13 +118,18 @@ type MessagesGetConversationsResponse struct {
 
 // GetConversations Returns a list of the current user's conversations.
 func (v Messages) GetConversations(params MessagesGetConversationsParams) (*MessagesGetConversationsResponse, error) {
-	r, err := v.API.Request("messages.getConversations", params)
+	return v.GetConversationsContext(context.Background(), params)
+}
+
+// GetConversationsContext is GetConversations with context support
+func (v Messages) GetConversationsContext(ctx context.Context, params MessagesGetConversationsParams) (*MessagesGetConversationsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.getConversations", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesGetConversationsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -131,6 +149,7 @@ type MessagesGetConversationsByIDParams struct {
 }
 
 // MessagesGetConversationsByIDResponse is response for Messages.GetConversationsByID
+//
 //easyjson:json
 type MessagesGetConversationsByIDResponse struct {
 	// Total number
@@ -143,13 +162,18 @@ type MessagesGetConversationsByIDResponse struct {
 
 // GetConversationsByID Returns conversations by their IDs
 func (v Messages) GetConversationsByID(params MessagesGetConversationsByIDParams) (*MessagesGetConversationsByIDResponse, error) {
-	r, err := v.API.Request("messages.getConversationsById", params)
+	return v.GetConversationsByIDContext(context.Background(), params)
+}
+
+// GetConversationsByIDContext is GetConversationsByID with context support
+func (v Messages) GetConversationsByIDContext(ctx context.Context, params MessagesGetConversationsByIDParams) (*MessagesGetConversationsByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.getConversationsById", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesGetConversationsByIDResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -171,6 +195,7 @@ type MessagesGetByIDParams struct {
 }
 
 // MessagesGetByIDResponse is response for Messages.GetByID
+//
 //easyjson:json
 type MessagesGetByIDResponse struct {
 	// Total number
@@ -180,13 +205,18 @@ type MessagesGetByIDResponse struct {
 
 // GetByID Returns messages by their IDs.
 func (v Messages) GetByID(params MessagesGetByIDParams) (*MessagesGetByIDResponse, error) {
-	r, err := v.API.Request("messages.getById", params)
+	return v.GetByIDContext(context.Background(), params)
+}
+
+// GetByIDContext is GetByID with context support
+func (v Messages) GetByIDContext(ctx context.Context, params MessagesGetByIDParams) (*MessagesGetByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.getById", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesGetByIDResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -208,6 +238,7 @@ type MessagesGetByConversationMessageIDParams struct {
 }
 
 // MessagesGetByConversationMessageIDResponse is response for Messages.GetByConversationMessageID
+//
 //easyjson:json
 type MessagesGetByConversationMessageIDResponse struct {
 	// Total number
@@ -217,13 +248,18 @@ type MessagesGetByConversationMessageIDResponse struct {
 
 // GetByConversationMessageID Returns messages by their IDs within the conversation.
 func (v Messages) GetByConversationMessageID(params MessagesGetByConversationMessageIDParams) (*MessagesGetByConversationMessageIDResponse, error) {
-	r, err := v.API.Request("messages.getByConversationMessageId", params)
+	return v.GetByConversationMessageIDContext(context.Background(), params)
+}
+
+// GetByConversationMessageIDContext is GetByConversationMessageID with context support
+func (v Messages) GetByConversationMessageIDContext(ctx context.Context, params MessagesGetByConversationMessageIDParams) (*MessagesGetByConversationMessageIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.getByConversationMessageId", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesGetByConversationMessageIDResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -251,6 +287,7 @@ type MessagesSearchParams struct {
 }
 
 // MessagesSearchResponse is response for Messages.Search
+//
 //easyjson:json
 type MessagesSearchResponse struct {
 	// Total number
@@ -260,13 +297,18 @@ type MessagesSearchResponse struct {
 
 // Search Returns a list of the current user's private messages that match search criteria.
 func (v Messages) Search(params MessagesSearchParams) (*MessagesSearchResponse, error) {
-	r, err := v.API.Request("messages.search", params)
+	return v.SearchContext(context.Background(), params)
+}
+
+// SearchContext is Search with context support
+func (v Messages) SearchContext(ctx context.Context, params MessagesSearchParams) (*MessagesSearchResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.search", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesSearchResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -295,6 +337,7 @@ type MessagesGetHistoryParams struct {
 }
 
 // MessagesGetHistoryResponse is response for Messages.GetHistory
+//
 //easyjson:json
 type MessagesGetHistoryResponse struct {
 	// Total number
@@ -306,13 +349,18 @@ type MessagesGetHistoryResponse struct {
 
 // GetHistory Returns message history for the specified user or group chat.
 func (v Messages) GetHistory(params MessagesGetHistoryParams) (*MessagesGetHistoryResponse, error) {
-	r, err := v.API.Request("messages.getHistory", params)
+	return v.GetHistoryContext(context.Background(), params)
+}
+
+// GetHistoryContext is GetHistory with context support
+func (v Messages) GetHistoryContext(ctx context.Context, params MessagesGetHistoryParams) (*MessagesGetHistoryResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.getHistory", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesGetHistoryResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -338,6 +386,7 @@ type MessagesGetHistoryAttachmentsParams struct {
 }
 
 // MessagesGetHistoryAttachmentsResponse is response for Messages.GetHistoryAttachments
+//
 //easyjson:json
 type MessagesGetHistoryAttachmentsResponse struct {
 	Items []struct {
@@ -351,13 +400,18 @@ type MessagesGetHistoryAttachmentsResponse struct {
 
 // GetHistoryAttachments Returns media files from the dialog or group chat.
 func (v Messages) GetHistoryAttachments(params MessagesGetHistoryAttachmentsParams) (*MessagesGetHistoryAttachmentsResponse, error) {
-	r, err := v.API.Request("messages.getHistoryAttachments", params)
+	return v.GetHistoryAttachmentsContext(context.Background(), params)
+}
+
+// GetHistoryAttachmentsContext is GetHistoryAttachments with context support
+func (v Messages) GetHistoryAttachmentsContext(ctx context.Context, params MessagesGetHistoryAttachmentsParams) (*MessagesGetHistoryAttachmentsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.getHistoryAttachments", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesGetHistoryAttachmentsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -392,10 +446,11 @@ type MessagesSendParams struct {
 	// Sticker id.
 	StickerID int `url:"sticker_id,omitempty"`
 	// Group ID (for group messages with group access token)
-	GroupID        int    `url:"group_id,omitempty"`
-	Keyboard       string `url:"keyboard,omitempty"`
-	Payload        string `url:"payload,omitempty"`
-	DontParseLinks bool   `url:"dont_parse_links,omitempty"`
+	GroupID        int              `url:"group_id,omitempty"`
+	Keyboard       vk.KeyboardParam `url:"keyboard,omitempty"`
+	Payload        string           `url:"payload,omitempty"`
+	DontParseLinks bool             `url:"dont_parse_links,omitempty"`
+	Template       *vk.Template     `url:"template,omitempty"`
 }
 
 // MessagesSendResponse is response for Messages.Send
@@ -404,7 +459,12 @@ type MessagesSendResponse int
 
 // Send Sends a message.
 func (v Messages) Send(params MessagesSendParams) (MessagesSendResponse, error) {
-	r, err := v.API.Request("messages.send", params)
+	return v.SendContext(context.Background(), params)
+}
+
+// SendContext is Send with context support
+func (v Messages) SendContext(ctx context.Context, params MessagesSendParams) (MessagesSendResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.send", params)
 	if err != nil {
 		return 0, err
 	}
@@ -439,13 +499,19 @@ type MessagesEditParams struct {
 	// '1' — to keep attached snippets.
 	KeepSnippets bool `url:"keep_snippets,omitempty"`
 	// Group ID (for group messages with user access token)
-	GroupID        int  `url:"group_id,omitempty"`
-	DontParseLinks bool `url:"dont_parse_links,omitempty"`
+	GroupID        int              `url:"group_id,omitempty"`
+	DontParseLinks bool             `url:"dont_parse_links,omitempty"`
+	Keyboard       vk.KeyboardParam `url:"keyboard,omitempty"`
 }
 
 // Edit Edits the message.
 func (v Messages) Edit(params MessagesEditParams) (bool, error) {
-	r, err := v.API.Request("messages.edit", params)
+	return v.EditContext(context.Background(), params)
+}
+
+// EditContext is Edit with context support
+func (v Messages) EditContext(ctx context.Context, params MessagesEditParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.edit", params)
 	if err != nil {
 		return false, err
 	}
@@ -466,18 +532,24 @@ type MessagesDeleteParams struct {
 }
 
 // MessagesDeleteResponse is response for Messages.Delete
+//
 //easyjson:json
 type MessagesDeleteResponse ArrayOnMeth
 
 // Delete Deletes one or more messages.
 func (v Messages) Delete(params MessagesDeleteParams) (*MessagesDeleteResponse, error) {
-	r, err := v.API.Request("messages.delete", params)
+	return v.DeleteContext(context.Background(), params)
+}
+
+// DeleteContext is Delete with context support
+func (v Messages) DeleteContext(ctx context.Context, params MessagesDeleteParams) (*MessagesDeleteResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.delete", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesDeleteResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -499,6 +571,7 @@ type MessagesDeleteConversationParams struct {
 }
 
 // MessagesDeleteConversationResponse is response for Messages.DeleteConversation
+//
 //easyjson:json
 type MessagesDeleteConversationResponse struct {
 	// Id of the last message, that was deleted
@@ -507,13 +580,18 @@ type MessagesDeleteConversationResponse struct {
 
 // DeleteConversation Deletes all private messages in a conversation.
 func (v Messages) DeleteConversation(params MessagesDeleteConversationParams) (*MessagesDeleteConversationResponse, error) {
-	r, err := v.API.Request("messages.deleteConversation", params)
+	return v.DeleteConversationContext(context.Background(), params)
+}
+
+// DeleteConversationContext is DeleteConversation with context support
+func (v Messages) DeleteConversationContext(ctx context.Context, params MessagesDeleteConversationParams) (*MessagesDeleteConversationResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.deleteConversation", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesDeleteConversationResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -528,18 +606,24 @@ type MessagesPinParams struct {
 }
 
 // MessagesPinResponse is response for Messages.Pin
+//
 //easyjson:json
 type MessagesPinResponse vk.Message
 
 // Pin Pin a message.
 func (v Messages) Pin(params MessagesPinParams) (*MessagesPinResponse, error) {
-	r, err := v.API.Request("messages.pin", params)
+	return v.PinContext(context.Background(), params)
+}
+
+// PinContext is Pin with context support
+func (v Messages) PinContext(ctx context.Context, params MessagesPinParams) (*MessagesPinResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.pin", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesPinResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -556,7 +640,12 @@ type MessagesRestoreParams struct {
 
 // Restore Restores a deleted message.
 func (v Messages) Restore(params MessagesRestoreParams) (bool, error) {
-	r, err := v.API.Request("messages.restore", params)
+	return v.RestoreContext(context.Background(), params)
+}
+
+// RestoreContext is Restore with context support
+func (v Messages) RestoreContext(ctx context.Context, params MessagesRestoreParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.restore", params)
 	if err != nil {
 		return false, err
 	}
@@ -576,7 +665,12 @@ type MessagesMarkAsReadParams struct {
 
 // MarkAsRead Marks messages as read.
 func (v Messages) MarkAsRead(params MessagesMarkAsReadParams) (bool, error) {
-	r, err := v.API.Request("messages.markAsRead", params)
+	return v.MarkAsReadContext(context.Background(), params)
+}
+
+// MarkAsReadContext is MarkAsRead with context support
+func (v Messages) MarkAsReadContext(ctx context.Context, params MessagesMarkAsReadParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.markAsRead", params)
 	if err != nil {
 		return false, err
 	}
@@ -594,18 +688,24 @@ type MessagesMarkAsImportantParams struct {
 
 // MessagesMarkAsImportantResponse is response for Messages.MarkAsImportant
 // Messages IDs
+//
 //easyjson:json
 type MessagesMarkAsImportantResponse []int
 
 // MarkAsImportant Marks and unmarks messages as important (starred).
 func (v Messages) MarkAsImportant(params MessagesMarkAsImportantParams) (MessagesMarkAsImportantResponse, error) {
-	r, err := v.API.Request("messages.markAsImportant", params)
+	return v.MarkAsImportantContext(context.Background(), params)
+}
+
+// MarkAsImportantContext is MarkAsImportant with context support
+func (v Messages) MarkAsImportantContext(ctx context.Context, params MessagesMarkAsImportantParams) (MessagesMarkAsImportantResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.markAsImportant", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesMarkAsImportantResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -624,7 +724,12 @@ type MessagesMarkAsImportantConversationParams struct {
 
 // MarkAsImportantConversation Marks and unmarks conversations as important.
 func (v Messages) MarkAsImportantConversation(params MessagesMarkAsImportantConversationParams) (bool, error) {
-	r, err := v.API.Request("messages.markAsImportantConversation", params)
+	return v.MarkAsImportantConversationContext(context.Background(), params)
+}
+
+// MarkAsImportantConversationContext is MarkAsImportantConversation with context support
+func (v Messages) MarkAsImportantConversationContext(ctx context.Context, params MessagesMarkAsImportantConversationParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.markAsImportantConversation", params)
 	if err != nil {
 		return false, err
 	}
@@ -644,7 +749,12 @@ type MessagesMarkAsAnsweredConversationParams struct {
 
 // MarkAsAnsweredConversation Marks and unmarks conversations as unanswered.
 func (v Messages) MarkAsAnsweredConversation(params MessagesMarkAsAnsweredConversationParams) (bool, error) {
-	r, err := v.API.Request("messages.markAsAnsweredConversation", params)
+	return v.MarkAsAnsweredConversationContext(context.Background(), params)
+}
+
+// MarkAsAnsweredConversationContext is MarkAsAnsweredConversation with context support
+func (v Messages) MarkAsAnsweredConversationContext(ctx context.Context, params MessagesMarkAsAnsweredConversationParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.markAsAnsweredConversation", params)
 	if err != nil {
 		return false, err
 	}
@@ -663,6 +773,7 @@ type MessagesGetLongPollServerParams struct {
 }
 
 // MessagesGetLongPollServerResponse is response for Messages.GetLongPollServer
+//
 //easyjson:json
 type MessagesGetLongPollServerResponse struct {
 	// Key
@@ -677,13 +788,18 @@ type MessagesGetLongPollServerResponse struct {
 
 // GetLongPollServer Returns data required for connection to a Long Poll server.
 func (v Messages) GetLongPollServer(params MessagesGetLongPollServerParams) (*MessagesGetLongPollServerResponse, error) {
-	r, err := v.API.Request("messages.getLongPollServer", params)
+	return v.GetLongPollServerContext(context.Background(), params)
+}
+
+// GetLongPollServerContext is GetLongPollServer with context support
+func (v Messages) GetLongPollServerContext(ctx context.Context, params MessagesGetLongPollServerParams) (*MessagesGetLongPollServerResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.getLongPollServer", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesGetLongPollServerResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -714,6 +830,7 @@ type MessagesGetLongPollHistoryParams struct {
 }
 
 // MessagesGetLongPollHistoryResponse is response for Messages.GetLongPollHistory
+//
 //easyjson:json
 type MessagesGetLongPollHistoryResponse struct {
 	// Longpoll event value
@@ -734,13 +851,18 @@ type MessagesGetLongPollHistoryResponse struct {
 
 // GetLongPollHistory Returns updates in user's private messages.
 func (v Messages) GetLongPollHistory(params MessagesGetLongPollHistoryParams) (*MessagesGetLongPollHistoryResponse, error) {
-	r, err := v.API.Request("messages.getLongPollHistory", params)
+	return v.GetLongPollHistoryContext(context.Background(), params)
+}
+
+// GetLongPollHistoryContext is GetLongPollHistory with context support
+func (v Messages) GetLongPollHistoryContext(ctx context.Context, params MessagesGetLongPollHistoryParams) (*MessagesGetLongPollHistoryResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.getLongPollHistory", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesGetLongPollHistoryResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -756,6 +878,7 @@ type MessagesGetChatPreviewParams struct {
 }
 
 // MessagesGetChatPreviewResponse is response for Messages.GetChatPreview
+//
 //easyjson:json
 type MessagesGetChatPreviewResponse struct {
 	Preview struct {
@@ -771,13 +894,18 @@ type MessagesGetChatPreviewResponse struct {
 
 // GetChatPreview does messages.getChatPreview
 func (v Messages) GetChatPreview(params MessagesGetChatPreviewParams) (*MessagesGetChatPreviewResponse, error) {
-	r, err := v.API.Request("messages.getChatPreview", params)
+	return v.GetChatPreviewContext(context.Background(), params)
+}
+
+// GetChatPreviewContext is GetChatPreview with context support
+func (v Messages) GetChatPreviewContext(ctx context.Context, params MessagesGetChatPreviewParams) (*MessagesGetChatPreviewResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.getChatPreview", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesGetChatPreviewResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -798,7 +926,12 @@ type MessagesCreateChatResponse int
 
 // CreateChat Creates a chat with several participants.
 func (v Messages) CreateChat(params MessagesCreateChatParams) (MessagesCreateChatResponse, error) {
-	r, err := v.API.Request("messages.createChat", params)
+	return v.CreateChatContext(context.Background(), params)
+}
+
+// CreateChatContext is CreateChat with context support
+func (v Messages) CreateChatContext(ctx context.Context, params MessagesCreateChatParams) (MessagesCreateChatResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.createChat", params)
 	if err != nil {
 		return 0, err
 	}
@@ -825,7 +958,12 @@ type MessagesEditChatParams struct {
 
 // EditChat Edits the title of a chat.
 func (v Messages) EditChat(params MessagesEditChatParams) (bool, error) {
-	r, err := v.API.Request("messages.editChat", params)
+	return v.EditChatContext(context.Background(), params)
+}
+
+// EditChatContext is EditChat with context support
+func (v Messages) EditChatContext(ctx context.Context, params MessagesEditChatParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.editChat", params)
 	if err != nil {
 		return false, err
 	}
@@ -844,6 +982,7 @@ type MessagesGetConversationMembersParams struct {
 }
 
 // MessagesGetConversationMembersResponse is response for Messages.GetConversationMembers
+//
 //easyjson:json
 type MessagesGetConversationMembersResponse struct {
 	// Chat members count
@@ -875,13 +1014,18 @@ type MessagesGetConversationMembersResponse struct {
 
 // GetConversationMembers Returns a list of IDs of users participating in a chat.
 func (v Messages) GetConversationMembers(params MessagesGetConversationMembersParams) (*MessagesGetConversationMembersResponse, error) {
-	r, err := v.API.Request("messages.getConversationMembers", params)
+	return v.GetConversationMembersContext(context.Background(), params)
+}
+
+// GetConversationMembersContext is GetConversationMembers with context support
+func (v Messages) GetConversationMembersContext(ctx context.Context, params MessagesGetConversationMembersParams) (*MessagesGetConversationMembersResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.getConversationMembers", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesGetConversationMembersResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -902,7 +1046,12 @@ type MessagesSetActivityParams struct {
 
 // SetActivity Changes the status of a user as typing in a conversation.
 func (v Messages) SetActivity(params MessagesSetActivityParams) (bool, error) {
-	r, err := v.API.Request("messages.setActivity", params)
+	return v.SetActivityContext(context.Background(), params)
+}
+
+// SetActivityContext is SetActivity with context support
+func (v Messages) SetActivityContext(ctx context.Context, params MessagesSetActivityParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.setActivity", params)
 	if err != nil {
 		return false, err
 	}
@@ -925,6 +1074,7 @@ type MessagesSearchConversationsParams struct {
 }
 
 // MessagesSearchConversationsResponse is response for Messages.SearchConversations
+//
 //easyjson:json
 type MessagesSearchConversationsResponse struct {
 	// Total results number
@@ -936,13 +1086,18 @@ type MessagesSearchConversationsResponse struct {
 
 // SearchConversations Returns a list of the current user's conversations that match search criteria.
 func (v Messages) SearchConversations(params MessagesSearchConversationsParams) (*MessagesSearchConversationsResponse, error) {
-	r, err := v.API.Request("messages.searchConversations", params)
+	return v.SearchConversationsContext(context.Background(), params)
+}
+
+// SearchConversationsContext is SearchConversations with context support
+func (v Messages) SearchConversationsContext(ctx context.Context, params MessagesSearchConversationsParams) (*MessagesSearchConversationsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.searchConversations", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesSearchConversationsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -959,7 +1114,12 @@ type MessagesAddChatUserParams struct {
 
 // AddChatUser Adds a new user to a chat.
 func (v Messages) AddChatUser(params MessagesAddChatUserParams) (bool, error) {
-	r, err := v.API.Request("messages.addChatUser", params)
+	return v.AddChatUserContext(context.Background(), params)
+}
+
+// AddChatUserContext is AddChatUser with context support
+func (v Messages) AddChatUserContext(ctx context.Context, params MessagesAddChatUserParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.addChatUser", params)
 	if err != nil {
 		return false, err
 	}
@@ -978,7 +1138,12 @@ type MessagesRemoveChatUserParams struct {
 
 // RemoveChatUser Allows the current user to leave a chat or, if the current user started the chat, allows the user to remove another user from the chat.
 func (v Messages) RemoveChatUser(params MessagesRemoveChatUserParams) (bool, error) {
-	r, err := v.API.Request("messages.removeChatUser", params)
+	return v.RemoveChatUserContext(context.Background(), params)
+}
+
+// RemoveChatUserContext is RemoveChatUser with context support
+func (v Messages) RemoveChatUserContext(ctx context.Context, params MessagesRemoveChatUserParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.removeChatUser", params)
 	if err != nil {
 		return false, err
 	}
@@ -993,6 +1158,7 @@ type MessagesGetLastActivityParams struct {
 }
 
 // MessagesGetLastActivityResponse is response for Messages.GetLastActivity
+//
 //easyjson:json
 type MessagesGetLastActivityResponse struct {
 	// Information whether user is online
@@ -1003,13 +1169,18 @@ type MessagesGetLastActivityResponse struct {
 
 // GetLastActivity Returns a user's current status and date of last activity.
 func (v Messages) GetLastActivity(params MessagesGetLastActivityParams) (*MessagesGetLastActivityResponse, error) {
-	r, err := v.API.Request("messages.getLastActivity", params)
+	return v.GetLastActivityContext(context.Background(), params)
+}
+
+// GetLastActivityContext is GetLastActivity with context support
+func (v Messages) GetLastActivityContext(ctx context.Context, params MessagesGetLastActivityParams) (*MessagesGetLastActivityResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.getLastActivity", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesGetLastActivityResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1023,6 +1194,7 @@ type MessagesSetChatPhotoParams struct {
 }
 
 // MessagesSetChatPhotoResponse is response for Messages.SetChatPhoto
+//
 //easyjson:json
 type MessagesSetChatPhotoResponse struct {
 	// Service message ID
@@ -1032,13 +1204,18 @@ type MessagesSetChatPhotoResponse struct {
 
 // SetChatPhoto Sets a previously-uploaded picture as the cover picture of a chat.
 func (v Messages) SetChatPhoto(params MessagesSetChatPhotoParams) (*MessagesSetChatPhotoResponse, error) {
-	r, err := v.API.Request("messages.setChatPhoto", params)
+	return v.SetChatPhotoContext(context.Background(), params)
+}
+
+// SetChatPhotoContext is SetChatPhoto with context support
+func (v Messages) SetChatPhotoContext(ctx context.Context, params MessagesSetChatPhotoParams) (*MessagesSetChatPhotoResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.setChatPhoto", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesSetChatPhotoResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1053,6 +1230,7 @@ type MessagesDeleteChatPhotoParams struct {
 }
 
 // MessagesDeleteChatPhotoResponse is response for Messages.DeleteChatPhoto
+//
 //easyjson:json
 type MessagesDeleteChatPhotoResponse struct {
 	// Service message ID
@@ -1062,13 +1240,18 @@ type MessagesDeleteChatPhotoResponse struct {
 
 // DeleteChatPhoto Deletes a chat's cover picture.
 func (v Messages) DeleteChatPhoto(params MessagesDeleteChatPhotoParams) (*MessagesDeleteChatPhotoResponse, error) {
-	r, err := v.API.Request("messages.deleteChatPhoto", params)
+	return v.DeleteChatPhotoContext(context.Background(), params)
+}
+
+// DeleteChatPhotoContext is DeleteChatPhoto with context support
+func (v Messages) DeleteChatPhotoContext(ctx context.Context, params MessagesDeleteChatPhotoParams) (*MessagesDeleteChatPhotoResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.deleteChatPhoto", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesDeleteChatPhotoResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1083,7 +1266,12 @@ type MessagesDenyMessagesFromGroupParams struct {
 
 // DenyMessagesFromGroup Denies sending message from community to the current user.
 func (v Messages) DenyMessagesFromGroup(params MessagesDenyMessagesFromGroupParams) (bool, error) {
-	r, err := v.API.Request("messages.denyMessagesFromGroup", params)
+	return v.DenyMessagesFromGroupContext(context.Background(), params)
+}
+
+// DenyMessagesFromGroupContext is DenyMessagesFromGroup with context support
+func (v Messages) DenyMessagesFromGroupContext(ctx context.Context, params MessagesDenyMessagesFromGroupParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.denyMessagesFromGroup", params)
 	if err != nil {
 		return false, err
 	}
@@ -1100,7 +1288,12 @@ type MessagesAllowMessagesFromGroupParams struct {
 
 // AllowMessagesFromGroup Allows sending messages from community to the current user.
 func (v Messages) AllowMessagesFromGroup(params MessagesAllowMessagesFromGroupParams) (bool, error) {
-	r, err := v.API.Request("messages.allowMessagesFromGroup", params)
+	return v.AllowMessagesFromGroupContext(context.Background(), params)
+}
+
+// AllowMessagesFromGroupContext is AllowMessagesFromGroup with context support
+func (v Messages) AllowMessagesFromGroupContext(ctx context.Context, params MessagesAllowMessagesFromGroupParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.allowMessagesFromGroup", params)
 	if err != nil {
 		return false, err
 	}
@@ -1117,6 +1310,7 @@ type MessagesIsMessagesFromGroupAllowedParams struct {
 }
 
 // MessagesIsMessagesFromGroupAllowedResponse is response for Messages.IsMessagesFromGroupAllowed
+//
 //easyjson:json
 type MessagesIsMessagesFromGroupAllowedResponse struct {
 	IsAllowed vk.BoolInt `json:"is_allowed,omitempty"`
@@ -1124,13 +1318,18 @@ type MessagesIsMessagesFromGroupAllowedResponse struct {
 
 // IsMessagesFromGroupAllowed Returns information whether sending messages from the community to current user is allowed.
 func (v Messages) IsMessagesFromGroupAllowed(params MessagesIsMessagesFromGroupAllowedParams) (*MessagesIsMessagesFromGroupAllowedResponse, error) {
-	r, err := v.API.Request("messages.isMessagesFromGroupAllowed", params)
+	return v.IsMessagesFromGroupAllowedContext(context.Background(), params)
+}
+
+// IsMessagesFromGroupAllowedContext is IsMessagesFromGroupAllowed with context support
+func (v Messages) IsMessagesFromGroupAllowedContext(ctx context.Context, params MessagesIsMessagesFromGroupAllowedParams) (*MessagesIsMessagesFromGroupAllowedResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.isMessagesFromGroupAllowed", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp MessagesIsMessagesFromGroupAllowedResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1145,7 +1344,39 @@ type MessagesUnpinParams struct {
 
 // Unpin does messages.unpin
 func (v Messages) Unpin(params MessagesUnpinParams) (bool, error) {
-	r, err := v.API.Request("messages.unpin", params)
+	return v.UnpinContext(context.Background(), params)
+}
+
+// UnpinContext is Unpin with context support
+func (v Messages) UnpinContext(ctx context.Context, params MessagesUnpinParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.unpin", params)
+	if err != nil {
+		return false, err
+	}
+
+	return decodeBoolIntResponse(r)
+}
+
+// MessagesSendMessageEventAnswerParams are params for Messages.SendMessageEventAnswer
+type MessagesSendMessageEventAnswerParams struct {
+	// Event ID from message_event.
+	EventID string `url:"event_id"`
+	// ID of user who has pressed the button.
+	UserID int `url:"user_id"`
+	// ID of conversation the button was pressed in.
+	PeerID int `url:"peer_id"`
+	// Action to perform, if nil, button just stops loading.
+	EventData *EventData `url:"event_data,omitempty"`
+}
+
+// SendMessageEventAnswer Answers message_event sent when callback button is pressed.
+func (v Messages) SendMessageEventAnswer(params MessagesSendMessageEventAnswerParams) (bool, error) {
+	return v.SendMessageEventAnswerContext(context.Background(), params)
+}
+
+// SendMessageEventAnswerContext is SendMessageEventAnswer with context support
+func (v Messages) SendMessageEventAnswerContext(ctx context.Context, params MessagesSendMessageEventAnswerParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "messages.sendMessageEventAnswer", params)
 	if err != nil {
 		return false, err
 	}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 	"strconv"
 
 	"github.com/stek29/vk"
@@ -36,6 +36,7 @@ type NewsfeedGetParams struct {
 }
 
 // NewsfeedGetResponse is response for Newsfeed.Get
+//
 //easyjson:json
 type NewsfeedGetResponse struct {
 	Items    []vk.NewsfeedItem `json:"items,omitempty"`
@@ -45,13 +46,18 @@ type NewsfeedGetResponse struct {
 
 // Get Returns data required to show newsfeed for the current user.
 func (v Newsfeed) Get(params NewsfeedGetParams) (*NewsfeedGetResponse, error) {
-	r, err := v.API.Request("newsfeed.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Newsfeed) GetContext(ctx context.Context, params NewsfeedGetParams) (*NewsfeedGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "newsfeed.get", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp NewsfeedGetResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -75,6 +81,7 @@ type NewsfeedGetRecommendedParams struct {
 }
 
 // NewsfeedGetRecommendedResponse is response for Newsfeed.GetRecommended
+//
 //easyjson:json
 type NewsfeedGetRecommendedResponse struct {
 	Items    []vk.NewsfeedItem `json:"items,omitempty"`
@@ -88,13 +95,18 @@ type NewsfeedGetRecommendedResponse struct {
 
 // GetRecommended , Returns a list of newsfeeds recommended to the current user.
 func (v Newsfeed) GetRecommended(params NewsfeedGetRecommendedParams) (*NewsfeedGetRecommendedResponse, error) {
-	r, err := v.API.Request("newsfeed.getRecommended", params)
+	return v.GetRecommendedContext(context.Background(), params)
+}
+
+// GetRecommendedContext is GetRecommended with context support
+func (v Newsfeed) GetRecommendedContext(ctx context.Context, params NewsfeedGetRecommendedParams) (*NewsfeedGetRecommendedResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "newsfeed.getRecommended", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp NewsfeedGetRecommendedResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +133,7 @@ type NewsfeedGetCommentsParams struct {
 }
 
 // NewsfeedGetCommentsResponse is response for Newsfeed.GetComments
+//
 //easyjson:json
 type NewsfeedGetCommentsResponse struct {
 	Items    []vk.NewsfeedItem `json:"items,omitempty"`
@@ -132,13 +145,18 @@ type NewsfeedGetCommentsResponse struct {
 
 // GetComments Returns a list of comments in the current user's newsfeed.
 func (v Newsfeed) GetComments(params NewsfeedGetCommentsParams) (*NewsfeedGetCommentsResponse, error) {
-	r, err := v.API.Request("newsfeed.getComments", params)
+	return v.GetCommentsContext(context.Background(), params)
+}
+
+// GetCommentsContext is GetComments with context support
+func (v Newsfeed) GetCommentsContext(ctx context.Context, params NewsfeedGetCommentsParams) (*NewsfeedGetCommentsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "newsfeed.getComments", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp NewsfeedGetCommentsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -160,6 +178,7 @@ type NewsfeedGetMentionsParams struct {
 }
 
 // NewsfeedGetMentionsResponse is response for Newsfeed.GetMentions
+//
 //easyjson:json
 type NewsfeedGetMentionsResponse struct {
 	// Total number
@@ -169,13 +188,18 @@ type NewsfeedGetMentionsResponse struct {
 
 // GetMentions Returns a list of posts on user walls in which the current user is mentioned.
 func (v Newsfeed) GetMentions(params NewsfeedGetMentionsParams) (*NewsfeedGetMentionsResponse, error) {
-	r, err := v.API.Request("newsfeed.getMentions", params)
+	return v.GetMentionsContext(context.Background(), params)
+}
+
+// GetMentionsContext is GetMentions with context support
+func (v Newsfeed) GetMentionsContext(ctx context.Context, params NewsfeedGetMentionsParams) (*NewsfeedGetMentionsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "newsfeed.getMentions", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp NewsfeedGetMentionsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -199,6 +223,7 @@ type NewsfeedGetBannedResponse interface {
 }
 
 // NewsfeedGetBannedResponseNormal is non-extended version of NewsfeedGetBannedResponse
+//
 //easyjson:json
 type NewsfeedGetBannedResponseNormal struct {
 	// Community ID
@@ -210,6 +235,7 @@ type NewsfeedGetBannedResponseNormal struct {
 func (NewsfeedGetBannedResponseNormal) isNewsfeedGetBanned() {}
 
 // NewsfeedGetBannedResponseExtended is extended version of NewsfeedGetBannedResponse
+//
 //easyjson:json
 type NewsfeedGetBannedResponseExtended struct {
 	Groups  []vk.User  `json:"groups,omitempty"`
@@ -220,7 +246,12 @@ func (NewsfeedGetBannedResponseExtended) isNewsfeedGetBanned() {}
 
 // GetBanned Returns a list of users and communities banned from the current user's newsfeed.
 func (v Newsfeed) GetBanned(params NewsfeedGetBannedParams) (NewsfeedGetBannedResponse, error) {
-	r, err := v.API.Request("newsfeed.getBanned", params)
+	return v.GetBannedContext(context.Background(), params)
+}
+
+// GetBannedContext is GetBanned with context support
+func (v Newsfeed) GetBannedContext(ctx context.Context, params NewsfeedGetBannedParams) (NewsfeedGetBannedResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "newsfeed.getBanned", params)
 	if err != nil {
 		return nil, err
 	}
@@ -228,11 +259,11 @@ func (v Newsfeed) GetBanned(params NewsfeedGetBannedParams) (NewsfeedGetBannedRe
 	var resp NewsfeedGetBannedResponse
 	if params.Extended {
 		var tmp NewsfeedGetBannedResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp NewsfeedGetBannedResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -249,7 +280,12 @@ type NewsfeedAddBanParams struct {
 
 // AddBan Prevents news from specified users and communities from appearing in the current user's newsfeed.
 func (v Newsfeed) AddBan(params NewsfeedAddBanParams) (bool, error) {
-	r, err := v.API.Request("newsfeed.addBan", params)
+	return v.AddBanContext(context.Background(), params)
+}
+
+// AddBanContext is AddBan with context support
+func (v Newsfeed) AddBanContext(ctx context.Context, params NewsfeedAddBanParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "newsfeed.addBan", params)
 	if err != nil {
 		return false, err
 	}
@@ -265,7 +301,12 @@ type NewsfeedDeleteBanParams struct {
 
 // DeleteBan Allows news from previously banned users and communities to be shown in the current user's newsfeed.
 func (v Newsfeed) DeleteBan(params NewsfeedDeleteBanParams) (bool, error) {
-	r, err := v.API.Request("newsfeed.deleteBan", params)
+	return v.DeleteBanContext(context.Background(), params)
+}
+
+// DeleteBanContext is DeleteBan with context support
+func (v Newsfeed) DeleteBanContext(ctx context.Context, params NewsfeedDeleteBanParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "newsfeed.deleteBan", params)
 	if err != nil {
 		return false, err
 	}
@@ -285,7 +326,12 @@ type NewsfeedIgnoreItemParams struct {
 
 // IgnoreItem Hides an item from the newsfeed.
 func (v Newsfeed) IgnoreItem(params NewsfeedIgnoreItemParams) (bool, error) {
-	r, err := v.API.Request("newsfeed.ignoreItem", params)
+	return v.IgnoreItemContext(context.Background(), params)
+}
+
+// IgnoreItemContext is IgnoreItem with context support
+func (v Newsfeed) IgnoreItemContext(ctx context.Context, params NewsfeedIgnoreItemParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "newsfeed.ignoreItem", params)
 	if err != nil {
 		return false, err
 	}
@@ -305,7 +351,12 @@ type NewsfeedUnignoreItemParams struct {
 
 // UnignoreItem Returns a hidden item to the newsfeed.
 func (v Newsfeed) UnignoreItem(params NewsfeedUnignoreItemParams) (bool, error) {
-	r, err := v.API.Request("newsfeed.unignoreItem", params)
+	return v.UnignoreItemContext(context.Background(), params)
+}
+
+// UnignoreItemContext is UnignoreItem with context support
+func (v Newsfeed) UnignoreItemContext(ctx context.Context, params NewsfeedUnignoreItemParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "newsfeed.unignoreItem", params)
 	if err != nil {
 		return false, err
 	}
@@ -341,6 +392,7 @@ type NewsfeedSearchResponse interface {
 }
 
 // NewsfeedSearchResponseNormal is non-extended version of NewsfeedSearchResponse
+//
 //easyjson:json
 type NewsfeedSearchResponseNormal struct {
 	Items            []vk.Post `json:"items,omitempty"`
@@ -350,6 +402,7 @@ type NewsfeedSearchResponseNormal struct {
 func (NewsfeedSearchResponseNormal) isNewsfeedSearch() {}
 
 // NewsfeedSearchResponseExtended is extended version of NewsfeedSearchResponse
+//
 //easyjson:json
 type NewsfeedSearchResponseExtended struct {
 	Items    []vk.Post  `json:"items,omitempty"`
@@ -361,7 +414,12 @@ func (NewsfeedSearchResponseExtended) isNewsfeedSearch() {}
 
 // Search Returns search results by statuses.
 func (v Newsfeed) Search(params NewsfeedSearchParams) (NewsfeedSearchResponse, error) {
-	r, err := v.API.Request("newsfeed.search", params)
+	return v.SearchContext(context.Background(), params)
+}
+
+// SearchContext is Search with context support
+func (v Newsfeed) SearchContext(ctx context.Context, params NewsfeedSearchParams) (NewsfeedSearchResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "newsfeed.search", params)
 	if err != nil {
 		return nil, err
 	}
@@ -369,11 +427,11 @@ func (v Newsfeed) Search(params NewsfeedSearchParams) (NewsfeedSearchResponse, e
 	var resp NewsfeedSearchResponse
 	if params.Extended {
 		var tmp NewsfeedSearchResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp NewsfeedSearchResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -397,6 +455,7 @@ type NewsfeedGetListsResponse interface {
 }
 
 // NewsfeedGetListsResponseNormal is non-extended version of NewsfeedGetListsResponse
+//
 //easyjson:json
 type NewsfeedGetListsResponseNormal struct {
 	// Total number
@@ -407,6 +466,7 @@ type NewsfeedGetListsResponseNormal struct {
 func (NewsfeedGetListsResponseNormal) isNewsfeedGetLists() {}
 
 // NewsfeedGetListsResponseExtended is extended version of NewsfeedGetListsResponse
+//
 //easyjson:json
 type NewsfeedGetListsResponseExtended struct {
 	// Total number
@@ -418,7 +478,12 @@ func (NewsfeedGetListsResponseExtended) isNewsfeedGetLists() {}
 
 // GetLists Returns a list of newsfeeds followed by the current user.
 func (v Newsfeed) GetLists(params NewsfeedGetListsParams) (NewsfeedGetListsResponse, error) {
-	r, err := v.API.Request("newsfeed.getLists", params)
+	return v.GetListsContext(context.Background(), params)
+}
+
+// GetListsContext is GetLists with context support
+func (v Newsfeed) GetListsContext(ctx context.Context, params NewsfeedGetListsParams) (NewsfeedGetListsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "newsfeed.getLists", params)
 	if err != nil {
 		return nil, err
 	}
@@ -426,11 +491,11 @@ func (v Newsfeed) GetLists(params NewsfeedGetListsParams) (NewsfeedGetListsRespo
 	var resp NewsfeedGetListsResponse
 	if params.Extended {
 		var tmp NewsfeedGetListsResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp NewsfeedGetListsResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -457,7 +522,12 @@ type NewsfeedSaveListResponse int
 
 // SaveList Creates and edits user newsfeed lists
 func (v Newsfeed) SaveList(params NewsfeedSaveListParams) (NewsfeedSaveListResponse, error) {
-	r, err := v.API.Request("newsfeed.saveList", params)
+	return v.SaveListContext(context.Background(), params)
+}
+
+// SaveListContext is SaveList with context support
+func (v Newsfeed) SaveListContext(ctx context.Context, params NewsfeedSaveListParams) (NewsfeedSaveListResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "newsfeed.saveList", params)
 	if err != nil {
 		return 0, err
 	}
@@ -481,7 +551,12 @@ type NewsfeedDeleteListParams struct {
 
 // DeleteList does newsfeed.deleteList
 func (v Newsfeed) DeleteList(params NewsfeedDeleteListParams) (bool, error) {
-	r, err := v.API.Request("newsfeed.deleteList", params)
+	return v.DeleteListContext(context.Background(), params)
+}
+
+// DeleteListContext is DeleteList with context support
+func (v Newsfeed) DeleteListContext(ctx context.Context, params NewsfeedDeleteListParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "newsfeed.deleteList", params)
 	if err != nil {
 		return false, err
 	}
@@ -501,7 +576,12 @@ type NewsfeedUnsubscribeParams struct {
 
 // Unsubscribe Unsubscribes the current user from specified newsfeeds.
 func (v Newsfeed) Unsubscribe(params NewsfeedUnsubscribeParams) (bool, error) {
-	r, err := v.API.Request("newsfeed.unsubscribe", params)
+	return v.UnsubscribeContext(context.Background(), params)
+}
+
+// UnsubscribeContext is Unsubscribe with context support
+func (v Newsfeed) UnsubscribeContext(ctx context.Context, params NewsfeedUnsubscribeParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "newsfeed.unsubscribe", params)
 	if err != nil {
 		return false, err
 	}
@@ -522,6 +602,7 @@ type NewsfeedGetSuggestedSourcesParams struct {
 }
 
 // NewsfeedGetSuggestedSourcesResponse is response for Newsfeed.GetSuggestedSources
+//
 //easyjson:json
 type NewsfeedGetSuggestedSourcesResponse struct {
 	// Total number
@@ -531,13 +612,18 @@ type NewsfeedGetSuggestedSourcesResponse struct {
 
 // GetSuggestedSources Returns communities and users that current user is suggested to follow.
 func (v Newsfeed) GetSuggestedSources(params NewsfeedGetSuggestedSourcesParams) (*NewsfeedGetSuggestedSourcesResponse, error) {
-	r, err := v.API.Request("newsfeed.getSuggestedSources", params)
+	return v.GetSuggestedSourcesContext(context.Background(), params)
+}
+
+// GetSuggestedSourcesContext is GetSuggestedSources with context support
+func (v Newsfeed) GetSuggestedSourcesContext(ctx context.Context, params NewsfeedGetSuggestedSourcesParams) (*NewsfeedGetSuggestedSourcesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "newsfeed.getSuggestedSources", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp NewsfeedGetSuggestedSourcesResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
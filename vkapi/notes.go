@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 	"strconv"
 
 	"github.com/stek29/vk"
@@ -25,6 +25,7 @@ type NotesGetParams struct {
 }
 
 // NotesGetResponse is response for Notes.Get
+//
 //easyjson:json
 type NotesGetResponse struct {
 	// Total number
@@ -34,13 +35,18 @@ type NotesGetResponse struct {
 
 // Get Returns a list of notes created by a user.
 func (v Notes) Get(params NotesGetParams) (*NotesGetResponse, error) {
-	r, err := v.API.Request("notes.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Notes) GetContext(ctx context.Context, params NotesGetParams) (*NotesGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "notes.get", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp NotesGetResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -57,18 +63,24 @@ type NotesGetByIDParams struct {
 }
 
 // NotesGetByIDResponse is response for Notes.GetByID
+//
 //easyjson:json
 type NotesGetByIDResponse vk.Note
 
 // GetByID Returns a note by its ID.
 func (v Notes) GetByID(params NotesGetByIDParams) (*NotesGetByIDResponse, error) {
-	r, err := v.API.Request("notes.getById", params)
+	return v.GetByIDContext(context.Background(), params)
+}
+
+// GetByIDContext is GetByID with context support
+func (v Notes) GetByIDContext(ctx context.Context, params NotesGetByIDParams) (*NotesGetByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "notes.getById", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp NotesGetByIDResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +103,12 @@ type NotesAddResponse int
 
 // Add Creates a new note for the current user.
 func (v Notes) Add(params NotesAddParams) (NotesAddResponse, error) {
-	r, err := v.API.Request("notes.add", params)
+	return v.AddContext(context.Background(), params)
+}
+
+// AddContext is Add with context support
+func (v Notes) AddContext(ctx context.Context, params NotesAddParams) (NotesAddResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "notes.add", params)
 	if err != nil {
 		return 0, err
 	}
@@ -122,7 +139,12 @@ type NotesEditParams struct {
 
 // Edit Edits a note of the current user.
 func (v Notes) Edit(params NotesEditParams) (bool, error) {
-	r, err := v.API.Request("notes.edit", params)
+	return v.EditContext(context.Background(), params)
+}
+
+// EditContext is Edit with context support
+func (v Notes) EditContext(ctx context.Context, params NotesEditParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "notes.edit", params)
 	if err != nil {
 		return false, err
 	}
@@ -138,7 +160,12 @@ type NotesDeleteParams struct {
 
 // Delete Deletes a note of the current user.
 func (v Notes) Delete(params NotesDeleteParams) (bool, error) {
-	r, err := v.API.Request("notes.delete", params)
+	return v.DeleteContext(context.Background(), params)
+}
+
+// DeleteContext is Delete with context support
+func (v Notes) DeleteContext(ctx context.Context, params NotesDeleteParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "notes.delete", params)
 	if err != nil {
 		return false, err
 	}
@@ -159,6 +186,7 @@ type NotesGetCommentsParams struct {
 }
 
 // NotesGetCommentsResponse is response for Notes.GetComments
+//
 //easyjson:json
 type NotesGetCommentsResponse struct {
 	// Total number
@@ -168,13 +196,18 @@ type NotesGetCommentsResponse struct {
 
 // GetComments Returns a list of comments on a note.
 func (v Notes) GetComments(params NotesGetCommentsParams) (*NotesGetCommentsResponse, error) {
-	r, err := v.API.Request("notes.getComments", params)
+	return v.GetCommentsContext(context.Background(), params)
+}
+
+// GetCommentsContext is GetComments with context support
+func (v Notes) GetCommentsContext(ctx context.Context, params NotesGetCommentsParams) (*NotesGetCommentsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "notes.getComments", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp NotesGetCommentsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -200,7 +233,12 @@ type NotesCreateCommentResponse int
 
 // CreateComment Adds a new comment on a note.
 func (v Notes) CreateComment(params NotesCreateCommentParams) (NotesCreateCommentResponse, error) {
-	r, err := v.API.Request("notes.createComment", params)
+	return v.CreateCommentContext(context.Background(), params)
+}
+
+// CreateCommentContext is CreateComment with context support
+func (v Notes) CreateCommentContext(ctx context.Context, params NotesCreateCommentParams) (NotesCreateCommentResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "notes.createComment", params)
 	if err != nil {
 		return 0, err
 	}
@@ -229,7 +267,12 @@ type NotesEditCommentParams struct {
 
 // EditComment Edits a comment on a note.
 func (v Notes) EditComment(params NotesEditCommentParams) (bool, error) {
-	r, err := v.API.Request("notes.editComment", params)
+	return v.EditCommentContext(context.Background(), params)
+}
+
+// EditCommentContext is EditComment with context support
+func (v Notes) EditCommentContext(ctx context.Context, params NotesEditCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "notes.editComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -247,7 +290,12 @@ type NotesDeleteCommentParams struct {
 
 // DeleteComment Deletes a comment on a note.
 func (v Notes) DeleteComment(params NotesDeleteCommentParams) (bool, error) {
-	r, err := v.API.Request("notes.deleteComment", params)
+	return v.DeleteCommentContext(context.Background(), params)
+}
+
+// DeleteCommentContext is DeleteComment with context support
+func (v Notes) DeleteCommentContext(ctx context.Context, params NotesDeleteCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "notes.deleteComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -265,7 +313,12 @@ type NotesRestoreCommentParams struct {
 
 // RestoreComment Restores a deleted comment on a note.
 func (v Notes) RestoreComment(params NotesRestoreCommentParams) (bool, error) {
-	r, err := v.API.Request("notes.restoreComment", params)
+	return v.RestoreCommentContext(context.Background(), params)
+}
+
+// RestoreCommentContext is RestoreComment with context support
+func (v Notes) RestoreCommentContext(ctx context.Context, params NotesRestoreCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "notes.restoreComment", params)
 	if err != nil {
 		return false, err
 	}
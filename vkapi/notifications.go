@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -25,6 +25,7 @@ type NotificationsGetParams struct {
 }
 
 // NotificationsGetResponse is response for Notifications.Get
+//
 //easyjson:json
 type NotificationsGetResponse struct {
 	// Total number
@@ -38,13 +39,18 @@ type NotificationsGetResponse struct {
 
 // Get Returns a list of notifications about other users' feedback to the current user's wall posts.
 func (v Notifications) Get(params NotificationsGetParams) (*NotificationsGetResponse, error) {
-	r, err := v.API.Request("notifications.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Notifications) GetContext(ctx context.Context, params NotificationsGetParams) (*NotificationsGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "notifications.get", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp NotificationsGetResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +59,12 @@ func (v Notifications) Get(params NotificationsGetParams) (*NotificationsGetResp
 
 // MarkAsViewed Resets the counter of new notifications about other users' feedback to the current user's wall posts.
 func (v Notifications) MarkAsViewed() (bool, error) {
-	r, err := v.API.Request("notifications.markAsViewed", nil)
+	return v.MarkAsViewedContext(context.Background())
+}
+
+// MarkAsViewedContext is MarkAsViewed with context support
+func (v Notifications) MarkAsViewedContext(ctx context.Context) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "notifications.markAsViewed", nil)
 	if err != nil {
 		return false, err
 	}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -21,6 +21,7 @@ type OrdersGetParams struct {
 }
 
 // OrdersGetResponse is response for Orders.Get
+//
 //easyjson:json
 type OrdersGetResponse []struct {
 	// Order ID
@@ -47,13 +48,18 @@ type OrdersGetResponse []struct {
 
 // Get Returns a list of orders.
 func (v Orders) Get(params OrdersGetParams) (OrdersGetResponse, error) {
-	r, err := v.API.Request("orders.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Orders) GetContext(ctx context.Context, params OrdersGetParams) (OrdersGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "orders.get", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp OrdersGetResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -71,6 +77,7 @@ type OrdersGetByIDParams struct {
 }
 
 // OrdersGetByIDResponse is response for Orders.GetByID
+//
 //easyjson:json
 type OrdersGetByIDResponse []struct {
 	// Order ID
@@ -97,13 +104,18 @@ type OrdersGetByIDResponse []struct {
 
 // GetByID Returns information about orders by their IDs.
 func (v Orders) GetByID(params OrdersGetByIDParams) (OrdersGetByIDResponse, error) {
-	r, err := v.API.Request("orders.getById", params)
+	return v.GetByIDContext(context.Background(), params)
+}
+
+// GetByIDContext is GetByID with context support
+func (v Orders) GetByIDContext(ctx context.Context, params OrdersGetByIDParams) (OrdersGetByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "orders.getById", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp OrdersGetByIDResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +140,12 @@ type OrdersChangeStateResponse string
 
 // ChangeState Changes order status.
 func (v Orders) ChangeState(params OrdersChangeStateParams) (OrdersChangeStateResponse, error) {
-	r, err := v.API.Request("orders.changeState", params)
+	return v.ChangeStateContext(context.Background(), params)
+}
+
+// ChangeStateContext is ChangeState with context support
+func (v Orders) ChangeStateContext(ctx context.Context, params OrdersChangeStateParams) (OrdersChangeStateResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "orders.changeState", params)
 	if err != nil {
 		return "", err
 	}
@@ -150,6 +167,7 @@ type OrdersGetAmountParams struct {
 }
 
 // OrdersGetAmountResponse is response for Orders.GetAmount
+//
 //easyjson:json
 type OrdersGetAmountResponse struct {
 	Amounts []struct {
@@ -166,13 +184,18 @@ type OrdersGetAmountResponse struct {
 
 // GetAmount does orders.getAmount
 func (v Orders) GetAmount(params OrdersGetAmountParams) (*OrdersGetAmountResponse, error) {
-	r, err := v.API.Request("orders.getAmount", params)
+	return v.GetAmountContext(context.Background(), params)
+}
+
+// GetAmountContext is GetAmount with context support
+func (v Orders) GetAmountContext(ctx context.Context, params OrdersGetAmountParams) (*OrdersGetAmountResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "orders.getAmount", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp OrdersGetAmountResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 	"strconv"
 
 	"github.com/stek29/vk"
@@ -30,18 +30,24 @@ type PagesGetParams struct {
 }
 
 // PagesGetResponse is response for Pages.Get
+//
 //easyjson:json
 type PagesGetResponse vk.Page
 
 // Get Returns information about a wiki page.
 func (v Pages) Get(params PagesGetParams) (*PagesGetResponse, error) {
-	r, err := v.API.Request("pages.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Pages) GetContext(ctx context.Context, params PagesGetParams) (*PagesGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "pages.get", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PagesGetResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +74,12 @@ type PagesSaveResponse int
 
 // Save Saves the text of a wiki page.
 func (v Pages) Save(params PagesSaveParams) (PagesSaveResponse, error) {
-	r, err := v.API.Request("pages.save", params)
+	return v.SaveContext(context.Background(), params)
+}
+
+// SaveContext is Save with context support
+func (v Pages) SaveContext(ctx context.Context, params PagesSaveParams) (PagesSaveResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "pages.save", params)
 	if err != nil {
 		return 0, err
 	}
@@ -104,7 +115,12 @@ type PagesSaveAccessResponse int
 
 // SaveAccess Saves modified read and edit access settings for a wiki page.
 func (v Pages) SaveAccess(params PagesSaveAccessParams) (PagesSaveAccessResponse, error) {
-	r, err := v.API.Request("pages.saveAccess", params)
+	return v.SaveAccessContext(context.Background(), params)
+}
+
+// SaveAccessContext is SaveAccess with context support
+func (v Pages) SaveAccessContext(ctx context.Context, params PagesSaveAccessParams) (PagesSaveAccessResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "pages.saveAccess", params)
 	if err != nil {
 		return 0, err
 	}
@@ -131,6 +147,7 @@ type PagesGetHistoryParams struct {
 }
 
 // PagesGetHistoryResponse is response for Pages.GetHistory
+//
 //easyjson:json
 type PagesGetHistoryResponse []struct {
 	// Version ID
@@ -147,13 +164,18 @@ type PagesGetHistoryResponse []struct {
 
 // GetHistory Returns a list of all previous versions of a wiki page.
 func (v Pages) GetHistory(params PagesGetHistoryParams) (PagesGetHistoryResponse, error) {
-	r, err := v.API.Request("pages.getHistory", params)
+	return v.GetHistoryContext(context.Background(), params)
+}
+
+// GetHistoryContext is GetHistory with context support
+func (v Pages) GetHistoryContext(ctx context.Context, params PagesGetHistoryParams) (PagesGetHistoryResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "pages.getHistory", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PagesGetHistoryResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -167,18 +189,24 @@ type PagesGetTitlesParams struct {
 }
 
 // PagesGetTitlesResponse is response for Pages.GetTitles
+//
 //easyjson:json
 type PagesGetTitlesResponse []vk.Page
 
 // GetTitles Returns a list of wiki pages in a group.
 func (v Pages) GetTitles(params PagesGetTitlesParams) (PagesGetTitlesResponse, error) {
-	r, err := v.API.Request("pages.getTitles", params)
+	return v.GetTitlesContext(context.Background(), params)
+}
+
+// GetTitlesContext is GetTitles with context support
+func (v Pages) GetTitlesContext(ctx context.Context, params PagesGetTitlesParams) (PagesGetTitlesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "pages.getTitles", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PagesGetTitlesResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -196,18 +224,24 @@ type PagesGetVersionParams struct {
 }
 
 // PagesGetVersionResponse is response for Pages.GetVersion
+//
 //easyjson:json
 type PagesGetVersionResponse vk.Page
 
 // GetVersion Returns the text of one of the previous versions of a wiki page.
 func (v Pages) GetVersion(params PagesGetVersionParams) (*PagesGetVersionResponse, error) {
-	r, err := v.API.Request("pages.getVersion", params)
+	return v.GetVersionContext(context.Background(), params)
+}
+
+// GetVersionContext is GetVersion with context support
+func (v Pages) GetVersionContext(ctx context.Context, params PagesGetVersionParams) (*PagesGetVersionResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "pages.getVersion", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PagesGetVersionResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -228,7 +262,12 @@ type PagesParseWikiResponse string
 
 // ParseWiki Returns HTML representation of the wiki markup.
 func (v Pages) ParseWiki(params PagesParseWikiParams) (PagesParseWikiResponse, error) {
-	r, err := v.API.Request("pages.parseWiki", params)
+	return v.ParseWikiContext(context.Background(), params)
+}
+
+// ParseWikiContext is ParseWiki with context support
+func (v Pages) ParseWikiContext(ctx context.Context, params PagesParseWikiParams) (PagesParseWikiResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "pages.parseWiki", params)
 	if err != nil {
 		return "", err
 	}
@@ -251,7 +290,12 @@ type PagesClearCacheParams struct {
 
 // ClearCache Allows to clear the cache of particular 'external' pages which may be attached to VK posts.
 func (v Pages) ClearCache(params PagesClearCacheParams) (bool, error) {
-	r, err := v.API.Request("pages.clearCache", params)
+	return v.ClearCacheContext(context.Background(), params)
+}
+
+// ClearCacheContext is ClearCache with context support
+func (v Pages) ClearCacheContext(ctx context.Context, params PagesClearCacheParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "pages.clearCache", params)
 	if err != nil {
 		return false, err
 	}
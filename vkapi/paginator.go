@@ -0,0 +1,330 @@
+package vkapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/stek29/vk"
+)
+
+// PaginatorConfig represents configuration used for Paginator creation
+type PaginatorConfig struct {
+	// Optional: number of items requested at once,
+	// if 0 or more than maximum of method, maximum is used
+	PageSize int
+	// Optional: maximal number of items to return,
+	// if 0, every item is returned
+	Limit int
+	// Optional: number of pages requested ahead concurrently,
+	// ignored by methods paginated with start_from
+	Prefetch int
+}
+
+// Paginator lazily iterates over items of methods which return
+// only a page of items at once, requesting next page when needed
+//
+// Methods are paginated either with offset and count params,
+// and then iteration stops on count reported by VK, or with
+// start_from param and next_from in response.
+//
+// Usage:
+//
+//	it := vkapi.Groups{API: api}.GetMembersIter(ctx, params, vkapi.PaginatorConfig{})
+//	for it.Next() {
+//		fmt.Println(it.User().ID)
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Paginator struct {
+	ctx    context.Context
+	api    vk.API
+	method string
+	params url.Values
+	cursor bool
+
+	pageSize int
+	limit    int
+	prefetch int
+
+	// offset of next page to request, or its start_from
+	offset    int
+	startFrom string
+	end       int
+	pending   []chan pageResult
+
+	page pageEnvelope
+	pos  int
+	n    int
+	done bool
+	err  error
+}
+
+// pageEnvelope is response of paginated method
+type pageEnvelope struct {
+	Count    *int              `json:"count"`
+	Items    []json.RawMessage `json:"items"`
+	NextFrom string            `json:"next_from"`
+
+	raw json.RawMessage
+}
+
+type pageResult struct {
+	page pageEnvelope
+	err  error
+}
+
+// NewOffsetPaginator creates a new Paginator for method paginated
+// with offset and count params, which returns up to maxPageSize items at once
+func NewOffsetPaginator(ctx context.Context, api vk.API, method string, params interface{}, maxPageSize int, cfg PaginatorConfig) *Paginator {
+	return newPaginator(ctx, api, method, params, maxPageSize, false, cfg)
+}
+
+// NewCursorPaginator creates a new Paginator for method paginated with
+// start_from param, which returns up to maxPageSize items at once
+func NewCursorPaginator(ctx context.Context, api vk.API, method string, params interface{}, maxPageSize int, cfg PaginatorConfig) *Paginator {
+	return newPaginator(ctx, api, method, params, maxPageSize, true, cfg)
+}
+
+func newPaginator(ctx context.Context, api vk.API, method string, params interface{}, maxPageSize int, cursor bool, cfg PaginatorConfig) *Paginator {
+	p := &Paginator{
+		ctx:      ctx,
+		api:      api,
+		method:   method,
+		cursor:   cursor,
+		pageSize: cfg.PageSize,
+		limit:    cfg.Limit,
+		prefetch: cfg.Prefetch,
+		end:      -1,
+		pos:      -1,
+	}
+
+	if p.pageSize <= 0 || p.pageSize > maxPageSize {
+		p.pageSize = maxPageSize
+	}
+
+	if p.prefetch < 0 || cursor {
+		p.prefetch = 0
+	}
+
+	q, err := vk.BuildRequestParams(params)
+	if err != nil {
+		p.err = err
+		return p
+	}
+
+	// params are modified for every page, so url.Values passed by user are copied
+	p.params = make(url.Values, len(q))
+	for k, v := range q {
+		p.params[k] = v
+	}
+
+	if cursor {
+		p.startFrom = p.params.Get("start_from")
+	} else if offset := p.params.Get("offset"); offset != "" {
+		p.offset, _ = strconv.Atoi(offset)
+	}
+
+	if p.limit > 0 && !cursor {
+		p.end = p.offset + p.limit
+	}
+
+	return p
+}
+
+// Next advances Paginator to next item, requesting next page if needed
+//
+// It returns false when there are no more items or request has failed,
+// Err should be checked afterwards
+func (p *Paginator) Next() bool {
+	for p.err == nil {
+		if p.limit > 0 && p.n >= p.limit {
+			return false
+		}
+
+		if p.pos+1 < len(p.page.Items) {
+			p.pos++
+			p.n++
+			return true
+		}
+
+		if p.done {
+			return false
+		}
+
+		p.nextPage()
+	}
+
+	return false
+}
+
+// Item returns current item as is
+func (p *Paginator) Item() json.RawMessage {
+	if p.pos < 0 || p.pos >= len(p.page.Items) {
+		return nil
+	}
+	return p.page.Items[p.pos]
+}
+
+// Scan unmarshals current item into v
+func (p *Paginator) Scan(v interface{}) error {
+	return vk.Unmarshal(p.Item(), v)
+}
+
+// Response returns whole response current item was returned in,
+// i.e. to get profiles and groups of extended responses
+func (p *Paginator) Response() json.RawMessage {
+	return p.page.raw
+}
+
+// Total returns total number of items reported by VK,
+// or -1 if it's unknown yet
+func (p *Paginator) Total() int {
+	if p.page.Count == nil {
+		return -1
+	}
+	return *p.page.Count
+}
+
+// Err returns error which has stopped iteration, if any
+func (p *Paginator) Err() error {
+	return p.err
+}
+
+// scan is Scan which stops iteration on error
+func (p *Paginator) scan(v interface{}) bool {
+	if err := p.Scan(v); err != nil {
+		p.err = err
+		return false
+	}
+	return true
+}
+
+func (p *Paginator) nextPage() {
+	if p.cursor {
+		p.nextCursorPage()
+	} else {
+		p.nextOffsetPage()
+	}
+}
+
+func (p *Paginator) nextCursorPage() {
+	q := p.pageParams()
+	if p.startFrom != "" {
+		q.Set("start_from", p.startFrom)
+	}
+
+	res := p.fetch(q)
+	if res.err != nil {
+		p.err = res.err
+		return
+	}
+
+	p.setPage(res.page)
+	p.startFrom = res.page.NextFrom
+	if p.startFrom == "" || len(res.page.Items) == 0 {
+		p.done = true
+	}
+}
+
+func (p *Paginator) nextOffsetPage() {
+	p.schedule()
+	if len(p.pending) == 0 {
+		p.done = true
+		return
+	}
+
+	var res pageResult
+	select {
+	case <-p.ctx.Done():
+		p.err = p.ctx.Err()
+		return
+	case res = <-p.pending[0]:
+	}
+	p.pending = p.pending[1:]
+
+	if res.err != nil {
+		p.err = res.err
+		return
+	}
+
+	p.setPage(res.page)
+
+	if res.page.Count != nil {
+		if p.end < 0 || *res.page.Count < p.end {
+			p.end = *res.page.Count
+		}
+	} else if len(res.page.Items) < p.pageSize {
+		// without total count, short page is the last one
+		p.done = true
+	}
+
+	if len(res.page.Items) == 0 {
+		// VK may report more items than it returns
+		p.done = true
+	}
+
+	if p.done {
+		p.pending = nil
+	} else {
+		p.schedule()
+	}
+}
+
+// schedule requests pages ahead, only one page is requested
+// until total count is known
+func (p *Paginator) schedule() {
+	want := 1
+	if p.page.Count != nil {
+		want += p.prefetch
+	}
+
+	for len(p.pending) < want && (p.end < 0 || p.offset < p.end) {
+		count := p.pageSize
+		if p.end >= 0 && p.end-p.offset < count {
+			count = p.end - p.offset
+		}
+
+		q := p.pageParams()
+		q.Set("offset", strconv.Itoa(p.offset))
+		q.Set("count", strconv.Itoa(count))
+		p.offset += count
+
+		done := make(chan pageResult, 1)
+		go func() {
+			done <- p.fetch(q)
+		}()
+		p.pending = append(p.pending, done)
+	}
+}
+
+func (p *Paginator) pageParams() url.Values {
+	q := make(url.Values, len(p.params)+2)
+	for k, v := range p.params {
+		q[k] = v
+	}
+	q.Set("count", strconv.Itoa(p.pageSize))
+	return q
+}
+
+func (p *Paginator) fetch(q url.Values) pageResult {
+	r, err := vk.RequestContext(p.ctx, p.api, p.method, q)
+	if err != nil {
+		return pageResult{err: err}
+	}
+
+	var page pageEnvelope
+	if err := json.Unmarshal(r, &page); err != nil {
+		return pageResult{err: err}
+	}
+	page.raw = r
+
+	return pageResult{page: page}
+}
+
+func (p *Paginator) setPage(page pageEnvelope) {
+	p.page = page
+	p.pos = -1
+}
@@ -0,0 +1,136 @@
+package vkapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stek29/vk"
+)
+
+// funcAPI is API which handles requests with a function
+type funcAPI func(method string, params url.Values) (json.RawMessage, error)
+
+func (f funcAPI) Request(method string, params interface{}) (json.RawMessage, error) {
+	return f.RequestContext(context.Background(), method, params)
+}
+
+func (f funcAPI) RequestContext(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	q, err := vk.BuildRequestParams(params)
+	if err != nil {
+		return nil, err
+	}
+	return f(method, q)
+}
+
+func (f funcAPI) HTTPClient() *http.Client {
+	return http.DefaultClient
+}
+
+// membersAPI returns API with community of total members,
+// and records offsets of requests made to it
+func membersAPI(total int, offsets *[]int) funcAPI {
+	var mu sync.Mutex
+
+	return func(method string, q url.Values) (json.RawMessage, error) {
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		count, _ := strconv.Atoi(q.Get("count"))
+
+		mu.Lock()
+		*offsets = append(*offsets, offset)
+		mu.Unlock()
+
+		var items []string
+		for id := offset + 1; id <= offset+count && id <= total; id++ {
+			items = append(items, strconv.Itoa(id))
+		}
+
+		return json.RawMessage(fmt.Sprintf(`{"count":%d,"items":[%s]}`, total, strings.Join(items, ","))), nil
+	}
+}
+
+func TestOffsetPaginator(t *testing.T) {
+	for _, prefetch := range []int{0, 3} {
+		var offsets []int
+		groups := Groups{API: membersAPI(2500, &offsets)}
+
+		it := groups.GetMembersIter(context.Background(), GroupsGetMembersParams{GroupID: "1"}, PaginatorConfig{Prefetch: prefetch})
+
+		n := 0
+		for it.Next() {
+			n++
+			if it.User().ID != n {
+				t.Fatalf("Expected user %v, got %v", n, it.User().ID)
+			}
+		}
+
+		if err := it.Err(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+
+		if n != 2500 || it.Total() != 2500 {
+			t.Errorf("Expected 2500 members, got %v of %v", n, it.Total())
+		}
+
+		// pages past total count are never requested
+		if len(offsets) != 3 {
+			t.Errorf("Expected 3 requests with prefetch %v, got offsets %v", prefetch, offsets)
+		}
+	}
+}
+
+func TestOffsetPaginatorLimit(t *testing.T) {
+	var offsets []int
+	friends := Friends{API: membersAPI(100, &offsets)}
+
+	it := friends.GetIter(context.Background(), FriendsGetParams{Offset: 10}, PaginatorConfig{PageSize: 20, Limit: 30})
+
+	var ids []int
+	for it.Next() {
+		ids = append(ids, it.User().ID)
+	}
+
+	if len(ids) != 30 || ids[0] != 11 || ids[29] != 40 {
+		t.Errorf("Unexpected IDs: %v", ids)
+	}
+
+	if fmt.Sprint(offsets) != "[10 30]" {
+		t.Errorf("Unexpected offsets: %v", offsets)
+	}
+}
+
+func TestCursorPaginator(t *testing.T) {
+	pages := map[string]string{
+		"":  `{"items":[{"id":1},{"id":2}],"next_from":"a"}`,
+		"a": `{"items":[{"id":3}],"next_from":"b"}`,
+		"b": `{"items":[{"id":4}]}`,
+	}
+
+	newsfeed := Newsfeed{API: funcAPI(func(method string, q url.Values) (json.RawMessage, error) {
+		if method != "newsfeed.search" || q.Get("count") != "200" {
+			t.Errorf("Unexpected request: %v %v", method, q)
+		}
+		return json.RawMessage(pages[q.Get("start_from")]), nil
+	})}
+
+	it := newsfeed.SearchIter(context.Background(), NewsfeedSearchParams{Q: "cats"}, PaginatorConfig{})
+
+	var ids []int
+	for it.Next() {
+		ids = append(ids, it.Post().ID)
+	}
+
+	if err := it.Err(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if fmt.Sprint(ids) != "[1 2 3 4]" {
+		t.Errorf("Unexpected IDs: %v", ids)
+	}
+}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 	"strconv"
 
 	"github.com/stek29/vk"
@@ -27,17 +27,23 @@ type PhotosCreateAlbumParams struct {
 }
 
 // PhotosCreateAlbumResponse is response for Photos.CreateAlbum
+//
 //easyjson:json
 type PhotosCreateAlbumResponse genTODOType /* objects.json#/definitions/photos_photo_album_full */
 // CreateAlbum Creates an empty photo album.
 func (v Photos) CreateAlbum(params PhotosCreateAlbumParams) (*PhotosCreateAlbumResponse, error) {
-	r, err := v.API.Request("photos.createAlbum", params)
+	return v.CreateAlbumContext(context.Background(), params)
+}
+
+// CreateAlbumContext is CreateAlbum with context support
+func (v Photos) CreateAlbumContext(ctx context.Context, params PhotosCreateAlbumParams) (*PhotosCreateAlbumResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.createAlbum", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosCreateAlbumResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +68,12 @@ type PhotosEditAlbumParams struct {
 
 // EditAlbum Edits information about a photo album.
 func (v Photos) EditAlbum(params PhotosEditAlbumParams) (bool, error) {
-	r, err := v.API.Request("photos.editAlbum", params)
+	return v.EditAlbumContext(context.Background(), params)
+}
+
+// EditAlbumContext is EditAlbum with context support
+func (v Photos) EditAlbumContext(ctx context.Context, params PhotosEditAlbumParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.editAlbum", params)
 	if err != nil {
 		return false, err
 	}
@@ -89,6 +100,7 @@ type PhotosGetAlbumsParams struct {
 }
 
 // PhotosGetAlbumsResponse is response for Photos.GetAlbums
+//
 //easyjson:json
 type PhotosGetAlbumsResponse struct {
 	// Total number
@@ -98,13 +110,18 @@ type PhotosGetAlbumsResponse struct {
 
 // GetAlbums Returns a list of a user's or community's photo albums.
 func (v Photos) GetAlbums(params PhotosGetAlbumsParams) (*PhotosGetAlbumsResponse, error) {
-	r, err := v.API.Request("photos.getAlbums", params)
+	return v.GetAlbumsContext(context.Background(), params)
+}
+
+// GetAlbumsContext is GetAlbums with context support
+func (v Photos) GetAlbumsContext(ctx context.Context, params PhotosGetAlbumsParams) (*PhotosGetAlbumsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getAlbums", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosGetAlbumsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +157,7 @@ type PhotosGetResponse interface {
 }
 
 // PhotosGetResponseNormal is non-extended version of PhotosGetResponse
+//
 //easyjson:json
 type PhotosGetResponseNormal struct {
 	// Total number
@@ -150,6 +168,7 @@ type PhotosGetResponseNormal struct {
 func (PhotosGetResponseNormal) isPhotosGet() {}
 
 // PhotosGetResponseExtended is extended version of PhotosGetResponse
+//
 //easyjson:json
 type PhotosGetResponseExtended struct {
 	// Total number
@@ -161,7 +180,12 @@ func (PhotosGetResponseExtended) isPhotosGet() {}
 
 // Get Returns a list of a user's or community's photos.
 func (v Photos) Get(params PhotosGetParams) (PhotosGetResponse, error) {
-	r, err := v.API.Request("photos.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Photos) GetContext(ctx context.Context, params PhotosGetParams) (PhotosGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.get", params)
 	if err != nil {
 		return nil, err
 	}
@@ -169,11 +193,11 @@ func (v Photos) Get(params PhotosGetParams) (PhotosGetResponse, error) {
 	var resp PhotosGetResponse
 	if params.Extended {
 		var tmp PhotosGetResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp PhotosGetResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -196,7 +220,12 @@ type PhotosGetAlbumsCountResponse int
 
 // GetAlbumsCount Returns the number of photo albums belonging to a user or community.
 func (v Photos) GetAlbumsCount(params PhotosGetAlbumsCountParams) (PhotosGetAlbumsCountResponse, error) {
-	r, err := v.API.Request("photos.getAlbumsCount", params)
+	return v.GetAlbumsCountContext(context.Background(), params)
+}
+
+// GetAlbumsCountContext is GetAlbumsCount with context support
+func (v Photos) GetAlbumsCountContext(ctx context.Context, params PhotosGetAlbumsCountParams) (PhotosGetAlbumsCountResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getAlbumsCount", params)
 	if err != nil {
 		return 0, err
 	}
@@ -230,12 +259,14 @@ type PhotosGetByIDResponse interface {
 }
 
 // PhotosGetByIDResponseNormal is non-extended version of PhotosGetByIDResponse
+//
 //easyjson:json
 type PhotosGetByIDResponseNormal []vk.Photo
 
 func (PhotosGetByIDResponseNormal) isPhotosGetByID() {}
 
 // PhotosGetByIDResponseExtended is extended version of PhotosGetByIDResponse
+//
 //easyjson:json
 type PhotosGetByIDResponseExtended []vk.Photo
 
@@ -243,7 +274,12 @@ func (PhotosGetByIDResponseExtended) isPhotosGetByID() {}
 
 // GetByID Returns information about photos by their IDs.
 func (v Photos) GetByID(params PhotosGetByIDParams) (PhotosGetByIDResponse, error) {
-	r, err := v.API.Request("photos.getById", params)
+	return v.GetByIDContext(context.Background(), params)
+}
+
+// GetByIDContext is GetByID with context support
+func (v Photos) GetByIDContext(ctx context.Context, params PhotosGetByIDParams) (PhotosGetByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getById", params)
 	if err != nil {
 		return nil, err
 	}
@@ -251,11 +287,11 @@ func (v Photos) GetByID(params PhotosGetByIDParams) (PhotosGetByIDResponse, erro
 	var resp PhotosGetByIDResponse
 	if params.Extended {
 		var tmp PhotosGetByIDResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp PhotosGetByIDResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -272,6 +308,7 @@ type PhotosGetUploadServerParams struct {
 }
 
 // PhotosGetUploadServerResponse is response for Photos.GetUploadServer
+//
 //easyjson:json
 type PhotosGetUploadServerResponse struct {
 	// URL to upload photo
@@ -284,13 +321,18 @@ type PhotosGetUploadServerResponse struct {
 
 // GetUploadServer Returns the server address for photo upload.
 func (v Photos) GetUploadServer(params PhotosGetUploadServerParams) (*PhotosGetUploadServerResponse, error) {
-	r, err := v.API.Request("photos.getUploadServer", params)
+	return v.GetUploadServerContext(context.Background(), params)
+}
+
+// GetUploadServerContext is GetUploadServer with context support
+func (v Photos) GetUploadServerContext(ctx context.Context, params PhotosGetUploadServerParams) (*PhotosGetUploadServerResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getUploadServer", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosGetUploadServerResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -312,6 +354,7 @@ type PhotosGetOwnerCoverPhotoUploadServerParams struct {
 }
 
 // PhotosGetOwnerCoverPhotoUploadServerResponse is response for Photos.GetOwnerCoverPhotoUploadServer
+//
 //easyjson:json
 type PhotosGetOwnerCoverPhotoUploadServerResponse struct {
 	// Upload URL
@@ -320,13 +363,18 @@ type PhotosGetOwnerCoverPhotoUploadServerResponse struct {
 
 // GetOwnerCoverPhotoUploadServer Returns the server address for owner cover upload.
 func (v Photos) GetOwnerCoverPhotoUploadServer(params PhotosGetOwnerCoverPhotoUploadServerParams) (*PhotosGetOwnerCoverPhotoUploadServerResponse, error) {
-	r, err := v.API.Request("photos.getOwnerCoverPhotoUploadServer", params)
+	return v.GetOwnerCoverPhotoUploadServerContext(context.Background(), params)
+}
+
+// GetOwnerCoverPhotoUploadServerContext is GetOwnerCoverPhotoUploadServer with context support
+func (v Photos) GetOwnerCoverPhotoUploadServerContext(ctx context.Context, params PhotosGetOwnerCoverPhotoUploadServerParams) (*PhotosGetOwnerCoverPhotoUploadServerResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getOwnerCoverPhotoUploadServer", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosGetOwnerCoverPhotoUploadServerResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -340,6 +388,7 @@ type PhotosGetOwnerPhotoUploadServerParams struct {
 }
 
 // PhotosGetOwnerPhotoUploadServerResponse is response for Photos.GetOwnerPhotoUploadServer
+//
 //easyjson:json
 type PhotosGetOwnerPhotoUploadServerResponse struct {
 	// Upload URL
@@ -348,13 +397,18 @@ type PhotosGetOwnerPhotoUploadServerResponse struct {
 
 // GetOwnerPhotoUploadServer Returns an upload server address for a profile or community photo.
 func (v Photos) GetOwnerPhotoUploadServer(params PhotosGetOwnerPhotoUploadServerParams) (*PhotosGetOwnerPhotoUploadServerResponse, error) {
-	r, err := v.API.Request("photos.getOwnerPhotoUploadServer", params)
+	return v.GetOwnerPhotoUploadServerContext(context.Background(), params)
+}
+
+// GetOwnerPhotoUploadServerContext is GetOwnerPhotoUploadServer with context support
+func (v Photos) GetOwnerPhotoUploadServerContext(ctx context.Context, params PhotosGetOwnerPhotoUploadServerParams) (*PhotosGetOwnerPhotoUploadServerResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getOwnerPhotoUploadServer", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosGetOwnerPhotoUploadServerResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -372,6 +426,7 @@ type PhotosGetChatUploadServerParams struct {
 }
 
 // PhotosGetChatUploadServerResponse is response for Photos.GetChatUploadServer
+//
 //easyjson:json
 type PhotosGetChatUploadServerResponse struct {
 	// URL to upload photo
@@ -384,13 +439,18 @@ type PhotosGetChatUploadServerResponse struct {
 
 // GetChatUploadServer Returns an upload link for chat cover pictures.
 func (v Photos) GetChatUploadServer(params PhotosGetChatUploadServerParams) (*PhotosGetChatUploadServerResponse, error) {
-	r, err := v.API.Request("photos.getChatUploadServer", params)
+	return v.GetChatUploadServerContext(context.Background(), params)
+}
+
+// GetChatUploadServerContext is GetChatUploadServer with context support
+func (v Photos) GetChatUploadServerContext(ctx context.Context, params PhotosGetChatUploadServerParams) (*PhotosGetChatUploadServerResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getChatUploadServer", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosGetChatUploadServerResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -412,6 +472,7 @@ type PhotosGetMarketUploadServerParams struct {
 }
 
 // PhotosGetMarketUploadServerResponse is response for Photos.GetMarketUploadServer
+//
 //easyjson:json
 type PhotosGetMarketUploadServerResponse struct {
 	// Upload URL
@@ -420,13 +481,18 @@ type PhotosGetMarketUploadServerResponse struct {
 
 // GetMarketUploadServer Returns the server address for market photo upload.
 func (v Photos) GetMarketUploadServer(params PhotosGetMarketUploadServerParams) (*PhotosGetMarketUploadServerResponse, error) {
-	r, err := v.API.Request("photos.getMarketUploadServer", params)
+	return v.GetMarketUploadServerContext(context.Background(), params)
+}
+
+// GetMarketUploadServerContext is GetMarketUploadServer with context support
+func (v Photos) GetMarketUploadServerContext(ctx context.Context, params PhotosGetMarketUploadServerParams) (*PhotosGetMarketUploadServerResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getMarketUploadServer", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosGetMarketUploadServerResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -440,6 +506,7 @@ type PhotosGetMarketAlbumUploadServerParams struct {
 }
 
 // PhotosGetMarketAlbumUploadServerResponse is response for Photos.GetMarketAlbumUploadServer
+//
 //easyjson:json
 type PhotosGetMarketAlbumUploadServerResponse struct {
 	// Upload URL
@@ -448,13 +515,18 @@ type PhotosGetMarketAlbumUploadServerResponse struct {
 
 // GetMarketAlbumUploadServer Returns the server address for market album photo upload.
 func (v Photos) GetMarketAlbumUploadServer(params PhotosGetMarketAlbumUploadServerParams) (*PhotosGetMarketAlbumUploadServerResponse, error) {
-	r, err := v.API.Request("photos.getMarketAlbumUploadServer", params)
+	return v.GetMarketAlbumUploadServerContext(context.Background(), params)
+}
+
+// GetMarketAlbumUploadServerContext is GetMarketAlbumUploadServer with context support
+func (v Photos) GetMarketAlbumUploadServerContext(ctx context.Context, params PhotosGetMarketAlbumUploadServerParams) (*PhotosGetMarketAlbumUploadServerResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getMarketAlbumUploadServer", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosGetMarketAlbumUploadServerResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -478,18 +550,24 @@ type PhotosSaveMarketPhotoParams struct {
 }
 
 // PhotosSaveMarketPhotoResponse is response for Photos.SaveMarketPhoto
+//
 //easyjson:json
 type PhotosSaveMarketPhotoResponse []vk.Photo
 
 // SaveMarketPhoto Saves market photos after successful uploading.
 func (v Photos) SaveMarketPhoto(params PhotosSaveMarketPhotoParams) (PhotosSaveMarketPhotoResponse, error) {
-	r, err := v.API.Request("photos.saveMarketPhoto", params)
+	return v.SaveMarketPhotoContext(context.Background(), params)
+}
+
+// SaveMarketPhotoContext is SaveMarketPhoto with context support
+func (v Photos) SaveMarketPhotoContext(ctx context.Context, params PhotosSaveMarketPhotoParams) (PhotosSaveMarketPhotoResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.saveMarketPhoto", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosSaveMarketPhotoResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -505,18 +583,24 @@ type PhotosSaveOwnerCoverPhotoParams struct {
 }
 
 // PhotosSaveOwnerCoverPhotoResponse is response for Photos.SaveOwnerCoverPhoto
+//
 //easyjson:json
 type PhotosSaveOwnerCoverPhotoResponse []vk.BaseImage
 
 // SaveOwnerCoverPhoto Saves cover photo after successful uploading.
 func (v Photos) SaveOwnerCoverPhoto(params PhotosSaveOwnerCoverPhotoParams) (PhotosSaveOwnerCoverPhotoResponse, error) {
-	r, err := v.API.Request("photos.saveOwnerCoverPhoto", params)
+	return v.SaveOwnerCoverPhotoContext(context.Background(), params)
+}
+
+// SaveOwnerCoverPhotoContext is SaveOwnerCoverPhoto with context support
+func (v Photos) SaveOwnerCoverPhotoContext(ctx context.Context, params PhotosSaveOwnerCoverPhotoParams) (PhotosSaveOwnerCoverPhotoResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.saveOwnerCoverPhoto", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosSaveOwnerCoverPhotoResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -536,18 +620,24 @@ type PhotosSaveMarketAlbumPhotoParams struct {
 }
 
 // PhotosSaveMarketAlbumPhotoResponse is response for Photos.SaveMarketAlbumPhoto
+//
 //easyjson:json
 type PhotosSaveMarketAlbumPhotoResponse []vk.Photo
 
 // SaveMarketAlbumPhoto Saves market album photos after successful uploading.
 func (v Photos) SaveMarketAlbumPhoto(params PhotosSaveMarketAlbumPhotoParams) (PhotosSaveMarketAlbumPhotoResponse, error) {
-	r, err := v.API.Request("photos.saveMarketAlbumPhoto", params)
+	return v.SaveMarketAlbumPhotoContext(context.Background(), params)
+}
+
+// SaveMarketAlbumPhotoContext is SaveMarketAlbumPhoto with context support
+func (v Photos) SaveMarketAlbumPhotoContext(ctx context.Context, params PhotosSaveMarketAlbumPhotoParams) (PhotosSaveMarketAlbumPhotoResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.saveMarketAlbumPhoto", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosSaveMarketAlbumPhotoResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -565,6 +655,7 @@ type PhotosSaveOwnerPhotoParams struct {
 }
 
 // PhotosSaveOwnerPhotoResponse is response for Photos.SaveOwnerPhoto
+//
 //easyjson:json
 type PhotosSaveOwnerPhotoResponse struct {
 	// Parameter for saveProfilePhoto method
@@ -575,13 +666,18 @@ type PhotosSaveOwnerPhotoResponse struct {
 
 // SaveOwnerPhoto Saves a profile or community photo. Upload URL can be got with the [vk.com/dev/photos.getOwnerPhotoUploadServer|photos.getOwnerPhotoUploadServer] method.
 func (v Photos) SaveOwnerPhoto(params PhotosSaveOwnerPhotoParams) (*PhotosSaveOwnerPhotoResponse, error) {
-	r, err := v.API.Request("photos.saveOwnerPhoto", params)
+	return v.SaveOwnerPhotoContext(context.Background(), params)
+}
+
+// SaveOwnerPhotoContext is SaveOwnerPhoto with context support
+func (v Photos) SaveOwnerPhotoContext(ctx context.Context, params PhotosSaveOwnerPhotoParams) (*PhotosSaveOwnerPhotoResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.saveOwnerPhoto", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosSaveOwnerPhotoResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -607,18 +703,24 @@ type PhotosSaveWallPhotoParams struct {
 }
 
 // PhotosSaveWallPhotoResponse is response for Photos.SaveWallPhoto
+//
 //easyjson:json
 type PhotosSaveWallPhotoResponse []vk.Photo
 
 // SaveWallPhoto Saves a photo to a user's or community's wall after being uploaded.
 func (v Photos) SaveWallPhoto(params PhotosSaveWallPhotoParams) (PhotosSaveWallPhotoResponse, error) {
-	r, err := v.API.Request("photos.saveWallPhoto", params)
+	return v.SaveWallPhotoContext(context.Background(), params)
+}
+
+// SaveWallPhotoContext is SaveWallPhoto with context support
+func (v Photos) SaveWallPhotoContext(ctx context.Context, params PhotosSaveWallPhotoParams) (PhotosSaveWallPhotoResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.saveWallPhoto", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosSaveWallPhotoResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -632,6 +734,7 @@ type PhotosGetWallUploadServerParams struct {
 }
 
 // PhotosGetWallUploadServerResponse is response for Photos.GetWallUploadServer
+//
 //easyjson:json
 type PhotosGetWallUploadServerResponse struct {
 	// URL to upload photo
@@ -644,13 +747,18 @@ type PhotosGetWallUploadServerResponse struct {
 
 // GetWallUploadServer Returns the server address for photo upload onto a user's wall.
 func (v Photos) GetWallUploadServer(params PhotosGetWallUploadServerParams) (*PhotosGetWallUploadServerResponse, error) {
-	r, err := v.API.Request("photos.getWallUploadServer", params)
+	return v.GetWallUploadServerContext(context.Background(), params)
+}
+
+// GetWallUploadServerContext is GetWallUploadServer with context support
+func (v Photos) GetWallUploadServerContext(ctx context.Context, params PhotosGetWallUploadServerParams) (*PhotosGetWallUploadServerResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getWallUploadServer", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosGetWallUploadServerResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -664,6 +772,7 @@ type PhotosGetMessagesUploadServerParams struct {
 }
 
 // PhotosGetMessagesUploadServerResponse is response for Photos.GetMessagesUploadServer
+//
 //easyjson:json
 type PhotosGetMessagesUploadServerResponse struct {
 	// URL to upload photo
@@ -676,13 +785,18 @@ type PhotosGetMessagesUploadServerResponse struct {
 
 // GetMessagesUploadServer Returns the server address for photo upload in a private message for a user.
 func (v Photos) GetMessagesUploadServer(params PhotosGetMessagesUploadServerParams) (*PhotosGetMessagesUploadServerResponse, error) {
-	r, err := v.API.Request("photos.getMessagesUploadServer", params)
+	return v.GetMessagesUploadServerContext(context.Background(), params)
+}
+
+// GetMessagesUploadServerContext is GetMessagesUploadServer with context support
+func (v Photos) GetMessagesUploadServerContext(ctx context.Context, params PhotosGetMessagesUploadServerParams) (*PhotosGetMessagesUploadServerResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getMessagesUploadServer", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosGetMessagesUploadServerResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -698,18 +812,24 @@ type PhotosSaveMessagesPhotoParams struct {
 }
 
 // PhotosSaveMessagesPhotoResponse is response for Photos.SaveMessagesPhoto
+//
 //easyjson:json
 type PhotosSaveMessagesPhotoResponse []vk.Photo
 
 // SaveMessagesPhoto Saves a photo after being successfully uploaded. URL obtained with [vk.com/dev/photos.getMessagesUploadServer|photos.getMessagesUploadServer] method.
 func (v Photos) SaveMessagesPhoto(params PhotosSaveMessagesPhotoParams) (PhotosSaveMessagesPhotoResponse, error) {
-	r, err := v.API.Request("photos.saveMessagesPhoto", params)
+	return v.SaveMessagesPhotoContext(context.Background(), params)
+}
+
+// SaveMessagesPhotoContext is SaveMessagesPhoto with context support
+func (v Photos) SaveMessagesPhotoContext(ctx context.Context, params PhotosSaveMessagesPhotoParams) (PhotosSaveMessagesPhotoResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.saveMessagesPhoto", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosSaveMessagesPhotoResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -728,7 +848,12 @@ type PhotosReportParams struct {
 
 // Report Reports (submits a complaint about) a photo.
 func (v Photos) Report(params PhotosReportParams) (bool, error) {
-	r, err := v.API.Request("photos.report", params)
+	return v.ReportContext(context.Background(), params)
+}
+
+// ReportContext is Report with context support
+func (v Photos) ReportContext(ctx context.Context, params PhotosReportParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.report", params)
 	if err != nil {
 		return false, err
 	}
@@ -748,7 +873,12 @@ type PhotosReportCommentParams struct {
 
 // ReportComment Reports (submits a complaint about) a comment on a photo.
 func (v Photos) ReportComment(params PhotosReportCommentParams) (bool, error) {
-	r, err := v.API.Request("photos.reportComment", params)
+	return v.ReportCommentContext(context.Background(), params)
+}
+
+// ReportCommentContext is ReportComment with context support
+func (v Photos) ReportCommentContext(ctx context.Context, params PhotosReportCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.reportComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -777,6 +907,7 @@ type PhotosSearchParams struct {
 }
 
 // PhotosSearchResponse is response for Photos.Search
+//
 //easyjson:json
 type PhotosSearchResponse struct {
 	// Total number
@@ -786,13 +917,18 @@ type PhotosSearchResponse struct {
 
 // Search Returns a list of photos.
 func (v Photos) Search(params PhotosSearchParams) (*PhotosSearchResponse, error) {
-	r, err := v.API.Request("photos.search", params)
+	return v.SearchContext(context.Background(), params)
+}
+
+// SearchContext is Search with context support
+func (v Photos) SearchContext(ctx context.Context, params PhotosSearchParams) (*PhotosSearchResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.search", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosSearchResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -820,18 +956,24 @@ type PhotosSaveParams struct {
 }
 
 // PhotosSaveResponse is response for Photos.Save
+//
 //easyjson:json
 type PhotosSaveResponse []vk.Photo
 
 // Save Saves photos after successful uploading.
 func (v Photos) Save(params PhotosSaveParams) (PhotosSaveResponse, error) {
-	r, err := v.API.Request("photos.save", params)
+	return v.SaveContext(context.Background(), params)
+}
+
+// SaveContext is Save with context support
+func (v Photos) SaveContext(ctx context.Context, params PhotosSaveParams) (PhotosSaveResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.save", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosSaveResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -854,7 +996,12 @@ type PhotosCopyResponse int
 
 // Copy Allows to copy a photo to the "Saved photos" album
 func (v Photos) Copy(params PhotosCopyParams) (PhotosCopyResponse, error) {
-	r, err := v.API.Request("photos.copy", params)
+	return v.CopyContext(context.Background(), params)
+}
+
+// CopyContext is Copy with context support
+func (v Photos) CopyContext(ctx context.Context, params PhotosCopyParams) (PhotosCopyResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.copy", params)
 	if err != nil {
 		return 0, err
 	}
@@ -888,7 +1035,12 @@ type PhotosEditParams struct {
 
 // Edit Edits the caption of a photo.
 func (v Photos) Edit(params PhotosEditParams) (bool, error) {
-	r, err := v.API.Request("photos.edit", params)
+	return v.EditContext(context.Background(), params)
+}
+
+// EditContext is Edit with context support
+func (v Photos) EditContext(ctx context.Context, params PhotosEditParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.edit", params)
 	if err != nil {
 		return false, err
 	}
@@ -908,7 +1060,12 @@ type PhotosMoveParams struct {
 
 // Move Moves a photo from one album to another.
 func (v Photos) Move(params PhotosMoveParams) (bool, error) {
-	r, err := v.API.Request("photos.move", params)
+	return v.MoveContext(context.Background(), params)
+}
+
+// MoveContext is Move with context support
+func (v Photos) MoveContext(ctx context.Context, params PhotosMoveParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.move", params)
 	if err != nil {
 		return false, err
 	}
@@ -928,7 +1085,12 @@ type PhotosMakeCoverParams struct {
 
 // MakeCover Makes a photo into an album cover.
 func (v Photos) MakeCover(params PhotosMakeCoverParams) (bool, error) {
-	r, err := v.API.Request("photos.makeCover", params)
+	return v.MakeCoverContext(context.Background(), params)
+}
+
+// MakeCoverContext is MakeCover with context support
+func (v Photos) MakeCoverContext(ctx context.Context, params PhotosMakeCoverParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.makeCover", params)
 	if err != nil {
 		return false, err
 	}
@@ -950,7 +1112,12 @@ type PhotosReorderAlbumsParams struct {
 
 // ReorderAlbums Reorders the album in the list of user albums.
 func (v Photos) ReorderAlbums(params PhotosReorderAlbumsParams) (bool, error) {
-	r, err := v.API.Request("photos.reorderAlbums", params)
+	return v.ReorderAlbumsContext(context.Background(), params)
+}
+
+// ReorderAlbumsContext is ReorderAlbums with context support
+func (v Photos) ReorderAlbumsContext(ctx context.Context, params PhotosReorderAlbumsParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.reorderAlbums", params)
 	if err != nil {
 		return false, err
 	}
@@ -972,7 +1139,12 @@ type PhotosReorderPhotosParams struct {
 
 // ReorderPhotos Reorders the photo in the list of photos of the user album.
 func (v Photos) ReorderPhotos(params PhotosReorderPhotosParams) (bool, error) {
-	r, err := v.API.Request("photos.reorderPhotos", params)
+	return v.ReorderPhotosContext(context.Background(), params)
+}
+
+// ReorderPhotosContext is ReorderPhotos with context support
+func (v Photos) ReorderPhotosContext(ctx context.Context, params PhotosReorderPhotosParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.reorderPhotos", params)
 	if err != nil {
 		return false, err
 	}
@@ -1007,6 +1179,7 @@ type PhotosGetAllResponse interface {
 }
 
 // PhotosGetAllResponseNormal is non-extended version of PhotosGetAllResponse
+//
 //easyjson:json
 type PhotosGetAllResponseNormal struct {
 	// Total number
@@ -1019,6 +1192,7 @@ type PhotosGetAllResponseNormal struct {
 func (PhotosGetAllResponseNormal) isPhotosGetAll() {}
 
 // PhotosGetAllResponseExtended is extended version of PhotosGetAllResponse
+//
 //easyjson:json
 type PhotosGetAllResponseExtended struct {
 	// Total number
@@ -1032,7 +1206,12 @@ func (PhotosGetAllResponseExtended) isPhotosGetAll() {}
 
 // GetAll Returns a list of photos belonging to a user or community, in reverse chronological order.
 func (v Photos) GetAll(params PhotosGetAllParams) (PhotosGetAllResponse, error) {
-	r, err := v.API.Request("photos.getAll", params)
+	return v.GetAllContext(context.Background(), params)
+}
+
+// GetAllContext is GetAll with context support
+func (v Photos) GetAllContext(ctx context.Context, params PhotosGetAllParams) (PhotosGetAllResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getAll", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1040,11 +1219,11 @@ func (v Photos) GetAll(params PhotosGetAllParams) (PhotosGetAllResponse, error)
 	var resp PhotosGetAllResponse
 	if params.Extended {
 		var tmp PhotosGetAllResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp PhotosGetAllResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -1068,6 +1247,7 @@ type PhotosGetUserPhotosParams struct {
 }
 
 // PhotosGetUserPhotosResponse is response for Photos.GetUserPhotos
+//
 //easyjson:json
 type PhotosGetUserPhotosResponse struct {
 	// Total number
@@ -1077,13 +1257,18 @@ type PhotosGetUserPhotosResponse struct {
 
 // GetUserPhotos Returns a list of photos in which a user is tagged.
 func (v Photos) GetUserPhotos(params PhotosGetUserPhotosParams) (*PhotosGetUserPhotosResponse, error) {
-	r, err := v.API.Request("photos.getUserPhotos", params)
+	return v.GetUserPhotosContext(context.Background(), params)
+}
+
+// GetUserPhotosContext is GetUserPhotos with context support
+func (v Photos) GetUserPhotosContext(ctx context.Context, params PhotosGetUserPhotosParams) (*PhotosGetUserPhotosResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getUserPhotos", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosGetUserPhotosResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1100,7 +1285,12 @@ type PhotosDeleteAlbumParams struct {
 
 // DeleteAlbum Deletes a photo album belonging to the current user.
 func (v Photos) DeleteAlbum(params PhotosDeleteAlbumParams) (bool, error) {
-	r, err := v.API.Request("photos.deleteAlbum", params)
+	return v.DeleteAlbumContext(context.Background(), params)
+}
+
+// DeleteAlbumContext is DeleteAlbum with context support
+func (v Photos) DeleteAlbumContext(ctx context.Context, params PhotosDeleteAlbumParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.deleteAlbum", params)
 	if err != nil {
 		return false, err
 	}
@@ -1118,7 +1308,12 @@ type PhotosDeleteParams struct {
 
 // Delete Deletes a photo.
 func (v Photos) Delete(params PhotosDeleteParams) (bool, error) {
-	r, err := v.API.Request("photos.delete", params)
+	return v.DeleteContext(context.Background(), params)
+}
+
+// DeleteContext is Delete with context support
+func (v Photos) DeleteContext(ctx context.Context, params PhotosDeleteParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.delete", params)
 	if err != nil {
 		return false, err
 	}
@@ -1136,7 +1331,12 @@ type PhotosRestoreParams struct {
 
 // Restore Restores a deleted photo.
 func (v Photos) Restore(params PhotosRestoreParams) (bool, error) {
-	r, err := v.API.Request("photos.restore", params)
+	return v.RestoreContext(context.Background(), params)
+}
+
+// RestoreContext is Restore with context support
+func (v Photos) RestoreContext(ctx context.Context, params PhotosRestoreParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.restore", params)
 	if err != nil {
 		return false, err
 	}
@@ -1156,7 +1356,12 @@ type PhotosConfirmTagParams struct {
 
 // ConfirmTag Confirms a tag on a photo.
 func (v Photos) ConfirmTag(params PhotosConfirmTagParams) (bool, error) {
-	r, err := v.API.Request("photos.confirmTag", params)
+	return v.ConfirmTagContext(context.Background(), params)
+}
+
+// ConfirmTagContext is ConfirmTag with context support
+func (v Photos) ConfirmTagContext(ctx context.Context, params PhotosConfirmTagParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.confirmTag", params)
 	if err != nil {
 		return false, err
 	}
@@ -1191,6 +1396,7 @@ type PhotosGetCommentsResponse interface {
 }
 
 // PhotosGetCommentsResponseNormal is non-extended version of PhotosGetCommentsResponse
+//
 //easyjson:json
 type PhotosGetCommentsResponseNormal struct {
 	// Total number
@@ -1203,6 +1409,7 @@ type PhotosGetCommentsResponseNormal struct {
 func (PhotosGetCommentsResponseNormal) isPhotosGetComments() {}
 
 // PhotosGetCommentsResponseExtended is extended version of PhotosGetCommentsResponse
+//
 //easyjson:json
 type PhotosGetCommentsResponseExtended struct {
 	// Total number
@@ -1218,7 +1425,12 @@ func (PhotosGetCommentsResponseExtended) isPhotosGetComments() {}
 
 // GetComments Returns a list of comments on a photo.
 func (v Photos) GetComments(params PhotosGetCommentsParams) (PhotosGetCommentsResponse, error) {
-	r, err := v.API.Request("photos.getComments", params)
+	return v.GetCommentsContext(context.Background(), params)
+}
+
+// GetCommentsContext is GetComments with context support
+func (v Photos) GetCommentsContext(ctx context.Context, params PhotosGetCommentsParams) (PhotosGetCommentsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getComments", params)
 	if err != nil {
 		return nil, err
 	}
@@ -1226,11 +1438,11 @@ func (v Photos) GetComments(params PhotosGetCommentsParams) (PhotosGetCommentsRe
 	var resp PhotosGetCommentsResponse
 	if params.Extended {
 		var tmp PhotosGetCommentsResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp PhotosGetCommentsResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -1254,6 +1466,7 @@ type PhotosGetAllCommentsParams struct {
 }
 
 // PhotosGetAllCommentsResponse is response for Photos.GetAllComments
+//
 //easyjson:json
 type PhotosGetAllCommentsResponse struct {
 	// Total number
@@ -1263,13 +1476,18 @@ type PhotosGetAllCommentsResponse struct {
 
 // GetAllComments Returns a list of comments on a specific photo album or all albums of the user sorted in reverse chronological order.
 func (v Photos) GetAllComments(params PhotosGetAllCommentsParams) (*PhotosGetAllCommentsResponse, error) {
-	r, err := v.API.Request("photos.getAllComments", params)
+	return v.GetAllCommentsContext(context.Background(), params)
+}
+
+// GetAllCommentsContext is GetAllComments with context support
+func (v Photos) GetAllCommentsContext(ctx context.Context, params PhotosGetAllCommentsParams) (*PhotosGetAllCommentsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getAllComments", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosGetAllCommentsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1300,7 +1518,12 @@ type PhotosCreateCommentResponse int
 
 // CreateComment Adds a new comment on the photo.
 func (v Photos) CreateComment(params PhotosCreateCommentParams) (PhotosCreateCommentResponse, error) {
-	r, err := v.API.Request("photos.createComment", params)
+	return v.CreateCommentContext(context.Background(), params)
+}
+
+// CreateCommentContext is CreateComment with context support
+func (v Photos) CreateCommentContext(ctx context.Context, params PhotosCreateCommentParams) (PhotosCreateCommentResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.createComment", params)
 	if err != nil {
 		return 0, err
 	}
@@ -1327,7 +1550,12 @@ type PhotosDeleteCommentParams struct {
 
 // DeleteComment Deletes a comment on the photo.
 func (v Photos) DeleteComment(params PhotosDeleteCommentParams) (bool, error) {
-	r, err := v.API.Request("photos.deleteComment", params)
+	return v.DeleteCommentContext(context.Background(), params)
+}
+
+// DeleteCommentContext is DeleteComment with context support
+func (v Photos) DeleteCommentContext(ctx context.Context, params PhotosDeleteCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.deleteComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -1345,7 +1573,12 @@ type PhotosRestoreCommentParams struct {
 
 // RestoreComment Restores a deleted comment on a photo.
 func (v Photos) RestoreComment(params PhotosRestoreCommentParams) (bool, error) {
-	r, err := v.API.Request("photos.restoreComment", params)
+	return v.RestoreCommentContext(context.Background(), params)
+}
+
+// RestoreCommentContext is RestoreComment with context support
+func (v Photos) RestoreCommentContext(ctx context.Context, params PhotosRestoreCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.restoreComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -1367,7 +1600,12 @@ type PhotosEditCommentParams struct {
 
 // EditComment Edits a comment on a photo.
 func (v Photos) EditComment(params PhotosEditCommentParams) (bool, error) {
-	r, err := v.API.Request("photos.editComment", params)
+	return v.EditCommentContext(context.Background(), params)
+}
+
+// EditCommentContext is EditComment with context support
+func (v Photos) EditCommentContext(ctx context.Context, params PhotosEditCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.editComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -1385,17 +1623,23 @@ type PhotosGetTagsParams struct {
 }
 
 // PhotosGetTagsResponse is response for Photos.GetTags
+//
 //easyjson:json
 type PhotosGetTagsResponse []genTODOType /* objects.json#/definitions/photos_photo_tag */
 // GetTags Returns a list of tags on a photo.
 func (v Photos) GetTags(params PhotosGetTagsParams) (PhotosGetTagsResponse, error) {
-	r, err := v.API.Request("photos.getTags", params)
+	return v.GetTagsContext(context.Background(), params)
+}
+
+// GetTagsContext is GetTags with context support
+func (v Photos) GetTagsContext(ctx context.Context, params PhotosGetTagsParams) (PhotosGetTagsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getTags", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosGetTagsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1426,7 +1670,12 @@ type PhotosPutTagResponse int
 
 // PutTag Adds a tag on the photo.
 func (v Photos) PutTag(params PhotosPutTagParams) (PhotosPutTagResponse, error) {
-	r, err := v.API.Request("photos.putTag", params)
+	return v.PutTagContext(context.Background(), params)
+}
+
+// PutTagContext is PutTag with context support
+func (v Photos) PutTagContext(ctx context.Context, params PhotosPutTagParams) (PhotosPutTagResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.putTag", params)
 	if err != nil {
 		return 0, err
 	}
@@ -1455,7 +1704,12 @@ type PhotosRemoveTagParams struct {
 
 // RemoveTag Removes a tag from a photo.
 func (v Photos) RemoveTag(params PhotosRemoveTagParams) (bool, error) {
-	r, err := v.API.Request("photos.removeTag", params)
+	return v.RemoveTagContext(context.Background(), params)
+}
+
+// RemoveTagContext is RemoveTag with context support
+func (v Photos) RemoveTagContext(ctx context.Context, params PhotosRemoveTagParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.removeTag", params)
 	if err != nil {
 		return false, err
 	}
@@ -1472,6 +1726,7 @@ type PhotosGetNewTagsParams struct {
 }
 
 // PhotosGetNewTagsResponse is response for Photos.GetNewTags
+//
 //easyjson:json
 type PhotosGetNewTagsResponse struct {
 	// Total number
@@ -1481,13 +1736,18 @@ type PhotosGetNewTagsResponse struct {
 
 // GetNewTags Returns a list of photos with tags that have not been viewed.
 func (v Photos) GetNewTags(params PhotosGetNewTagsParams) (*PhotosGetNewTagsResponse, error) {
-	r, err := v.API.Request("photos.getNewTags", params)
+	return v.GetNewTagsContext(context.Background(), params)
+}
+
+// GetNewTagsContext is GetNewTags with context support
+func (v Photos) GetNewTagsContext(ctx context.Context, params PhotosGetNewTagsParams) (*PhotosGetNewTagsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "photos.getNewTags", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PhotosGetNewTagsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
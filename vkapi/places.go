@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -30,6 +30,7 @@ type PlacesAddParams struct {
 }
 
 // PlacesAddResponse is response for Places.Add
+//
 //easyjson:json
 type PlacesAddResponse struct {
 	// Place ID
@@ -38,13 +39,18 @@ type PlacesAddResponse struct {
 
 // Add Adds a new location to the location database.
 func (v Places) Add(params PlacesAddParams) (*PlacesAddResponse, error) {
-	r, err := v.API.Request("places.add", params)
+	return v.AddContext(context.Background(), params)
+}
+
+// AddContext is Add with context support
+func (v Places) AddContext(ctx context.Context, params PlacesAddParams) (*PlacesAddResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "places.add", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PlacesAddResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -58,18 +64,24 @@ type PlacesGetByIDParams struct {
 }
 
 // PlacesGetByIDResponse is response for Places.GetByID
+//
 //easyjson:json
 type PlacesGetByIDResponse []vk.Place
 
 // GetByID Returns information about locations by their IDs.
 func (v Places) GetByID(params PlacesGetByIDParams) (PlacesGetByIDResponse, error) {
-	r, err := v.API.Request("places.getById", params)
+	return v.GetByIDContext(context.Background(), params)
+}
+
+// GetByIDContext is GetByID with context support
+func (v Places) GetByIDContext(ctx context.Context, params PlacesGetByIDParams) (PlacesGetByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "places.getById", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PlacesGetByIDResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +107,7 @@ type PlacesSearchParams struct {
 }
 
 // PlacesSearchResponse is response for Places.Search
+//
 //easyjson:json
 type PlacesSearchResponse struct {
 	// Total number
@@ -104,13 +117,18 @@ type PlacesSearchResponse struct {
 
 // Search Returns a list of locations that match the search criteria.
 func (v Places) Search(params PlacesSearchParams) (*PlacesSearchResponse, error) {
-	r, err := v.API.Request("places.search", params)
+	return v.SearchContext(context.Background(), params)
+}
+
+// SearchContext is Search with context support
+func (v Places) SearchContext(ctx context.Context, params PlacesSearchParams) (*PlacesSearchResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "places.search", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PlacesSearchResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -134,6 +152,7 @@ type PlacesCheckinParams struct {
 }
 
 // PlacesCheckinResponse is response for Places.Checkin
+//
 //easyjson:json
 type PlacesCheckinResponse struct {
 	// Checkin ID
@@ -142,13 +161,18 @@ type PlacesCheckinResponse struct {
 
 // Checkin Checks a user in at the specified location.
 func (v Places) Checkin(params PlacesCheckinParams) (*PlacesCheckinResponse, error) {
-	r, err := v.API.Request("places.checkin", params)
+	return v.CheckinContext(context.Background(), params)
+}
+
+// CheckinContext is Checkin with context support
+func (v Places) CheckinContext(ctx context.Context, params PlacesCheckinParams) (*PlacesCheckinResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "places.checkin", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PlacesCheckinResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -177,6 +201,7 @@ type PlacesGetCheckinsParams struct {
 }
 
 // PlacesGetCheckinsResponse is response for Places.GetCheckins
+//
 //easyjson:json
 type PlacesGetCheckinsResponse struct {
 	// Total number
@@ -213,13 +238,18 @@ type PlacesGetCheckinsResponse struct {
 
 // GetCheckins Returns a list of user check-ins at locations according to the set parameters.
 func (v Places) GetCheckins(params PlacesGetCheckinsParams) (*PlacesGetCheckinsResponse, error) {
-	r, err := v.API.Request("places.getCheckins", params)
+	return v.GetCheckinsContext(context.Background(), params)
+}
+
+// GetCheckinsContext is GetCheckins with context support
+func (v Places) GetCheckinsContext(ctx context.Context, params PlacesGetCheckinsParams) (*PlacesGetCheckinsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "places.getCheckins", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PlacesGetCheckinsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -227,6 +257,7 @@ func (v Places) GetCheckins(params PlacesGetCheckinsParams) (*PlacesGetCheckinsR
 }
 
 // PlacesGetTypesResponse is response for Places.GetTypes
+//
 //easyjson:json
 type PlacesGetTypesResponse []struct {
 	// Place type ID
@@ -239,13 +270,18 @@ type PlacesGetTypesResponse []struct {
 
 // GetTypes Returns a list of all types of locations.
 func (v Places) GetTypes() (PlacesGetTypesResponse, error) {
-	r, err := v.API.Request("places.getTypes", nil)
+	return v.GetTypesContext(context.Background())
+}
+
+// GetTypesContext is GetTypes with context support
+func (v Places) GetTypesContext(ctx context.Context) (PlacesGetTypesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "places.getTypes", nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PlacesGetTypesResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -26,18 +26,24 @@ type PollsGetByIDParams struct {
 }
 
 // PollsGetByIDResponse is response for Polls.GetByID
+//
 //easyjson:json
 type PollsGetByIDResponse vk.Poll
 
 // GetByID Returns detailed information about a poll by its ID.
 func (v Polls) GetByID(params PollsGetByIDParams) (*PollsGetByIDResponse, error) {
-	r, err := v.API.Request("polls.getById", params)
+	return v.GetByIDContext(context.Background(), params)
+}
+
+// GetByIDContext is GetByID with context support
+func (v Polls) GetByIDContext(ctx context.Context, params PollsGetByIDParams) (*PollsGetByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "polls.getById", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PollsGetByIDResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +62,12 @@ type PollsAddVoteParams struct {
 
 // AddVote Adds the current user's vote to the selected answer in the poll.
 func (v Polls) AddVote(params PollsAddVoteParams) (bool, error) {
-	r, err := v.API.Request("polls.addVote", params)
+	return v.AddVoteContext(context.Background(), params)
+}
+
+// AddVoteContext is AddVote with context support
+func (v Polls) AddVoteContext(ctx context.Context, params PollsAddVoteParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "polls.addVote", params)
 	if err != nil {
 		return false, err
 	}
@@ -77,7 +88,12 @@ type PollsDeleteVoteParams struct {
 
 // DeleteVote Deletes the current user's vote from the selected answer in the poll.
 func (v Polls) DeleteVote(params PollsDeleteVoteParams) (bool, error) {
-	r, err := v.API.Request("polls.deleteVote", params)
+	return v.DeleteVoteContext(context.Background(), params)
+}
+
+// DeleteVoteContext is DeleteVote with context support
+func (v Polls) DeleteVoteContext(ctx context.Context, params PollsDeleteVoteParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "polls.deleteVote", params)
 	if err != nil {
 		return false, err
 	}
@@ -107,6 +123,7 @@ type PollsGetVotersParams struct {
 }
 
 // PollsGetVotersResponse is response for Polls.GetVoters
+//
 //easyjson:json
 type PollsGetVotersResponse []struct {
 	// Answer ID
@@ -121,13 +138,18 @@ type PollsGetVotersResponse []struct {
 
 // GetVoters Returns a list of IDs of users who selected specific answers in the poll.
 func (v Polls) GetVoters(params PollsGetVotersParams) (PollsGetVotersResponse, error) {
-	r, err := v.API.Request("polls.getVoters", params)
+	return v.GetVotersContext(context.Background(), params)
+}
+
+// GetVotersContext is GetVoters with context support
+func (v Polls) GetVotersContext(ctx context.Context, params PollsGetVotersParams) (PollsGetVotersResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "polls.getVoters", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PollsGetVotersResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -151,18 +173,24 @@ type PollsCreateParams struct {
 }
 
 // PollsCreateResponse is response for Polls.Create
+//
 //easyjson:json
 type PollsCreateResponse vk.Poll
 
 // Create Creates polls that can be attached to the users' or communities' posts.
 func (v Polls) Create(params PollsCreateParams) (*PollsCreateResponse, error) {
-	r, err := v.API.Request("polls.create", params)
+	return v.CreateContext(context.Background(), params)
+}
+
+// CreateContext is Create with context support
+func (v Polls) CreateContext(ctx context.Context, params PollsCreateParams) (*PollsCreateResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "polls.create", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp PollsCreateResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -190,7 +218,12 @@ type PollsEditParams struct {
 
 // Edit Edits created polls
 func (v Polls) Edit(params PollsEditParams) (bool, error) {
-	r, err := v.API.Request("polls.edit", params)
+	return v.EditContext(context.Background(), params)
+}
+
+// EditContext is Edit with context support
+func (v Polls) EditContext(ctx context.Context, params PollsEditParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "polls.edit", params)
 	if err != nil {
 		return false, err
 	}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -25,6 +25,7 @@ type SearchGetHintsParams struct {
 }
 
 // SearchGetHintsResponse is response for Search.GetHints
+//
 //easyjson:json
 type SearchGetHintsResponse struct {
 	Items []struct {
@@ -44,13 +45,18 @@ type SearchGetHintsResponse struct {
 
 // GetHints Allows the programmer to do a quick search for any substring.
 func (v Search) GetHints(params SearchGetHintsParams) (*SearchGetHintsResponse, error) {
-	r, err := v.API.Request("search.getHints", params)
+	return v.GetHintsContext(context.Background(), params)
+}
+
+// GetHintsContext is GetHints with context support
+func (v Search) GetHintsContext(ctx context.Context, params SearchGetHintsParams) (*SearchGetHintsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "search.getHints", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp SearchGetHintsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 	"strconv"
 
 	"github.com/stek29/vk"
@@ -18,7 +18,12 @@ type SecureGetAppBalanceResponse int
 
 // GetAppBalance Returns payment balance of the application in hundredth of a vote.
 func (v Secure) GetAppBalance() (SecureGetAppBalanceResponse, error) {
-	r, err := v.API.Request("secure.getAppBalance", nil)
+	return v.GetAppBalanceContext(context.Background())
+}
+
+// GetAppBalanceContext is GetAppBalance with context support
+func (v Secure) GetAppBalanceContext(ctx context.Context) (SecureGetAppBalanceResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "secure.getAppBalance", nil)
 	if err != nil {
 		return 0, err
 	}
@@ -46,6 +51,7 @@ type SecureGetTransactionsHistoryParams struct {
 }
 
 // SecureGetTransactionsHistoryResponse is response for Secure.GetTransactionsHistory
+//
 //easyjson:json
 type SecureGetTransactionsHistoryResponse []struct {
 	// Transaction ID
@@ -62,13 +68,18 @@ type SecureGetTransactionsHistoryResponse []struct {
 
 // GetTransactionsHistory Shows history of votes transaction between users and the application.
 func (v Secure) GetTransactionsHistory(params SecureGetTransactionsHistoryParams) (SecureGetTransactionsHistoryResponse, error) {
-	r, err := v.API.Request("secure.getTransactionsHistory", params)
+	return v.GetTransactionsHistoryContext(context.Background(), params)
+}
+
+// GetTransactionsHistoryContext is GetTransactionsHistory with context support
+func (v Secure) GetTransactionsHistoryContext(ctx context.Context, params SecureGetTransactionsHistoryParams) (SecureGetTransactionsHistoryResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "secure.getTransactionsHistory", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp SecureGetTransactionsHistoryResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +98,7 @@ type SecureGetSMSHistoryParams struct {
 }
 
 // SecureGetSMSHistoryResponse is response for Secure.GetSMSHistory
+//
 //easyjson:json
 type SecureGetSMSHistoryResponse []struct {
 	// Notification ID
@@ -103,13 +115,18 @@ type SecureGetSMSHistoryResponse []struct {
 
 // GetSMSHistory Shows a list of SMS notifications sent by the application using [vk.com/dev/secure.sendSMSNotification|secure.sendSMSNotification] method.
 func (v Secure) GetSMSHistory(params SecureGetSMSHistoryParams) (SecureGetSMSHistoryResponse, error) {
-	r, err := v.API.Request("secure.getSMSHistory", params)
+	return v.GetSMSHistoryContext(context.Background(), params)
+}
+
+// GetSMSHistoryContext is GetSMSHistory with context support
+func (v Secure) GetSMSHistoryContext(ctx context.Context, params SecureGetSMSHistoryParams) (SecureGetSMSHistoryResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "secure.getSMSHistory", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp SecureGetSMSHistoryResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +143,12 @@ type SecureSendSMSNotificationParams struct {
 
 // SendSMSNotification Sends 'SMS' notification to a user's mobile device.
 func (v Secure) SendSMSNotification(params SecureSendSMSNotificationParams) (bool, error) {
-	r, err := v.API.Request("secure.sendSMSNotification", params)
+	return v.SendSMSNotificationContext(context.Background(), params)
+}
+
+// SendSMSNotificationContext is SendSMSNotification with context support
+func (v Secure) SendSMSNotificationContext(ctx context.Context, params SecureSendSMSNotificationParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "secure.sendSMSNotification", params)
 	if err != nil {
 		return false, err
 	}
@@ -144,18 +166,24 @@ type SecureSendNotificationParams struct {
 
 // SecureSendNotificationResponse is response for Secure.SendNotification
 // User ID
+//
 //easyjson:json
 type SecureSendNotificationResponse []int
 
 // SendNotification Sends notification to the user.
 func (v Secure) SendNotification(params SecureSendNotificationParams) (SecureSendNotificationResponse, error) {
-	r, err := v.API.Request("secure.sendNotification", params)
+	return v.SendNotificationContext(context.Background(), params)
+}
+
+// SendNotificationContext is SendNotification with context support
+func (v Secure) SendNotificationContext(ctx context.Context, params SecureSendNotificationParams) (SecureSendNotificationResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "secure.sendNotification", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp SecureSendNotificationResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +201,12 @@ type SecureSetCounterParams struct {
 
 // SetCounter Sets a counter which is shown to the user in bold in the left menu.
 func (v Secure) SetCounter(params SecureSetCounterParams) (bool, error) {
-	r, err := v.API.Request("secure.setCounter", params)
+	return v.SetCounterContext(context.Background(), params)
+}
+
+// SetCounterContext is SetCounter with context support
+func (v Secure) SetCounterContext(ctx context.Context, params SecureSetCounterParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "secure.setCounter", params)
 	if err != nil {
 		return false, err
 	}
@@ -187,6 +220,7 @@ type SecureGetUserLevelParams struct {
 }
 
 // SecureGetUserLevelResponse is response for Secure.GetUserLevel
+//
 //easyjson:json
 type SecureGetUserLevelResponse []struct {
 	// User ID
@@ -197,13 +231,18 @@ type SecureGetUserLevelResponse []struct {
 
 // GetUserLevel Returns one of the previously set game levels of one or more users in the application.
 func (v Secure) GetUserLevel(params SecureGetUserLevelParams) (SecureGetUserLevelResponse, error) {
-	r, err := v.API.Request("secure.getUserLevel", params)
+	return v.GetUserLevelContext(context.Background(), params)
+}
+
+// GetUserLevelContext is GetUserLevel with context support
+func (v Secure) GetUserLevelContext(ctx context.Context, params SecureGetUserLevelParams) (SecureGetUserLevelResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "secure.getUserLevel", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp SecureGetUserLevelResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -222,7 +261,12 @@ type SecureAddAppEventParams struct {
 
 // AddAppEvent Adds user activity information to an application
 func (v Secure) AddAppEvent(params SecureAddAppEventParams) (bool, error) {
-	r, err := v.API.Request("secure.addAppEvent", params)
+	return v.AddAppEventContext(context.Background(), params)
+}
+
+// AddAppEventContext is AddAppEvent with context support
+func (v Secure) AddAppEventContext(ctx context.Context, params SecureAddAppEventParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "secure.addAppEvent", params)
 	if err != nil {
 		return false, err
 	}
@@ -239,6 +283,7 @@ type SecureCheckTokenParams struct {
 }
 
 // SecureCheckTokenResponse is response for Secure.CheckToken
+//
 //easyjson:json
 type SecureCheckTokenResponse struct {
 	// Returns if successfully processed
@@ -253,13 +298,18 @@ type SecureCheckTokenResponse struct {
 
 // CheckToken Checks the user authentication in 'IFrame' and 'Flash' apps using the 'access_token' parameter.
 func (v Secure) CheckToken(params SecureCheckTokenParams) (*SecureCheckTokenResponse, error) {
-	r, err := v.API.Request("secure.checkToken", params)
+	return v.CheckTokenContext(context.Background(), params)
+}
+
+// CheckTokenContext is CheckToken with context support
+func (v Secure) CheckTokenContext(ctx context.Context, params SecureCheckTokenParams) (*SecureCheckTokenResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "secure.checkToken", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp SecureCheckTokenResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
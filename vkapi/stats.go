@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -27,17 +27,23 @@ type StatsGetParams struct {
 }
 
 // StatsGetResponse is response for Stats.Get
+//
 //easyjson:json
 type StatsGetResponse []genTODOType /* objects.json#/definitions/stats_period */
 // Get Returns statistics of a community or an application.
 func (v Stats) Get(params StatsGetParams) (StatsGetResponse, error) {
-	r, err := v.API.Request("stats.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Stats) GetContext(ctx context.Context, params StatsGetParams) (StatsGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stats.get", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp StatsGetResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +57,12 @@ type StatsTrackVisitorParams struct {
 
 // TrackVisitor does stats.trackVisitor
 func (v Stats) TrackVisitor(params StatsTrackVisitorParams) (bool, error) {
-	r, err := v.API.Request("stats.trackVisitor", params)
+	return v.TrackVisitorContext(context.Background(), params)
+}
+
+// TrackVisitorContext is TrackVisitor with context support
+func (v Stats) TrackVisitorContext(ctx context.Context, params StatsTrackVisitorParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stats.trackVisitor", params)
 	if err != nil {
 		return false, err
 	}
@@ -68,18 +79,24 @@ type StatsGetPostReachParams struct {
 }
 
 // StatsGetPostReachResponse is response for Stats.GetPostReach
+//
 //easyjson:json
 type StatsGetPostReachResponse []vk.WallpostStats
 
 // GetPostReach Returns stats for a wall post.
 func (v Stats) GetPostReach(params StatsGetPostReachParams) (StatsGetPostReachResponse, error) {
-	r, err := v.API.Request("stats.getPostReach", params)
+	return v.GetPostReachContext(context.Background(), params)
+}
+
+// GetPostReachContext is GetPostReach with context support
+func (v Stats) GetPostReachContext(ctx context.Context, params StatsGetPostReachParams) (StatsGetPostReachResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stats.getPostReach", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp StatsGetPostReachResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -19,6 +19,7 @@ type StatusGetParams struct {
 }
 
 // StatusGetResponse is response for Status.Get
+//
 //easyjson:json
 type StatusGetResponse struct {
 	// Status text
@@ -28,13 +29,18 @@ type StatusGetResponse struct {
 
 // Get Returns data required to show the status of a user or community.
 func (v Status) Get(params StatusGetParams) (*StatusGetResponse, error) {
-	r, err := v.API.Request("status.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Status) GetContext(ctx context.Context, params StatusGetParams) (*StatusGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "status.get", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp StatusGetResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +57,12 @@ type StatusSetParams struct {
 
 // Set Sets a new status for the current user.
 func (v Status) Set(params StatusSetParams) (bool, error) {
-	r, err := v.API.Request("status.set", params)
+	return v.SetContext(context.Background(), params)
+}
+
+// SetContext is Set with context support
+func (v Status) SetContext(ctx context.Context, params StatusSetParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "status.set", params)
 	if err != nil {
 		return false, err
 	}
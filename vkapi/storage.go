@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -25,7 +25,12 @@ type StorageGetResponse string
 
 // Get Returns a value of variable with the name set by key parameter.
 func (v Storage) Get(params StorageGetParams) (StorageGetResponse, error) {
-	r, err := v.API.Request("storage.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Storage) GetContext(ctx context.Context, params StorageGetParams) (StorageGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "storage.get", params)
 	if err != nil {
 		return "", err
 	}
@@ -50,7 +55,12 @@ type StorageSetParams struct {
 
 // Set Saves a value of variable with the name set by 'key' parameter.
 func (v Storage) Set(params StorageSetParams) (bool, error) {
-	r, err := v.API.Request("storage.set", params)
+	return v.SetContext(context.Background(), params)
+}
+
+// SetContext is Set with context support
+func (v Storage) SetContext(ctx context.Context, params StorageSetParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "storage.set", params)
 	if err != nil {
 		return false, err
 	}
@@ -70,18 +80,24 @@ type StorageGetKeysParams struct {
 
 // StorageGetKeysResponse is response for Storage.GetKeys
 // Key name
+//
 //easyjson:json
 type StorageGetKeysResponse []string
 
 // GetKeys Returns the names of all variables.
 func (v Storage) GetKeys(params StorageGetKeysParams) (StorageGetKeysResponse, error) {
-	r, err := v.API.Request("storage.getKeys", params)
+	return v.GetKeysContext(context.Background(), params)
+}
+
+// GetKeysContext is GetKeys with context support
+func (v Storage) GetKeysContext(ctx context.Context, params StorageGetKeysParams) (StorageGetKeysResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "storage.getKeys", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp StorageGetKeysResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -19,7 +19,12 @@ type StoriesBanOwnerParams struct {
 
 // BanOwner Allows to hide stories from chosen sources from current user's feed.
 func (v Stories) BanOwner(params StoriesBanOwnerParams) (bool, error) {
-	r, err := v.API.Request("stories.banOwner", params)
+	return v.BanOwnerContext(context.Background(), params)
+}
+
+// BanOwnerContext is BanOwner with context support
+func (v Stories) BanOwnerContext(ctx context.Context, params StoriesBanOwnerParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stories.banOwner", params)
 	if err != nil {
 		return false, err
 	}
@@ -37,7 +42,12 @@ type StoriesDeleteParams struct {
 
 // Delete Allows to delete story.
 func (v Stories) Delete(params StoriesDeleteParams) (bool, error) {
-	r, err := v.API.Request("stories.delete", params)
+	return v.DeleteContext(context.Background(), params)
+}
+
+// DeleteContext is Delete with context support
+func (v Stories) DeleteContext(ctx context.Context, params StoriesDeleteParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stories.delete", params)
 	if err != nil {
 		return false, err
 	}
@@ -60,6 +70,7 @@ type StoriesGetResponse interface {
 }
 
 // StoriesGetResponseNormal is non-extended version of StoriesGetResponse
+//
 //easyjson:json
 type StoriesGetResponseNormal struct {
 	// Stories count
@@ -70,6 +81,7 @@ type StoriesGetResponseNormal struct {
 func (StoriesGetResponseNormal) isStoriesGet() {}
 
 // StoriesGetResponseExtended is extended version of StoriesGetResponse
+//
 //easyjson:json
 type StoriesGetResponseExtended struct {
 	// Stories count
@@ -83,7 +95,12 @@ func (StoriesGetResponseExtended) isStoriesGet() {}
 
 // Get Returns stories available for current user.
 func (v Stories) Get(params StoriesGetParams) (StoriesGetResponse, error) {
-	r, err := v.API.Request("stories.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Stories) GetContext(ctx context.Context, params StoriesGetParams) (StoriesGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stories.get", params)
 	if err != nil {
 		return nil, err
 	}
@@ -91,11 +108,11 @@ func (v Stories) Get(params StoriesGetParams) (StoriesGetResponse, error) {
 	var resp StoriesGetResponse
 	if params.Extended {
 		var tmp StoriesGetResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp StoriesGetResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -119,6 +136,7 @@ type StoriesGetBannedResponse interface {
 }
 
 // StoriesGetBannedResponseNormal is non-extended version of StoriesGetBannedResponse
+//
 //easyjson:json
 type StoriesGetBannedResponseNormal struct {
 	// Stories count
@@ -130,6 +148,7 @@ type StoriesGetBannedResponseNormal struct {
 func (StoriesGetBannedResponseNormal) isStoriesGetBanned() {}
 
 // StoriesGetBannedResponseExtended is extended version of StoriesGetBannedResponse
+//
 //easyjson:json
 type StoriesGetBannedResponseExtended struct {
 	// Stories count
@@ -144,7 +163,12 @@ func (StoriesGetBannedResponseExtended) isStoriesGetBanned() {}
 
 // GetBanned Returns list of sources hidden from current user's feed.
 func (v Stories) GetBanned(params StoriesGetBannedParams) (StoriesGetBannedResponse, error) {
-	r, err := v.API.Request("stories.getBanned", params)
+	return v.GetBannedContext(context.Background(), params)
+}
+
+// GetBannedContext is GetBanned with context support
+func (v Stories) GetBannedContext(ctx context.Context, params StoriesGetBannedParams) (StoriesGetBannedResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stories.getBanned", params)
 	if err != nil {
 		return nil, err
 	}
@@ -152,11 +176,11 @@ func (v Stories) GetBanned(params StoriesGetBannedParams) (StoriesGetBannedRespo
 	var resp StoriesGetBannedResponse
 	if params.Extended {
 		var tmp StoriesGetBannedResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp StoriesGetBannedResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -182,6 +206,7 @@ type StoriesGetByIDResponse interface {
 }
 
 // StoriesGetByIDResponseNormal is non-extended version of StoriesGetByIDResponse
+//
 //easyjson:json
 type StoriesGetByIDResponseNormal struct {
 	// Stories count
@@ -192,6 +217,7 @@ type StoriesGetByIDResponseNormal struct {
 func (StoriesGetByIDResponseNormal) isStoriesGetByID() {}
 
 // StoriesGetByIDResponseExtended is extended version of StoriesGetByIDResponse
+//
 //easyjson:json
 type StoriesGetByIDResponseExtended struct {
 	// Stories count
@@ -205,7 +231,12 @@ func (StoriesGetByIDResponseExtended) isStoriesGetByID() {}
 
 // GetByID Returns story by its ID.
 func (v Stories) GetByID(params StoriesGetByIDParams) (StoriesGetByIDResponse, error) {
-	r, err := v.API.Request("stories.getById", params)
+	return v.GetByIDContext(context.Background(), params)
+}
+
+// GetByIDContext is GetByID with context support
+func (v Stories) GetByIDContext(ctx context.Context, params StoriesGetByIDParams) (StoriesGetByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stories.getById", params)
 	if err != nil {
 		return nil, err
 	}
@@ -213,11 +244,11 @@ func (v Stories) GetByID(params StoriesGetByIDParams) (StoriesGetByIDResponse, e
 	var resp StoriesGetByIDResponse
 	if params.Extended {
 		var tmp StoriesGetByIDResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp StoriesGetByIDResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -243,6 +274,7 @@ type StoriesGetPhotoUploadServerParams struct {
 }
 
 // StoriesGetPhotoUploadServerResponse is response for Stories.GetPhotoUploadServer
+//
 //easyjson:json
 type StoriesGetPhotoUploadServerResponse struct {
 	// Upload URL
@@ -253,13 +285,18 @@ type StoriesGetPhotoUploadServerResponse struct {
 
 // GetPhotoUploadServer Returns URL for uploading a story with photo.
 func (v Stories) GetPhotoUploadServer(params StoriesGetPhotoUploadServerParams) (*StoriesGetPhotoUploadServerResponse, error) {
-	r, err := v.API.Request("stories.getPhotoUploadServer", params)
+	return v.GetPhotoUploadServerContext(context.Background(), params)
+}
+
+// GetPhotoUploadServerContext is GetPhotoUploadServer with context support
+func (v Stories) GetPhotoUploadServerContext(ctx context.Context, params StoriesGetPhotoUploadServerParams) (*StoriesGetPhotoUploadServerResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stories.getPhotoUploadServer", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp StoriesGetPhotoUploadServerResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -287,6 +324,7 @@ type StoriesGetRepliesResponse interface {
 }
 
 // StoriesGetRepliesResponseNormal is non-extended version of StoriesGetRepliesResponse
+//
 //easyjson:json
 type StoriesGetRepliesResponseNormal struct {
 	// Stories count
@@ -297,6 +335,7 @@ type StoriesGetRepliesResponseNormal struct {
 func (StoriesGetRepliesResponseNormal) isStoriesGetReplies() {}
 
 // StoriesGetRepliesResponseExtended is extended version of StoriesGetRepliesResponse
+//
 //easyjson:json
 type StoriesGetRepliesResponseExtended struct {
 	// Stories count
@@ -310,7 +349,12 @@ func (StoriesGetRepliesResponseExtended) isStoriesGetReplies() {}
 
 // GetReplies Returns replies to the story.
 func (v Stories) GetReplies(params StoriesGetRepliesParams) (StoriesGetRepliesResponse, error) {
-	r, err := v.API.Request("stories.getReplies", params)
+	return v.GetRepliesContext(context.Background(), params)
+}
+
+// GetRepliesContext is GetReplies with context support
+func (v Stories) GetRepliesContext(ctx context.Context, params StoriesGetRepliesParams) (StoriesGetRepliesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stories.getReplies", params)
 	if err != nil {
 		return nil, err
 	}
@@ -318,11 +362,11 @@ func (v Stories) GetReplies(params StoriesGetRepliesParams) (StoriesGetRepliesRe
 	var resp StoriesGetRepliesResponse
 	if params.Extended {
 		var tmp StoriesGetRepliesResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp StoriesGetRepliesResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -340,6 +384,7 @@ type StoriesGetStatsParams struct {
 }
 
 // StoriesGetStatsResponse is response for Stories.GetStats
+//
 //easyjson:json
 type StoriesGetStatsResponse struct {
 	Views struct {
@@ -388,13 +433,18 @@ type StoriesGetStatsResponse struct {
 
 // GetStats Returns stories available for current user.
 func (v Stories) GetStats(params StoriesGetStatsParams) (*StoriesGetStatsResponse, error) {
-	r, err := v.API.Request("stories.getStats", params)
+	return v.GetStatsContext(context.Background(), params)
+}
+
+// GetStatsContext is GetStats with context support
+func (v Stories) GetStatsContext(ctx context.Context, params StoriesGetStatsParams) (*StoriesGetStatsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stories.getStats", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp StoriesGetStatsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -418,6 +468,7 @@ type StoriesGetVideoUploadServerParams struct {
 }
 
 // StoriesGetVideoUploadServerResponse is response for Stories.GetVideoUploadServer
+//
 //easyjson:json
 type StoriesGetVideoUploadServerResponse struct {
 	// Upload URL
@@ -428,13 +479,18 @@ type StoriesGetVideoUploadServerResponse struct {
 
 // GetVideoUploadServer Allows to receive URL for uploading story with video.
 func (v Stories) GetVideoUploadServer(params StoriesGetVideoUploadServerParams) (*StoriesGetVideoUploadServerResponse, error) {
-	r, err := v.API.Request("stories.getVideoUploadServer", params)
+	return v.GetVideoUploadServerContext(context.Background(), params)
+}
+
+// GetVideoUploadServerContext is GetVideoUploadServer with context support
+func (v Stories) GetVideoUploadServerContext(ctx context.Context, params StoriesGetVideoUploadServerParams) (*StoriesGetVideoUploadServerResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stories.getVideoUploadServer", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp StoriesGetVideoUploadServerResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -462,6 +518,7 @@ type StoriesGetViewersResponse interface {
 }
 
 // StoriesGetViewersResponseNormal is non-extended version of StoriesGetViewersResponse
+//
 //easyjson:json
 type StoriesGetViewersResponseNormal struct {
 	// Viewers count
@@ -472,6 +529,7 @@ type StoriesGetViewersResponseNormal struct {
 func (StoriesGetViewersResponseNormal) isStoriesGetViewers() {}
 
 // StoriesGetViewersResponseExtended is extended version of StoriesGetViewersResponse
+//
 //easyjson:json
 type StoriesGetViewersResponseExtended struct {
 	// Viewers count
@@ -483,7 +541,12 @@ func (StoriesGetViewersResponseExtended) isStoriesGetViewers() {}
 
 // GetViewers Returns a list of story viewers.
 func (v Stories) GetViewers(params StoriesGetViewersParams) (StoriesGetViewersResponse, error) {
-	r, err := v.API.Request("stories.getViewers", params)
+	return v.GetViewersContext(context.Background(), params)
+}
+
+// GetViewersContext is GetViewers with context support
+func (v Stories) GetViewersContext(ctx context.Context, params StoriesGetViewersParams) (StoriesGetViewersResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stories.getViewers", params)
 	if err != nil {
 		return nil, err
 	}
@@ -491,11 +554,11 @@ func (v Stories) GetViewers(params StoriesGetViewersParams) (StoriesGetViewersRe
 	var resp StoriesGetViewersResponse
 	if params.Extended {
 		var tmp StoriesGetViewersResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp StoriesGetViewersResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -513,7 +576,12 @@ type StoriesHideAllRepliesParams struct {
 
 // HideAllReplies Hides all replies in the last 24 hours from the user to current user's stories.
 func (v Stories) HideAllReplies(params StoriesHideAllRepliesParams) (bool, error) {
-	r, err := v.API.Request("stories.hideAllReplies", params)
+	return v.HideAllRepliesContext(context.Background(), params)
+}
+
+// HideAllRepliesContext is HideAllReplies with context support
+func (v Stories) HideAllRepliesContext(ctx context.Context, params StoriesHideAllRepliesParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stories.hideAllReplies", params)
 	if err != nil {
 		return false, err
 	}
@@ -533,7 +601,12 @@ type StoriesHideReplyParams struct {
 
 // HideReply Hides the reply to the current user's story.
 func (v Stories) HideReply(params StoriesHideReplyParams) (bool, error) {
-	r, err := v.API.Request("stories.hideReply", params)
+	return v.HideReplyContext(context.Background(), params)
+}
+
+// HideReplyContext is HideReply with context support
+func (v Stories) HideReplyContext(ctx context.Context, params StoriesHideReplyParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stories.hideReply", params)
 	if err != nil {
 		return false, err
 	}
@@ -549,7 +622,12 @@ type StoriesUnbanOwnerParams struct {
 
 // UnbanOwner Allows to show stories from hidden sources in current user's feed.
 func (v Stories) UnbanOwner(params StoriesUnbanOwnerParams) (bool, error) {
-	r, err := v.API.Request("stories.unbanOwner", params)
+	return v.UnbanOwnerContext(context.Background(), params)
+}
+
+// UnbanOwnerContext is UnbanOwner with context support
+func (v Stories) UnbanOwnerContext(ctx context.Context, params StoriesUnbanOwnerParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "stories.unbanOwner", params)
 	if err != nil {
 		return false, err
 	}
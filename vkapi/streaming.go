@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -12,6 +12,7 @@ type Streaming struct {
 }
 
 // StreamingGetServerURLResponse is response for Streaming.GetServerURL
+//
 //easyjson:json
 type StreamingGetServerURLResponse struct {
 	// Server host
@@ -22,13 +23,18 @@ type StreamingGetServerURLResponse struct {
 
 // GetServerURL Allows to receive data for the connection to Streaming API.
 func (v Streaming) GetServerURL() (*StreamingGetServerURLResponse, error) {
-	r, err := v.API.Request("streaming.getServerUrl", nil)
+	return v.GetServerURLContext(context.Background())
+}
+
+// GetServerURLContext is GetServerURL with context support
+func (v Streaming) GetServerURLContext(ctx context.Context) (*StreamingGetServerURLResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "streaming.getServerUrl", nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp StreamingGetServerURLResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +48,12 @@ type StreamingSetSettingsParams struct {
 
 // SetSettings does streaming.setSettings
 func (v Streaming) SetSettings(params StreamingSetSettingsParams) (bool, error) {
-	r, err := v.API.Request("streaming.setSettings", params)
+	return v.SetSettingsContext(context.Background(), params)
+}
+
+// SetSettingsContext is SetSettings with context support
+func (v Streaming) SetSettingsContext(ctx context.Context, params StreamingSetSettingsParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "streaming.setSettings", params)
 	if err != nil {
 		return false, err
 	}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -22,18 +22,24 @@ type UsersGetParams struct {
 }
 
 // UsersGetResponse is response for Users.Get
+//
 //easyjson:json
 type UsersGetResponse []vk.User
 
 // Get Returns detailed information on users.
 func (v Users) Get(params UsersGetParams) (UsersGetResponse, error) {
-	r, err := v.API.Request("users.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Users) GetContext(ctx context.Context, params UsersGetParams) (UsersGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "users.get", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp UsersGetResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -109,6 +115,7 @@ type UsersSearchParams struct {
 }
 
 // UsersSearchResponse is response for Users.Search
+//
 //easyjson:json
 type UsersSearchResponse struct {
 	// Total number of available results
@@ -118,13 +125,18 @@ type UsersSearchResponse struct {
 
 // Search Returns a list of users matching the search criteria.
 func (v Users) Search(params UsersSearchParams) (*UsersSearchResponse, error) {
-	r, err := v.API.Request("users.search", params)
+	return v.SearchContext(context.Background(), params)
+}
+
+// SearchContext is Search with context support
+func (v Users) SearchContext(ctx context.Context, params UsersSearchParams) (*UsersSearchResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "users.search", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp UsersSearchResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +150,12 @@ type UsersIsAppUserParams struct {
 
 // IsAppUser Returns information whether a user installed the application.
 func (v Users) IsAppUser(params UsersIsAppUserParams) (bool, error) {
-	r, err := v.API.Request("users.isAppUser", params)
+	return v.IsAppUserContext(context.Background(), params)
+}
+
+// IsAppUserContext is IsAppUser with context support
+func (v Users) IsAppUserContext(ctx context.Context, params UsersIsAppUserParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "users.isAppUser", params)
 	if err != nil {
 		return false, err
 	}
@@ -166,6 +183,7 @@ type UsersGetSubscriptionsResponse interface {
 }
 
 // UsersGetSubscriptionsResponseNormal is non-extended version of UsersGetSubscriptionsResponse
+//
 //easyjson:json
 type UsersGetSubscriptionsResponseNormal struct {
 	Users struct {
@@ -185,6 +203,7 @@ type UsersGetSubscriptionsResponseNormal struct {
 func (UsersGetSubscriptionsResponseNormal) isUsersGetSubscriptions() {}
 
 // UsersGetSubscriptionsResponseExtended is extended version of UsersGetSubscriptionsResponse
+//
 //easyjson:json
 type UsersGetSubscriptionsResponseExtended struct {
 	// Total number of available results
@@ -196,7 +215,12 @@ func (UsersGetSubscriptionsResponseExtended) isUsersGetSubscriptions() {}
 
 // GetSubscriptions Returns a list of IDs of users and communities followed by the user.
 func (v Users) GetSubscriptions(params UsersGetSubscriptionsParams) (UsersGetSubscriptionsResponse, error) {
-	r, err := v.API.Request("users.getSubscriptions", params)
+	return v.GetSubscriptionsContext(context.Background(), params)
+}
+
+// GetSubscriptionsContext is GetSubscriptions with context support
+func (v Users) GetSubscriptionsContext(ctx context.Context, params UsersGetSubscriptionsParams) (UsersGetSubscriptionsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "users.getSubscriptions", params)
 	if err != nil {
 		return nil, err
 	}
@@ -204,11 +228,11 @@ func (v Users) GetSubscriptions(params UsersGetSubscriptionsParams) (UsersGetSub
 	var resp UsersGetSubscriptionsResponse
 	if params.Extended {
 		var tmp UsersGetSubscriptionsResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp UsersGetSubscriptionsResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -232,6 +256,7 @@ type UsersGetFollowersParams struct {
 }
 
 // UsersGetFollowersResponse is response for Users.GetFollowers
+//
 //easyjson:json
 type UsersGetFollowersResponse struct {
 	// Total friends number
@@ -242,13 +267,18 @@ type UsersGetFollowersResponse struct {
 
 // GetFollowers Returns a list of IDs of followers of the user in question, sorted by date added, most recent first.
 func (v Users) GetFollowers(params UsersGetFollowersParams) (*UsersGetFollowersResponse, error) {
-	r, err := v.API.Request("users.getFollowers", params)
+	return v.GetFollowersContext(context.Background(), params)
+}
+
+// GetFollowersContext is GetFollowers with context support
+func (v Users) GetFollowersContext(ctx context.Context, params UsersGetFollowersParams) (*UsersGetFollowersResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "users.getFollowers", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp UsersGetFollowersResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -267,7 +297,12 @@ type UsersReportParams struct {
 
 // Report Reports (submits a complain about) a user.
 func (v Users) Report(params UsersReportParams) (bool, error) {
-	r, err := v.API.Request("users.report", params)
+	return v.ReportContext(context.Background(), params)
+}
+
+// ReportContext is Report with context support
+func (v Users) ReportContext(ctx context.Context, params UsersReportParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "users.report", params)
 	if err != nil {
 		return false, err
 	}
@@ -294,6 +329,7 @@ type UsersGetNearbyParams struct {
 }
 
 // UsersGetNearbyResponse is response for Users.GetNearby
+//
 //easyjson:json
 type UsersGetNearbyResponse struct {
 	// Users number
@@ -303,13 +339,18 @@ type UsersGetNearbyResponse struct {
 
 // GetNearby Indexes current user location and returns nearby users.
 func (v Users) GetNearby(params UsersGetNearbyParams) (*UsersGetNearbyResponse, error) {
-	r, err := v.API.Request("users.getNearby", params)
+	return v.GetNearbyContext(context.Background(), params)
+}
+
+// GetNearbyContext is GetNearby with context support
+func (v Users) GetNearbyContext(ctx context.Context, params UsersGetNearbyParams) (*UsersGetNearbyResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "users.getNearby", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp UsersGetNearbyResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
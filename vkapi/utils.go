@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 	"strconv"
 
 	"github.com/stek29/vk"
@@ -19,6 +19,7 @@ type UtilsCheckLinkParams struct {
 }
 
 // UtilsCheckLinkResponse is response for Utils.CheckLink
+//
 //easyjson:json
 type UtilsCheckLinkResponse struct {
 	// Link status
@@ -29,13 +30,18 @@ type UtilsCheckLinkResponse struct {
 
 // CheckLink Checks whether a link is blocked in VK.
 func (v Utils) CheckLink(params UtilsCheckLinkParams) (*UtilsCheckLinkResponse, error) {
-	r, err := v.API.Request("utils.checkLink", params)
+	return v.CheckLinkContext(context.Background(), params)
+}
+
+// CheckLinkContext is CheckLink with context support
+func (v Utils) CheckLinkContext(ctx context.Context, params UtilsCheckLinkParams) (*UtilsCheckLinkResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "utils.checkLink", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp UtilsCheckLinkResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +56,12 @@ type UtilsDeleteFromLastShortenedParams struct {
 
 // DeleteFromLastShortened Deletes shortened link from user's list.
 func (v Utils) DeleteFromLastShortened(params UtilsDeleteFromLastShortenedParams) (bool, error) {
-	r, err := v.API.Request("utils.deleteFromLastShortened", params)
+	return v.DeleteFromLastShortenedContext(context.Background(), params)
+}
+
+// DeleteFromLastShortenedContext is DeleteFromLastShortened with context support
+func (v Utils) DeleteFromLastShortenedContext(ctx context.Context, params UtilsDeleteFromLastShortenedParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "utils.deleteFromLastShortened", params)
 	if err != nil {
 		return false, err
 	}
@@ -67,6 +78,7 @@ type UtilsGetLastShortenedLinksParams struct {
 }
 
 // UtilsGetLastShortenedLinksResponse is response for Utils.GetLastShortenedLinks
+//
 //easyjson:json
 type UtilsGetLastShortenedLinksResponse struct {
 	// Total number of available results
@@ -89,13 +101,18 @@ type UtilsGetLastShortenedLinksResponse struct {
 
 // GetLastShortenedLinks Returns a list of user's shortened links.
 func (v Utils) GetLastShortenedLinks(params UtilsGetLastShortenedLinksParams) (*UtilsGetLastShortenedLinksResponse, error) {
-	r, err := v.API.Request("utils.getLastShortenedLinks", params)
+	return v.GetLastShortenedLinksContext(context.Background(), params)
+}
+
+// GetLastShortenedLinksContext is GetLastShortenedLinks with context support
+func (v Utils) GetLastShortenedLinksContext(ctx context.Context, params UtilsGetLastShortenedLinksParams) (*UtilsGetLastShortenedLinksResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "utils.getLastShortenedLinks", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp UtilsGetLastShortenedLinksResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +140,7 @@ type UtilsGetLinkStatsResponse interface {
 }
 
 // UtilsGetLinkStatsResponseNormal is non-extended version of UtilsGetLinkStatsResponse
+//
 //easyjson:json
 type UtilsGetLinkStatsResponseNormal struct {
 	// Link key (characters after vk.cc/)
@@ -138,6 +156,7 @@ type UtilsGetLinkStatsResponseNormal struct {
 func (UtilsGetLinkStatsResponseNormal) isUtilsGetLinkStats() {}
 
 // UtilsGetLinkStatsResponseExtended is extended version of UtilsGetLinkStatsResponse
+//
 //easyjson:json
 type UtilsGetLinkStatsResponseExtended struct {
 	// Link key (characters after vk.cc/)
@@ -174,7 +193,12 @@ func (UtilsGetLinkStatsResponseExtended) isUtilsGetLinkStats() {}
 
 // GetLinkStats Returns stats data for shortened link.
 func (v Utils) GetLinkStats(params UtilsGetLinkStatsParams) (UtilsGetLinkStatsResponse, error) {
-	r, err := v.API.Request("utils.getLinkStats", params)
+	return v.GetLinkStatsContext(context.Background(), params)
+}
+
+// GetLinkStatsContext is GetLinkStats with context support
+func (v Utils) GetLinkStatsContext(ctx context.Context, params UtilsGetLinkStatsParams) (UtilsGetLinkStatsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "utils.getLinkStats", params)
 	if err != nil {
 		return nil, err
 	}
@@ -182,11 +206,11 @@ func (v Utils) GetLinkStats(params UtilsGetLinkStatsParams) (UtilsGetLinkStatsRe
 	var resp UtilsGetLinkStatsResponse
 	if params.Extended {
 		var tmp UtilsGetLinkStatsResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp UtilsGetLinkStatsResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -204,6 +228,7 @@ type UtilsGetShortLinkParams struct {
 }
 
 // UtilsGetShortLinkResponse is response for Utils.GetShortLink
+//
 //easyjson:json
 type UtilsGetShortLinkResponse struct {
 	// Short link URL
@@ -218,13 +243,18 @@ type UtilsGetShortLinkResponse struct {
 
 // GetShortLink Allows to receive a link shortened via vk.cc.
 func (v Utils) GetShortLink(params UtilsGetShortLinkParams) (*UtilsGetShortLinkResponse, error) {
-	r, err := v.API.Request("utils.getShortLink", params)
+	return v.GetShortLinkContext(context.Background(), params)
+}
+
+// GetShortLinkContext is GetShortLink with context support
+func (v Utils) GetShortLinkContext(ctx context.Context, params UtilsGetShortLinkParams) (*UtilsGetShortLinkResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "utils.getShortLink", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp UtilsGetShortLinkResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -238,6 +268,7 @@ type UtilsResolveScreenNameParams struct {
 }
 
 // UtilsResolveScreenNameResponse is response for Utils.ResolveScreenName
+//
 //easyjson:json
 type UtilsResolveScreenNameResponse struct {
 	// Object type
@@ -248,13 +279,18 @@ type UtilsResolveScreenNameResponse struct {
 
 // ResolveScreenName Detects a type of object (e.g., user, community, application) and its ID by screen name.
 func (v Utils) ResolveScreenName(params UtilsResolveScreenNameParams) (*UtilsResolveScreenNameResponse, error) {
-	r, err := v.API.Request("utils.resolveScreenName", params)
+	return v.ResolveScreenNameContext(context.Background(), params)
+}
+
+// ResolveScreenNameContext is ResolveScreenName with context support
+func (v Utils) ResolveScreenNameContext(ctx context.Context, params UtilsResolveScreenNameParams) (*UtilsResolveScreenNameResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "utils.resolveScreenName", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp UtilsResolveScreenNameResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -267,7 +303,12 @@ type UtilsGetServerTimeResponse int
 
 // GetServerTime Returns the current time of the VK server.
 func (v Utils) GetServerTime() (UtilsGetServerTimeResponse, error) {
-	r, err := v.API.Request("utils.getServerTime", nil)
+	return v.GetServerTimeContext(context.Background())
+}
+
+// GetServerTimeContext is GetServerTime with context support
+func (v Utils) GetServerTimeContext(ctx context.Context) (UtilsGetServerTimeResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "utils.getServerTime", nil)
 	if err != nil {
 		return 0, err
 	}
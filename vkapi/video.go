@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 	"strconv"
 
 	"github.com/stek29/vk"
@@ -35,6 +35,7 @@ type VideoGetResponse interface {
 }
 
 // VideoGetResponseNormal is non-extended version of VideoGetResponse
+//
 //easyjson:json
 type VideoGetResponseNormal struct {
 	// Total number
@@ -45,6 +46,7 @@ type VideoGetResponseNormal struct {
 func (VideoGetResponseNormal) isVideoGet() {}
 
 // VideoGetResponseExtended is extended version of VideoGetResponse
+//
 //easyjson:json
 type VideoGetResponseExtended struct {
 	// Total number
@@ -58,7 +60,12 @@ func (VideoGetResponseExtended) isVideoGet() {}
 
 // Get Returns detailed information about videos.
 func (v Video) Get(params VideoGetParams) (VideoGetResponse, error) {
-	r, err := v.API.Request("video.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Video) GetContext(ctx context.Context, params VideoGetParams) (VideoGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.get", params)
 	if err != nil {
 		return nil, err
 	}
@@ -66,11 +73,11 @@ func (v Video) Get(params VideoGetParams) (VideoGetResponse, error) {
 	var resp VideoGetResponse
 	if params.Extended {
 		var tmp VideoGetResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp VideoGetResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -101,7 +108,12 @@ type VideoEditParams struct {
 
 // Edit Edits information about a video on a user or community page.
 func (v Video) Edit(params VideoEditParams) (bool, error) {
-	r, err := v.API.Request("video.edit", params)
+	return v.EditContext(context.Background(), params)
+}
+
+// EditContext is Edit with context support
+func (v Video) EditContext(ctx context.Context, params VideoEditParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.edit", params)
 	if err != nil {
 		return false, err
 	}
@@ -121,7 +133,12 @@ type VideoAddParams struct {
 
 // Add Adds a video to a user or community page.
 func (v Video) Add(params VideoAddParams) (bool, error) {
-	r, err := v.API.Request("video.add", params)
+	return v.AddContext(context.Background(), params)
+}
+
+// AddContext is Add with context support
+func (v Video) AddContext(ctx context.Context, params VideoAddParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.add", params)
 	if err != nil {
 		return false, err
 	}
@@ -154,6 +171,7 @@ type VideoSaveParams struct {
 }
 
 // VideoSaveResponse is response for Video.Save
+//
 //easyjson:json
 type VideoSaveResponse struct {
 	// URL for the video uploading
@@ -170,13 +188,18 @@ type VideoSaveResponse struct {
 
 // Save Returns a server address (required for upload) and video data.
 func (v Video) Save(params VideoSaveParams) (*VideoSaveResponse, error) {
-	r, err := v.API.Request("video.save", params)
+	return v.SaveContext(context.Background(), params)
+}
+
+// SaveContext is Save with context support
+func (v Video) SaveContext(ctx context.Context, params VideoSaveParams) (*VideoSaveResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.save", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp VideoSaveResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -194,7 +217,12 @@ type VideoDeleteParams struct {
 
 // Delete Deletes a video from a user or community page.
 func (v Video) Delete(params VideoDeleteParams) (bool, error) {
-	r, err := v.API.Request("video.delete", params)
+	return v.DeleteContext(context.Background(), params)
+}
+
+// DeleteContext is Delete with context support
+func (v Video) DeleteContext(ctx context.Context, params VideoDeleteParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.delete", params)
 	if err != nil {
 		return false, err
 	}
@@ -212,7 +240,12 @@ type VideoRestoreParams struct {
 
 // Restore Restores a previously deleted video.
 func (v Video) Restore(params VideoRestoreParams) (bool, error) {
-	r, err := v.API.Request("video.restore", params)
+	return v.RestoreContext(context.Background(), params)
+}
+
+// RestoreContext is Restore with context support
+func (v Video) RestoreContext(ctx context.Context, params VideoRestoreParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.restore", params)
 	if err != nil {
 		return false, err
 	}
@@ -249,6 +282,7 @@ type VideoSearchResponse interface {
 }
 
 // VideoSearchResponseNormal is non-extended version of VideoSearchResponse
+//
 //easyjson:json
 type VideoSearchResponseNormal struct {
 	// Total number
@@ -259,6 +293,7 @@ type VideoSearchResponseNormal struct {
 func (VideoSearchResponseNormal) isVideoSearch() {}
 
 // VideoSearchResponseExtended is extended version of VideoSearchResponse
+//
 //easyjson:json
 type VideoSearchResponseExtended struct {
 	// Total number
@@ -272,7 +307,12 @@ func (VideoSearchResponseExtended) isVideoSearch() {}
 
 // Search Returns a list of videos under the set search criterion.
 func (v Video) Search(params VideoSearchParams) (VideoSearchResponse, error) {
-	r, err := v.API.Request("video.search", params)
+	return v.SearchContext(context.Background(), params)
+}
+
+// SearchContext is Search with context support
+func (v Video) SearchContext(ctx context.Context, params VideoSearchParams) (VideoSearchResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.search", params)
 	if err != nil {
 		return nil, err
 	}
@@ -280,11 +320,11 @@ func (v Video) Search(params VideoSearchParams) (VideoSearchResponse, error) {
 	var resp VideoSearchResponse
 	if params.Extended {
 		var tmp VideoSearchResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp VideoSearchResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -313,6 +353,7 @@ type VideoGetAlbumsResponse interface {
 }
 
 // VideoGetAlbumsResponseNormal is non-extended version of VideoGetAlbumsResponse
+//
 //easyjson:json
 type VideoGetAlbumsResponseNormal struct {
 	// Total number
@@ -323,6 +364,7 @@ type VideoGetAlbumsResponseNormal struct {
 func (VideoGetAlbumsResponseNormal) isVideoGetAlbums() {}
 
 // VideoGetAlbumsResponseExtended is extended version of VideoGetAlbumsResponse
+//
 //easyjson:json
 type VideoGetAlbumsResponseExtended struct {
 	// Total number
@@ -334,7 +376,12 @@ func (VideoGetAlbumsResponseExtended) isVideoGetAlbums() {}
 
 // GetAlbums Returns a list of video albums owned by a user or community.
 func (v Video) GetAlbums(params VideoGetAlbumsParams) (VideoGetAlbumsResponse, error) {
-	r, err := v.API.Request("video.getAlbums", params)
+	return v.GetAlbumsContext(context.Background(), params)
+}
+
+// GetAlbumsContext is GetAlbums with context support
+func (v Video) GetAlbumsContext(ctx context.Context, params VideoGetAlbumsParams) (VideoGetAlbumsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.getAlbums", params)
 	if err != nil {
 		return nil, err
 	}
@@ -342,11 +389,11 @@ func (v Video) GetAlbums(params VideoGetAlbumsParams) (VideoGetAlbumsResponse, e
 	var resp VideoGetAlbumsResponse
 	if params.Extended {
 		var tmp VideoGetAlbumsResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp VideoGetAlbumsResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -364,17 +411,23 @@ type VideoGetAlbumByIDParams struct {
 }
 
 // VideoGetAlbumByIDResponse is response for Video.GetAlbumByID
+//
 //easyjson:json
 type VideoGetAlbumByIDResponse genTODOType /* objects.json#/definitions/video_video_album_full */
 // GetAlbumByID Returns video album info
 func (v Video) GetAlbumByID(params VideoGetAlbumByIDParams) (*VideoGetAlbumByIDResponse, error) {
-	r, err := v.API.Request("video.getAlbumById", params)
+	return v.GetAlbumByIDContext(context.Background(), params)
+}
+
+// GetAlbumByIDContext is GetAlbumByID with context support
+func (v Video) GetAlbumByIDContext(ctx context.Context, params VideoGetAlbumByIDParams) (*VideoGetAlbumByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.getAlbumById", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp VideoGetAlbumByIDResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -392,6 +445,7 @@ type VideoAddAlbumParams struct {
 }
 
 // VideoAddAlbumResponse is response for Video.AddAlbum
+//
 //easyjson:json
 type VideoAddAlbumResponse struct {
 	// Created album ID
@@ -400,13 +454,18 @@ type VideoAddAlbumResponse struct {
 
 // AddAlbum Creates an empty album for videos.
 func (v Video) AddAlbum(params VideoAddAlbumParams) (*VideoAddAlbumResponse, error) {
-	r, err := v.API.Request("video.addAlbum", params)
+	return v.AddAlbumContext(context.Background(), params)
+}
+
+// AddAlbumContext is AddAlbum with context support
+func (v Video) AddAlbumContext(ctx context.Context, params VideoAddAlbumParams) (*VideoAddAlbumResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.addAlbum", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp VideoAddAlbumResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -427,7 +486,12 @@ type VideoEditAlbumParams struct {
 
 // EditAlbum Edits the title of a video album.
 func (v Video) EditAlbum(params VideoEditAlbumParams) (bool, error) {
-	r, err := v.API.Request("video.editAlbum", params)
+	return v.EditAlbumContext(context.Background(), params)
+}
+
+// EditAlbumContext is EditAlbum with context support
+func (v Video) EditAlbumContext(ctx context.Context, params VideoEditAlbumParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.editAlbum", params)
 	if err != nil {
 		return false, err
 	}
@@ -445,7 +509,12 @@ type VideoDeleteAlbumParams struct {
 
 // DeleteAlbum Deletes a video album.
 func (v Video) DeleteAlbum(params VideoDeleteAlbumParams) (bool, error) {
-	r, err := v.API.Request("video.deleteAlbum", params)
+	return v.DeleteAlbumContext(context.Background(), params)
+}
+
+// DeleteAlbumContext is DeleteAlbum with context support
+func (v Video) DeleteAlbumContext(ctx context.Context, params VideoDeleteAlbumParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.deleteAlbum", params)
 	if err != nil {
 		return false, err
 	}
@@ -467,7 +536,12 @@ type VideoReorderAlbumsParams struct {
 
 // ReorderAlbums Reorders the album in the list of user video albums.
 func (v Video) ReorderAlbums(params VideoReorderAlbumsParams) (bool, error) {
-	r, err := v.API.Request("video.reorderAlbums", params)
+	return v.ReorderAlbumsContext(context.Background(), params)
+}
+
+// ReorderAlbumsContext is ReorderAlbums with context support
+func (v Video) ReorderAlbumsContext(ctx context.Context, params VideoReorderAlbumsParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.reorderAlbums", params)
 	if err != nil {
 		return false, err
 	}
@@ -497,7 +571,12 @@ type VideoReorderVideosParams struct {
 
 // ReorderVideos Reorders the video in the video album.
 func (v Video) ReorderVideos(params VideoReorderVideosParams) (bool, error) {
-	r, err := v.API.Request("video.reorderVideos", params)
+	return v.ReorderVideosContext(context.Background(), params)
+}
+
+// ReorderVideosContext is ReorderVideos with context support
+func (v Video) ReorderVideosContext(ctx context.Context, params VideoReorderVideosParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.reorderVideos", params)
 	if err != nil {
 		return false, err
 	}
@@ -516,7 +595,12 @@ type VideoAddToAlbumParams struct {
 
 // AddToAlbum does video.addToAlbum
 func (v Video) AddToAlbum(params VideoAddToAlbumParams) (bool, error) {
-	r, err := v.API.Request("video.addToAlbum", params)
+	return v.AddToAlbumContext(context.Background(), params)
+}
+
+// AddToAlbumContext is AddToAlbum with context support
+func (v Video) AddToAlbumContext(ctx context.Context, params VideoAddToAlbumParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.addToAlbum", params)
 	if err != nil {
 		return false, err
 	}
@@ -535,7 +619,12 @@ type VideoRemoveFromAlbumParams struct {
 
 // RemoveFromAlbum does video.removeFromAlbum
 func (v Video) RemoveFromAlbum(params VideoRemoveFromAlbumParams) (bool, error) {
-	r, err := v.API.Request("video.removeFromAlbum", params)
+	return v.RemoveFromAlbumContext(context.Background(), params)
+}
+
+// RemoveFromAlbumContext is RemoveFromAlbum with context support
+func (v Video) RemoveFromAlbumContext(ctx context.Context, params VideoRemoveFromAlbumParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.removeFromAlbum", params)
 	if err != nil {
 		return false, err
 	}
@@ -559,12 +648,14 @@ type VideoGetAlbumsByVideoResponse interface {
 
 // VideoGetAlbumsByVideoResponseNormal is non-extended version of VideoGetAlbumsByVideoResponse
 // Album ID
+//
 //easyjson:json
 type VideoGetAlbumsByVideoResponseNormal []int
 
 func (VideoGetAlbumsByVideoResponseNormal) isVideoGetAlbumsByVideo() {}
 
 // VideoGetAlbumsByVideoResponseExtended is extended version of VideoGetAlbumsByVideoResponse
+//
 //easyjson:json
 type VideoGetAlbumsByVideoResponseExtended struct {
 	// Total number
@@ -576,7 +667,12 @@ func (VideoGetAlbumsByVideoResponseExtended) isVideoGetAlbumsByVideo() {}
 
 // GetAlbumsByVideo does video.getAlbumsByVideo
 func (v Video) GetAlbumsByVideo(params VideoGetAlbumsByVideoParams) (VideoGetAlbumsByVideoResponse, error) {
-	r, err := v.API.Request("video.getAlbumsByVideo", params)
+	return v.GetAlbumsByVideoContext(context.Background(), params)
+}
+
+// GetAlbumsByVideoContext is GetAlbumsByVideo with context support
+func (v Video) GetAlbumsByVideoContext(ctx context.Context, params VideoGetAlbumsByVideoParams) (VideoGetAlbumsByVideoResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.getAlbumsByVideo", params)
 	if err != nil {
 		return nil, err
 	}
@@ -584,11 +680,11 @@ func (v Video) GetAlbumsByVideo(params VideoGetAlbumsByVideoParams) (VideoGetAlb
 	var resp VideoGetAlbumsByVideoResponse
 	if params.Extended {
 		var tmp VideoGetAlbumsByVideoResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp VideoGetAlbumsByVideoResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -623,6 +719,7 @@ type VideoGetCommentsResponse interface {
 }
 
 // VideoGetCommentsResponseNormal is non-extended version of VideoGetCommentsResponse
+//
 //easyjson:json
 type VideoGetCommentsResponseNormal struct {
 	// Total number
@@ -635,6 +732,7 @@ type VideoGetCommentsResponseNormal struct {
 func (VideoGetCommentsResponseNormal) isVideoGetComments() {}
 
 // VideoGetCommentsResponseExtended is extended version of VideoGetCommentsResponse
+//
 //easyjson:json
 type VideoGetCommentsResponseExtended struct {
 	// Total number
@@ -646,7 +744,12 @@ func (VideoGetCommentsResponseExtended) isVideoGetComments() {}
 
 // GetComments Returns a list of comments on a video.
 func (v Video) GetComments(params VideoGetCommentsParams) (VideoGetCommentsResponse, error) {
-	r, err := v.API.Request("video.getComments", params)
+	return v.GetCommentsContext(context.Background(), params)
+}
+
+// GetCommentsContext is GetComments with context support
+func (v Video) GetCommentsContext(ctx context.Context, params VideoGetCommentsParams) (VideoGetCommentsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.getComments", params)
 	if err != nil {
 		return nil, err
 	}
@@ -654,11 +757,11 @@ func (v Video) GetComments(params VideoGetCommentsParams) (VideoGetCommentsRespo
 	var resp VideoGetCommentsResponse
 	if params.Extended {
 		var tmp VideoGetCommentsResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp VideoGetCommentsResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -690,7 +793,12 @@ type VideoCreateCommentResponse int
 
 // CreateComment Adds a new comment on a video.
 func (v Video) CreateComment(params VideoCreateCommentParams) (VideoCreateCommentResponse, error) {
-	r, err := v.API.Request("video.createComment", params)
+	return v.CreateCommentContext(context.Background(), params)
+}
+
+// CreateCommentContext is CreateComment with context support
+func (v Video) CreateCommentContext(ctx context.Context, params VideoCreateCommentParams) (VideoCreateCommentResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.createComment", params)
 	if err != nil {
 		return 0, err
 	}
@@ -717,7 +825,12 @@ type VideoDeleteCommentParams struct {
 
 // DeleteComment Deletes a comment on a video.
 func (v Video) DeleteComment(params VideoDeleteCommentParams) (bool, error) {
-	r, err := v.API.Request("video.deleteComment", params)
+	return v.DeleteCommentContext(context.Background(), params)
+}
+
+// DeleteCommentContext is DeleteComment with context support
+func (v Video) DeleteCommentContext(ctx context.Context, params VideoDeleteCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.deleteComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -735,7 +848,12 @@ type VideoRestoreCommentParams struct {
 
 // RestoreComment Restores a previously deleted comment on a video.
 func (v Video) RestoreComment(params VideoRestoreCommentParams) (bool, error) {
-	r, err := v.API.Request("video.restoreComment", params)
+	return v.RestoreCommentContext(context.Background(), params)
+}
+
+// RestoreCommentContext is RestoreComment with context support
+func (v Video) RestoreCommentContext(ctx context.Context, params VideoRestoreCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.restoreComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -757,7 +875,12 @@ type VideoEditCommentParams struct {
 
 // EditComment Edits the text of a comment on a video.
 func (v Video) EditComment(params VideoEditCommentParams) (bool, error) {
-	r, err := v.API.Request("video.editComment", params)
+	return v.EditCommentContext(context.Background(), params)
+}
+
+// EditCommentContext is EditComment with context support
+func (v Video) EditCommentContext(ctx context.Context, params VideoEditCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.editComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -781,7 +904,12 @@ type VideoReportParams struct {
 
 // Report Reports (submits a complaint about) a video.
 func (v Video) Report(params VideoReportParams) (bool, error) {
-	r, err := v.API.Request("video.report", params)
+	return v.ReportContext(context.Background(), params)
+}
+
+// ReportContext is Report with context support
+func (v Video) ReportContext(ctx context.Context, params VideoReportParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.report", params)
 	if err != nil {
 		return false, err
 	}
@@ -801,7 +929,12 @@ type VideoReportCommentParams struct {
 
 // ReportComment Reports (submits a complaint about) a comment on a video.
 func (v Video) ReportComment(params VideoReportCommentParams) (bool, error) {
-	r, err := v.API.Request("video.reportComment", params)
+	return v.ReportCommentContext(context.Background(), params)
+}
+
+// ReportCommentContext is ReportComment with context support
+func (v Video) ReportCommentContext(ctx context.Context, params VideoReportCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "video.reportComment", params)
 	if err != nil {
 		return false, err
 	}
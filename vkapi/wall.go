@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -35,6 +35,7 @@ type WallGetResponse interface {
 }
 
 // WallGetResponseNormal is non-extended version of WallGetResponse
+//
 //easyjson:json
 type WallGetResponseNormal struct {
 	// Total number
@@ -45,6 +46,7 @@ type WallGetResponseNormal struct {
 func (WallGetResponseNormal) isWallGet() {}
 
 // WallGetResponseExtended is extended version of WallGetResponse
+//
 //easyjson:json
 type WallGetResponseExtended struct {
 	// Total number
@@ -58,7 +60,12 @@ func (WallGetResponseExtended) isWallGet() {}
 
 // Get Returns a list of posts on a user wall or community wall.
 func (v Wall) Get(params WallGetParams) (WallGetResponse, error) {
-	r, err := v.API.Request("wall.get", params)
+	return v.GetContext(context.Background(), params)
+}
+
+// GetContext is Get with context support
+func (v Wall) GetContext(ctx context.Context, params WallGetParams) (WallGetResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.get", params)
 	if err != nil {
 		return nil, err
 	}
@@ -66,11 +73,11 @@ func (v Wall) Get(params WallGetParams) (WallGetResponse, error) {
 	var resp WallGetResponse
 	if params.Extended {
 		var tmp WallGetResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp WallGetResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -105,6 +112,7 @@ type WallSearchResponse interface {
 }
 
 // WallSearchResponseNormal is non-extended version of WallSearchResponse
+//
 //easyjson:json
 type WallSearchResponseNormal struct {
 	// Total number
@@ -115,6 +123,7 @@ type WallSearchResponseNormal struct {
 func (WallSearchResponseNormal) isWallSearch() {}
 
 // WallSearchResponseExtended is extended version of WallSearchResponse
+//
 //easyjson:json
 type WallSearchResponseExtended struct {
 	// Total number
@@ -128,7 +137,12 @@ func (WallSearchResponseExtended) isWallSearch() {}
 
 // Search Allows to search posts on user or community walls.
 func (v Wall) Search(params WallSearchParams) (WallSearchResponse, error) {
-	r, err := v.API.Request("wall.search", params)
+	return v.SearchContext(context.Background(), params)
+}
+
+// SearchContext is Search with context support
+func (v Wall) SearchContext(ctx context.Context, params WallSearchParams) (WallSearchResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.search", params)
 	if err != nil {
 		return nil, err
 	}
@@ -136,11 +150,11 @@ func (v Wall) Search(params WallSearchParams) (WallSearchResponse, error) {
 	var resp WallSearchResponse
 	if params.Extended {
 		var tmp WallSearchResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp WallSearchResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -167,12 +181,14 @@ type WallGetByIDResponse interface {
 }
 
 // WallGetByIDResponseNormal is non-extended version of WallGetByIDResponse
+//
 //easyjson:json
 type WallGetByIDResponseNormal []vk.Post
 
 func (WallGetByIDResponseNormal) isWallGetByID() {}
 
 // WallGetByIDResponseExtended is extended version of WallGetByIDResponse
+//
 //easyjson:json
 type WallGetByIDResponseExtended struct {
 	Items    []vk.Post  `json:"items,omitempty"`
@@ -184,7 +200,12 @@ func (WallGetByIDResponseExtended) isWallGetByID() {}
 
 // GetByID Returns a list of posts from user or community walls by their IDs.
 func (v Wall) GetByID(params WallGetByIDParams) (WallGetByIDResponse, error) {
-	r, err := v.API.Request("wall.getById", params)
+	return v.GetByIDContext(context.Background(), params)
+}
+
+// GetByIDContext is GetByID with context support
+func (v Wall) GetByIDContext(ctx context.Context, params WallGetByIDParams) (WallGetByIDResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.getById", params)
 	if err != nil {
 		return nil, err
 	}
@@ -192,11 +213,11 @@ func (v Wall) GetByID(params WallGetByIDParams) (WallGetByIDResponse, error) {
 	var resp WallGetByIDResponse
 	if params.Extended {
 		var tmp WallGetByIDResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp WallGetByIDResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -237,6 +258,7 @@ type WallPostParams struct {
 }
 
 // WallPostResponse is response for Wall.Post
+//
 //easyjson:json
 type WallPostResponse struct {
 	// Created post ID
@@ -245,13 +267,18 @@ type WallPostResponse struct {
 
 // Post Adds a new post on a user wall or community wall. Can also be used to publish suggested or scheduled posts.
 func (v Wall) Post(params WallPostParams) (*WallPostResponse, error) {
-	r, err := v.API.Request("wall.post", params)
+	return v.PostContext(context.Background(), params)
+}
+
+// PostContext is Post with context support
+func (v Wall) PostContext(ctx context.Context, params WallPostParams) (*WallPostResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.post", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp WallPostResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -285,6 +312,7 @@ type WallPostAdsStealthParams struct {
 }
 
 // WallPostAdsStealthResponse is response for Wall.PostAdsStealth
+//
 //easyjson:json
 type WallPostAdsStealthResponse struct {
 	// Created post ID
@@ -293,13 +321,18 @@ type WallPostAdsStealthResponse struct {
 
 // PostAdsStealth Allows to create hidden post which will not be shown on the community's wall and can be used for creating an ad with type "Community post".
 func (v Wall) PostAdsStealth(params WallPostAdsStealthParams) (*WallPostAdsStealthResponse, error) {
-	r, err := v.API.Request("wall.postAdsStealth", params)
+	return v.PostAdsStealthContext(context.Background(), params)
+}
+
+// PostAdsStealthContext is PostAdsStealth with context support
+func (v Wall) PostAdsStealthContext(ctx context.Context, params WallPostAdsStealthParams) (*WallPostAdsStealthResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.postAdsStealth", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp WallPostAdsStealthResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -318,6 +351,7 @@ type WallRepostParams struct {
 }
 
 // WallRepostResponse is response for Wall.Repost
+//
 //easyjson:json
 type WallRepostResponse struct {
 	Success vk.BoolInt `json:"success,omitempty"`
@@ -331,13 +365,18 @@ type WallRepostResponse struct {
 
 // Repost Reposts (copies) an object to a user wall or community wall.
 func (v Wall) Repost(params WallRepostParams) (*WallRepostResponse, error) {
-	r, err := v.API.Request("wall.repost", params)
+	return v.RepostContext(context.Background(), params)
+}
+
+// RepostContext is Repost with context support
+func (v Wall) RepostContext(ctx context.Context, params WallRepostParams) (*WallRepostResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.repost", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp WallRepostResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -357,6 +396,7 @@ type WallGetRepostsParams struct {
 }
 
 // WallGetRepostsResponse is response for Wall.GetReposts
+//
 //easyjson:json
 type WallGetRepostsResponse struct {
 	Items    []vk.Post  `json:"items,omitempty"`
@@ -366,13 +406,18 @@ type WallGetRepostsResponse struct {
 
 // GetReposts Returns information about reposts of a post on user wall or community wall.
 func (v Wall) GetReposts(params WallGetRepostsParams) (*WallGetRepostsResponse, error) {
-	r, err := v.API.Request("wall.getReposts", params)
+	return v.GetRepostsContext(context.Background(), params)
+}
+
+// GetRepostsContext is GetReposts with context support
+func (v Wall) GetRepostsContext(ctx context.Context, params WallGetRepostsParams) (*WallGetRepostsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.getReposts", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp WallGetRepostsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -402,7 +447,12 @@ type WallEditParams struct {
 
 // Edit Edits a post on a user wall or community wall.
 func (v Wall) Edit(params WallEditParams) (bool, error) {
-	r, err := v.API.Request("wall.edit", params)
+	return v.EditContext(context.Background(), params)
+}
+
+// EditContext is Edit with context support
+func (v Wall) EditContext(ctx context.Context, params WallEditParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.edit", params)
 	if err != nil {
 		return false, err
 	}
@@ -438,7 +488,12 @@ type WallEditAdsStealthParams struct {
 
 // EditAdsStealth Allows to edit hidden post.
 func (v Wall) EditAdsStealth(params WallEditAdsStealthParams) (bool, error) {
-	r, err := v.API.Request("wall.editAdsStealth", params)
+	return v.EditAdsStealthContext(context.Background(), params)
+}
+
+// EditAdsStealthContext is EditAdsStealth with context support
+func (v Wall) EditAdsStealthContext(ctx context.Context, params WallEditAdsStealthParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.editAdsStealth", params)
 	if err != nil {
 		return false, err
 	}
@@ -456,7 +511,12 @@ type WallDeleteParams struct {
 
 // Delete Deletes a post from a user wall or community wall.
 func (v Wall) Delete(params WallDeleteParams) (bool, error) {
-	r, err := v.API.Request("wall.delete", params)
+	return v.DeleteContext(context.Background(), params)
+}
+
+// DeleteContext is Delete with context support
+func (v Wall) DeleteContext(ctx context.Context, params WallDeleteParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.delete", params)
 	if err != nil {
 		return false, err
 	}
@@ -474,7 +534,12 @@ type WallRestoreParams struct {
 
 // Restore Restores a post deleted from a user wall or community wall.
 func (v Wall) Restore(params WallRestoreParams) (bool, error) {
-	r, err := v.API.Request("wall.restore", params)
+	return v.RestoreContext(context.Background(), params)
+}
+
+// RestoreContext is Restore with context support
+func (v Wall) RestoreContext(ctx context.Context, params WallRestoreParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.restore", params)
 	if err != nil {
 		return false, err
 	}
@@ -492,7 +557,12 @@ type WallPinParams struct {
 
 // Pin Pins the post on wall.
 func (v Wall) Pin(params WallPinParams) (bool, error) {
-	r, err := v.API.Request("wall.pin", params)
+	return v.PinContext(context.Background(), params)
+}
+
+// PinContext is Pin with context support
+func (v Wall) PinContext(ctx context.Context, params WallPinParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.pin", params)
 	if err != nil {
 		return false, err
 	}
@@ -510,7 +580,12 @@ type WallUnpinParams struct {
 
 // Unpin Unpins the post on wall.
 func (v Wall) Unpin(params WallUnpinParams) (bool, error) {
-	r, err := v.API.Request("wall.unpin", params)
+	return v.UnpinContext(context.Background(), params)
+}
+
+// UnpinContext is Unpin with context support
+func (v Wall) UnpinContext(ctx context.Context, params WallUnpinParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.unpin", params)
 	if err != nil {
 		return false, err
 	}
@@ -550,6 +625,7 @@ type WallGetCommentsResponse interface {
 }
 
 // WallGetCommentsResponseNormal is non-extended version of WallGetCommentsResponse
+//
 //easyjson:json
 type WallGetCommentsResponseNormal struct {
 	// Total number
@@ -566,6 +642,7 @@ type WallGetCommentsResponseNormal struct {
 func (WallGetCommentsResponseNormal) isWallGetComments() {}
 
 // WallGetCommentsResponseExtended is extended version of WallGetCommentsResponse
+//
 //easyjson:json
 type WallGetCommentsResponseExtended struct {
 	// Total number
@@ -585,7 +662,12 @@ func (WallGetCommentsResponseExtended) isWallGetComments() {}
 
 // GetComments Returns a list of comments on a post on a user wall or community wall.
 func (v Wall) GetComments(params WallGetCommentsParams) (WallGetCommentsResponse, error) {
-	r, err := v.API.Request("wall.getComments", params)
+	return v.GetCommentsContext(context.Background(), params)
+}
+
+// GetCommentsContext is GetComments with context support
+func (v Wall) GetCommentsContext(ctx context.Context, params WallGetCommentsParams) (WallGetCommentsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.getComments", params)
 	if err != nil {
 		return nil, err
 	}
@@ -593,11 +675,11 @@ func (v Wall) GetComments(params WallGetCommentsParams) (WallGetCommentsResponse
 	var resp WallGetCommentsResponse
 	if params.Extended {
 		var tmp WallGetCommentsResponseExtended
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	} else {
 		var tmp WallGetCommentsResponseNormal
-		err = json.Unmarshal(r, &tmp)
+		err = vk.Unmarshal(r, &tmp)
 		resp = &tmp
 	}
 	if err != nil {
@@ -627,6 +709,7 @@ type WallCreateCommentParams struct {
 }
 
 // WallCreateCommentResponse is response for Wall.CreateComment
+//
 //easyjson:json
 type WallCreateCommentResponse struct {
 	// Created comment ID
@@ -635,13 +718,18 @@ type WallCreateCommentResponse struct {
 
 // CreateComment Adds a comment to a post on a user wall or community wall.
 func (v Wall) CreateComment(params WallCreateCommentParams) (*WallCreateCommentResponse, error) {
-	r, err := v.API.Request("wall.createComment", params)
+	return v.CreateCommentContext(context.Background(), params)
+}
+
+// CreateCommentContext is CreateComment with context support
+func (v Wall) CreateCommentContext(ctx context.Context, params WallCreateCommentParams) (*WallCreateCommentResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.createComment", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp WallCreateCommentResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -662,7 +750,12 @@ type WallEditCommentParams struct {
 
 // EditComment Edits a comment on a user wall or community wall.
 func (v Wall) EditComment(params WallEditCommentParams) (bool, error) {
-	r, err := v.API.Request("wall.editComment", params)
+	return v.EditCommentContext(context.Background(), params)
+}
+
+// EditCommentContext is EditComment with context support
+func (v Wall) EditCommentContext(ctx context.Context, params WallEditCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.editComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -680,7 +773,12 @@ type WallDeleteCommentParams struct {
 
 // DeleteComment Deletes a comment on a post on a user wall or community wall.
 func (v Wall) DeleteComment(params WallDeleteCommentParams) (bool, error) {
-	r, err := v.API.Request("wall.deleteComment", params)
+	return v.DeleteCommentContext(context.Background(), params)
+}
+
+// DeleteCommentContext is DeleteComment with context support
+func (v Wall) DeleteCommentContext(ctx context.Context, params WallDeleteCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.deleteComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -698,7 +796,12 @@ type WallRestoreCommentParams struct {
 
 // RestoreComment Restores a comment deleted from a user wall or community wall.
 func (v Wall) RestoreComment(params WallRestoreCommentParams) (bool, error) {
-	r, err := v.API.Request("wall.restoreComment", params)
+	return v.RestoreCommentContext(context.Background(), params)
+}
+
+// RestoreCommentContext is RestoreComment with context support
+func (v Wall) RestoreCommentContext(ctx context.Context, params WallRestoreCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.restoreComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -718,7 +821,12 @@ type WallReportPostParams struct {
 
 // ReportPost Reports (submits a complaint about) a post on a user wall or community wall.
 func (v Wall) ReportPost(params WallReportPostParams) (bool, error) {
-	r, err := v.API.Request("wall.reportPost", params)
+	return v.ReportPostContext(context.Background(), params)
+}
+
+// ReportPostContext is ReportPost with context support
+func (v Wall) ReportPostContext(ctx context.Context, params WallReportPostParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.reportPost", params)
 	if err != nil {
 		return false, err
 	}
@@ -738,7 +846,12 @@ type WallReportCommentParams struct {
 
 // ReportComment Reports (submits a complaint about) a comment on a post on a user wall or community wall.
 func (v Wall) ReportComment(params WallReportCommentParams) (bool, error) {
-	r, err := v.API.Request("wall.reportComment", params)
+	return v.ReportCommentContext(context.Background(), params)
+}
+
+// ReportCommentContext is ReportComment with context support
+func (v Wall) ReportCommentContext(ctx context.Context, params WallReportCommentParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.reportComment", params)
 	if err != nil {
 		return false, err
 	}
@@ -754,7 +867,12 @@ type WallCloseCommentsParams struct {
 
 // CloseComments does wall.closeComments
 func (v Wall) CloseComments(params WallCloseCommentsParams) (bool, error) {
-	r, err := v.API.Request("wall.closeComments", params)
+	return v.CloseCommentsContext(context.Background(), params)
+}
+
+// CloseCommentsContext is CloseComments with context support
+func (v Wall) CloseCommentsContext(ctx context.Context, params WallCloseCommentsParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.closeComments", params)
 	if err != nil {
 		return false, err
 	}
@@ -770,7 +888,12 @@ type WallOpenCommentsParams struct {
 
 // OpenComments does wall.openComments
 func (v Wall) OpenComments(params WallOpenCommentsParams) (bool, error) {
-	r, err := v.API.Request("wall.openComments", params)
+	return v.OpenCommentsContext(context.Background(), params)
+}
+
+// OpenCommentsContext is OpenComments with context support
+func (v Wall) OpenCommentsContext(ctx context.Context, params WallOpenCommentsParams) (bool, error) {
+	r, err := vk.RequestContext(ctx, v.API, "wall.openComments", params)
 	if err != nil {
 		return false, err
 	}
@@ -1,7 +1,7 @@
 package vkapi
 
 import (
-	"encoding/json"
+	"context"
 
 	"github.com/stek29/vk"
 )
@@ -23,6 +23,7 @@ type WidgetsGetCommentsParams struct {
 }
 
 // WidgetsGetCommentsResponse is response for Widgets.GetComments
+//
 //easyjson:json
 type WidgetsGetCommentsResponse struct {
 	// Total number
@@ -32,13 +33,18 @@ type WidgetsGetCommentsResponse struct {
 
 // GetComments Gets a list of comments for the page added through the [vk.com/dev/Comments|Comments widget].
 func (v Widgets) GetComments(params WidgetsGetCommentsParams) (*WidgetsGetCommentsResponse, error) {
-	r, err := v.API.Request("widgets.getComments", params)
+	return v.GetCommentsContext(context.Background(), params)
+}
+
+// GetCommentsContext is GetComments with context support
+func (v Widgets) GetCommentsContext(ctx context.Context, params WidgetsGetCommentsParams) (*WidgetsGetCommentsResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "widgets.getComments", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp WidgetsGetCommentsResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -55,6 +61,7 @@ type WidgetsGetPagesParams struct {
 }
 
 // WidgetsGetPagesResponse is response for Widgets.GetPages
+//
 //easyjson:json
 type WidgetsGetPagesResponse struct {
 	// Total number
@@ -64,13 +71,18 @@ type WidgetsGetPagesResponse struct {
 
 // GetPages Gets a list of application/site pages where the [vk.com/dev/Comments|Comments widget] or [vk.com/dev/Like|Like widget] is installed.
 func (v Widgets) GetPages(params WidgetsGetPagesParams) (*WidgetsGetPagesResponse, error) {
-	r, err := v.API.Request("widgets.getPages", params)
+	return v.GetPagesContext(context.Background(), params)
+}
+
+// GetPagesContext is GetPages with context support
+func (v Widgets) GetPagesContext(ctx context.Context, params WidgetsGetPagesParams) (*WidgetsGetPagesResponse, error) {
+	r, err := vk.RequestContext(ctx, v.API, "widgets.getPages", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp WidgetsGetPagesResponse
-	err = json.Unmarshal(r, &resp)
+	err = vk.Unmarshal(r, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,370 @@
+// Package vkauth implements obtaining access tokens with VK OAuth
+//
+// Usage:
+//
+//	c, _ := vkauth.NewClient(vkauth.ClientConfig{
+//		ClientID:     appID,
+//		ClientSecret: appSecret,
+//		Scope:        vk.ScopeMessages | vk.ScopeOffline,
+//	})
+//	token, _ := c.AuthorizeCode(ctx, func(url string) error {
+//		fmt.Println("Open in browser:", url)
+//		return nil
+//	})
+//	api, _ := vk.NewBaseAPI(vk.BaseAPIConfig{AccessToken: token.AccessToken})
+package vkauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stek29/vk"
+)
+
+const (
+	// DefaultOAuthURL is URL of VK OAuth server
+	DefaultOAuthURL = "https://oauth.vk.com"
+	// DefaultRedirectURI is redirect URI used by standalone applications
+	DefaultRedirectURI = "https://oauth.vk.com/blank.html"
+)
+
+// ClientConfig represents configuration used for Client creation
+type ClientConfig struct {
+	// Required: ID of application
+	ClientID int
+	// Optional: secret key of application, required by
+	// authorization code flow and client credentials flow
+	ClientSecret string
+	// Optional: if empty, DefaultRedirectURI is used
+	RedirectURI string
+	// Optional: permissions requested from user
+	Scope vk.Scope
+	// Optional: display type of authorization page, i.e. "page" or "mobile"
+	Display string
+	// Optional: if empty, DefaultOAuthURL is used
+	OAuthURL string
+	// Optional: if empty, vk.APIVersion is used
+	Version string
+	// Optional: if nil, http.DefaultClient is used
+	Client *http.Client
+	// Optional: address loopback Receiver listens on in AuthorizeCode
+	// and AuthorizeImplicit, if empty, random port on 127.0.0.1 is used
+	ListenAddr string
+}
+
+// Client obtains access tokens for one application
+type Client struct {
+	clientID     int
+	clientSecret string
+	redirectURI  string
+	scope        vk.Scope
+	display      string
+	oauthURL     string
+	version      string
+	client       *http.Client
+	listenAddr   string
+}
+
+// NewClient creates a new Client
+func NewClient(cfg ClientConfig) (*Client, error) {
+	if cfg.ClientID == 0 {
+		return nil, errors.New("ClientID is required")
+	}
+
+	redirectURI := cfg.RedirectURI
+	if redirectURI == "" {
+		redirectURI = DefaultRedirectURI
+	}
+
+	oauthURL := cfg.OAuthURL
+	if oauthURL == "" {
+		oauthURL = DefaultOAuthURL
+	}
+
+	version := cfg.Version
+	if version == "" {
+		version = vk.APIVersion
+	}
+
+	client := cfg.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &Client{
+		clientID:     cfg.ClientID,
+		clientSecret: cfg.ClientSecret,
+		redirectURI:  redirectURI,
+		scope:        cfg.Scope,
+		display:      cfg.Display,
+		oauthURL:     strings.TrimSuffix(oauthURL, "/"),
+		version:      version,
+		client:       client,
+		listenAddr:   cfg.ListenAddr,
+	}, nil
+}
+
+// Token is access token obtained from VK OAuth
+type Token struct {
+	AccessToken string
+	// ExpiresIn is lifetime of token, 0 if token doesn't expire
+	ExpiresIn time.Duration
+	// ExpiresAt is zero if token doesn't expire
+	ExpiresAt time.Time
+	// UserID is ID of user who has authorized application,
+	// it's 0 for service tokens
+	UserID int
+	// Email is set if vk.ScopeEmail was requested
+	Email string
+	// Scope is permissions granted to token, VK doesn't return them
+	// in most flows, so it's Scope requested by Client in that case
+	Scope vk.Scope
+}
+
+// BaseAPIConfig returns vk.BaseAPIConfig which uses token
+func (t *Token) BaseAPIConfig() vk.BaseAPIConfig {
+	return vk.BaseAPIConfig{AccessToken: t.AccessToken}
+}
+
+// tokenResponse is response of access_token endpoint,
+// and also fields of implicit flow redirect
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+	UserID      int    `json:"user_id"`
+	Email       string `json:"email"`
+
+	Scope *int `json:"scope"`
+
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	ErrorType        string `json:"error_type"`
+
+	// set for need_validation
+	ValidationType string `json:"validation_type"`
+	ValidationSID  string `json:"validation_sid"`
+	PhoneMask      string `json:"phone_mask"`
+	RedirectURI    string `json:"redirect_uri"`
+
+	// set for need_captcha
+	CaptchaSID string `json:"captcha_sid"`
+	CaptchaImg string `json:"captcha_img"`
+}
+
+func (r tokenResponse) err() error {
+	if r.Error == "" {
+		return nil
+	}
+
+	e := &Error{
+		Code:        r.Error,
+		Description: r.ErrorDescription,
+		Type:        r.ErrorType,
+	}
+
+	if r.ValidationType != "" || r.ValidationSID != "" {
+		e.Validation = &Validation{
+			Type:        r.ValidationType,
+			SID:         r.ValidationSID,
+			PhoneMask:   r.PhoneMask,
+			RedirectURI: r.RedirectURI,
+		}
+	}
+
+	if r.CaptchaSID != "" {
+		e.Captcha = &vk.Captcha{
+			SID: r.CaptchaSID,
+			Img: r.CaptchaImg,
+		}
+	}
+
+	return e
+}
+
+func (r tokenResponse) token() (*Token, error) {
+	if err := r.err(); err != nil {
+		return nil, err
+	}
+
+	if r.AccessToken == "" {
+		return nil, errors.New("vkauth: VK did not return access token")
+	}
+
+	t := &Token{
+		AccessToken: r.AccessToken,
+		UserID:      r.UserID,
+		Email:       r.Email,
+	}
+
+	if r.ExpiresIn != 0 {
+		t.ExpiresIn = time.Duration(r.ExpiresIn) * time.Second
+		t.ExpiresAt = time.Now().Add(t.ExpiresIn)
+	}
+
+	if r.Scope != nil {
+		t.Scope = vk.Scope(*r.Scope)
+	}
+
+	return t, nil
+}
+
+// Error is error returned by VK OAuth, i.e. "invalid_grant"
+type Error struct {
+	Code        string
+	Description string
+	// Type is additional error type, i.e. "wrong_otp"
+	Type string
+
+	// Validation is set if Code is "need_validation"
+	Validation *Validation
+	// Captcha is set if Code is "need_captcha"
+	Captcha *vk.Captcha
+}
+
+// Error implements error interface
+func (e *Error) Error() string {
+	if e.Description == "" {
+		return fmt.Sprintf("vkauth.Error: %s", e.Code)
+	}
+	return fmt.Sprintf("vkauth.Error %s: %s", e.Code, e.Description)
+}
+
+func (c *Client) authorizeURL(responseType, redirectURI, state string) string {
+	q := url.Values{}
+	q.Set("client_id", strconv.Itoa(c.clientID))
+	q.Set("redirect_uri", redirectURI)
+	q.Set("response_type", responseType)
+	q.Set("v", c.version)
+	if c.scope != 0 {
+		q.Set("scope", strconv.Itoa(int(c.scope)))
+	}
+	if c.display != "" {
+		q.Set("display", c.display)
+	}
+	if state != "" {
+		q.Set("state", state)
+	}
+
+	return c.oauthURL + "/authorize?" + q.Encode()
+}
+
+// ImplicitURL returns URL of authorization page for implicit flow
+//
+// After authorization user is redirected to RedirectURI
+// with token in fragment, see ParseFragment
+func (c *Client) ImplicitURL(state string) string {
+	return c.authorizeURL("token", c.redirectURI, state)
+}
+
+// CodeURL returns URL of authorization page for authorization code flow
+//
+// After authorization user is redirected to RedirectURI
+// with code in query, which should be passed to Exchange
+func (c *Client) CodeURL(state string) string {
+	return c.authorizeURL("code", c.redirectURI, state)
+}
+
+// ParseFragment parses token from fragment of URL user
+// was redirected to in implicit flow
+func ParseFragment(fragment string) (*Token, error) {
+	q, err := url.ParseQuery(strings.TrimPrefix(fragment, "#"))
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenFromValues(q)
+}
+
+func tokenFromValues(q url.Values) (*Token, error) {
+	resp := tokenResponse{
+		AccessToken:      q.Get("access_token"),
+		Email:            q.Get("email"),
+		Error:            q.Get("error"),
+		ErrorDescription: q.Get("error_description"),
+	}
+	resp.ExpiresIn, _ = strconv.Atoi(q.Get("expires_in"))
+	resp.UserID, _ = strconv.Atoi(q.Get("user_id"))
+
+	return resp.token()
+}
+
+// requestToken requests token from endpoint, i.e. "/access_token"
+func (c *Client) requestToken(ctx context.Context, endpoint string, q url.Values) (*Token, error) {
+	if c.clientSecret == "" {
+		return nil, errors.New("vkauth: ClientSecret is required")
+	}
+
+	q.Set("client_id", strconv.Itoa(c.clientID))
+	q.Set("client_secret", c.clientSecret)
+	q.Set("v", c.version)
+
+	// secrets are sent in body, since URL ends up in errors and logs
+	req, err := http.NewRequest("POST", c.oauthURL+endpoint, strings.NewReader(q.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	r, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	var resp tokenResponse
+
+	// errors are returned with 401 status
+	if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
+		if r.StatusCode != http.StatusOK {
+			return nil, &vk.HTTPError{
+				StatusCode: r.StatusCode,
+				Status:     r.Status,
+			}
+		}
+		return nil, err
+	}
+
+	token, err := resp.token()
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Scope == nil {
+		token.Scope = c.scope
+	}
+
+	return token, nil
+}
+
+// Exchange exchanges code obtained in authorization code flow for token
+func (c *Client) Exchange(ctx context.Context, code string) (*Token, error) {
+	return c.exchange(ctx, code, c.redirectURI)
+}
+
+func (c *Client) exchange(ctx context.Context, code, redirectURI string) (*Token, error) {
+	return c.requestToken(ctx, "/access_token", url.Values{
+		"code":         {code},
+		"redirect_uri": {redirectURI},
+	})
+}
+
+// ClientCredentials obtains service token of application
+func (c *Client) ClientCredentials(ctx context.Context) (*Token, error) {
+	token, err := c.requestToken(ctx, "/access_token", url.Values{
+		"grant_type": {"client_credentials"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// service tokens don't have user permissions
+	token.Scope = 0
+	return token, nil
+}
@@ -0,0 +1,291 @@
+package vkauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stek29/vk"
+)
+
+// newTestClient creates Client which uses fake OAuth server,
+// which authorizes everyone and issues token for code "good-code"
+func newTestClient(t *testing.T) *Client {
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		redirect, _ := url.Parse(q.Get("redirect_uri"))
+		rq := url.Values{"state": {q.Get("state")}}
+		if q.Get("response_type") == "code" {
+			rq.Set("code", "good-code")
+		} else {
+			rq.Set("access_token", "implicit-token")
+			rq.Set("user_id", "1")
+		}
+		redirect.RawQuery = rq.Encode()
+
+		http.Redirect(w, r, redirect.String(), http.StatusFound)
+	})
+
+	mux.HandleFunc("/access_token", func(w http.ResponseWriter, r *http.Request) {
+		q := postForm(r)
+
+		if q.Get("client_id") != "42" || q.Get("client_secret") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+			return
+		}
+
+		if q.Get("grant_type") == "client_credentials" {
+			json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "service-token", "expires_in": 0})
+			return
+		}
+
+		if q.Get("code") != "good-code" {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{
+				"error":             "invalid_grant",
+				"error_description": "Code is invalid or expired.",
+			})
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "user-token", "expires_in": 86400, "user_id": 1})
+	})
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		q := postForm(r)
+
+		switch {
+		case q.Get("username") != "user" || q.Get("password") != "pass":
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+		case q.Get("captcha_key") == "" && q.Get("code") == "":
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"error": "need_captcha", "captcha_sid": "sid", "captcha_img": "img"})
+		case q.Get("code") == "":
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{
+				"error":           "need_validation",
+				"validation_type": ValidationApp,
+				"validation_sid":  "vsid",
+				"phone_mask":      "+7 *** *** ** 12",
+			})
+		case q.Get("code") != "123456":
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_request", "error_type": "wrong_otp"})
+		default:
+			json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "direct-token", "expires_in": 0, "user_id": 1})
+		}
+	})
+
+	c, err := NewClient(ClientConfig{
+		ClientID:     42,
+		ClientSecret: "secret",
+		Scope:        vk.ScopeMessages | vk.ScopeOffline,
+		OAuthURL:     srv.URL,
+	})
+	if err != nil {
+		t.Fatalf("Cant create Client: %v", err)
+	}
+
+	return c
+}
+
+// postForm returns form sent in body of POST request,
+// params in query are ignored so secrets can't leak there
+func postForm(r *http.Request) url.Values {
+	if r.Method != "POST" || r.ParseForm() != nil {
+		return url.Values{}
+	}
+	return r.PostForm
+}
+
+// openInBrowser follows redirects like browser would
+func openInBrowser(authURL string) error {
+	r, err := http.Get(authURL)
+	if err != nil {
+		return err
+	}
+	return r.Body.Close()
+}
+
+func TestCodeURL(t *testing.T) {
+	c := newTestClient(t)
+
+	u, err := url.Parse(c.CodeURL("xyz"))
+	if err != nil {
+		t.Fatalf("Invalid URL: %v", err)
+	}
+
+	q := u.Query()
+	if q.Get("response_type") != "code" || q.Get("scope") != "69632" || q.Get("state") != "xyz" ||
+		q.Get("redirect_uri") != DefaultRedirectURI || q.Get("client_id") != "42" {
+		t.Errorf("Unexpected URL: %v", u)
+	}
+}
+
+func TestAuthorizeCode(t *testing.T) {
+	c := newTestClient(t)
+
+	token, err := c.AuthorizeCode(context.Background(), openInBrowser)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if token.AccessToken != "user-token" || token.UserID != 1 || token.ExpiresAt.IsZero() {
+		t.Errorf("Unexpected token: %+v", token)
+	}
+}
+
+func TestAuthorizeImplicit(t *testing.T) {
+	c := newTestClient(t)
+
+	token, err := c.AuthorizeImplicit(context.Background(), openInBrowser)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if token.AccessToken != "implicit-token" || !token.ExpiresAt.IsZero() {
+		t.Errorf("Unexpected token: %+v", token)
+	}
+}
+
+func TestExchangeError(t *testing.T) {
+	c := newTestClient(t)
+
+	_, err := c.Exchange(context.Background(), "bad-code")
+
+	var authErr *Error
+	if !errors.As(err, &authErr) || authErr.Code != "invalid_grant" {
+		t.Errorf("Expected invalid_grant, got %v", err)
+	}
+}
+
+func TestClientCredentials(t *testing.T) {
+	c := newTestClient(t)
+
+	token, err := c.ClientCredentials(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if token.AccessToken != "service-token" || token.UserID != 0 {
+		t.Errorf("Unexpected token: %+v", token)
+	}
+}
+
+func TestParseFragment(t *testing.T) {
+	token, err := ParseFragment("#access_token=abc&expires_in=0&user_id=5")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if token.AccessToken != "abc" || token.UserID != 5 {
+		t.Errorf("Unexpected token: %+v", token)
+	}
+
+	_, err = ParseFragment("error=access_denied&error_description=User+denied+your+request")
+	var authErr *Error
+	if !errors.As(err, &authErr) || authErr.Code != "access_denied" {
+		t.Errorf("Expected access_denied, got %v", err)
+	}
+}
+
+func TestPassword(t *testing.T) {
+	c := newTestClient(t)
+
+	codes := []string{"000000", "123456"}
+	var validations []Validation
+
+	token, err := c.Password(context.Background(), PasswordCredentials{
+		Username: "user",
+		Password: "pass",
+		CaptchaSolver: vk.CaptchaSolverFunc(func(ctx context.Context, captcha vk.Captcha) (string, error) {
+			if captcha.SID != "sid" {
+				t.Errorf("Unexpected captcha: %+v", captcha)
+			}
+			return "key", nil
+		}),
+		Validate: func(ctx context.Context, v Validation) (string, error) {
+			validations = append(validations, v)
+			code := codes[0]
+			codes = codes[1:]
+			return code, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// wrong code is requested again with the same validation
+	if len(validations) != 2 || validations[1].Type != ValidationApp || validations[1].PhoneMask != "+7 *** *** ** 12" {
+		t.Errorf("Unexpected validations: %+v", validations)
+	}
+
+	if token.AccessToken != "direct-token" || token.UserID != 1 || token.Scope != vk.ScopeMessages|vk.ScopeOffline {
+		t.Errorf("Unexpected token: %+v", token)
+	}
+
+	if cfg := token.BaseAPIConfig(); cfg.AccessToken != "direct-token" {
+		t.Errorf("Unexpected BaseAPIConfig: %+v", cfg)
+	}
+}
+
+func TestPasswordNeedValidation(t *testing.T) {
+	c := newTestClient(t)
+
+	_, err := c.Password(context.Background(), PasswordCredentials{
+		Username: "user",
+		Password: "pass",
+		CaptchaSolver: vk.CaptchaSolverFunc(func(ctx context.Context, captcha vk.Captcha) (string, error) {
+			return "key", nil
+		}),
+	})
+
+	var authErr *Error
+	if !errors.As(err, &authErr) || authErr.Validation == nil || authErr.Validation.SID != "vsid" {
+		t.Errorf("Expected need_validation, got %v", err)
+	}
+}
+
+// failingTransport fails every request
+type failingTransport struct{}
+
+func (failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestRequestTokenErrorHidesSecrets(t *testing.T) {
+	c, err := NewClient(ClientConfig{
+		ClientID:     42,
+		ClientSecret: "very-secret",
+		Client:       &http.Client{Transport: failingTransport{}},
+	})
+	if err != nil {
+		t.Fatalf("Cant create Client: %v", err)
+	}
+
+	_, err = c.Password(context.Background(), PasswordCredentials{
+		Username: "user",
+		Password: "hunter2",
+	})
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+
+	for _, secret := range []string{"very-secret", "hunter2"} {
+		if strings.Contains(err.Error(), secret) {
+			t.Errorf("Error contains %q: %v", secret, err)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package vkauth
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/stek29/vk"
+)
+
+// maxDirectAttempts is maximal number of requests made by Password
+const maxDirectAttempts = 5
+
+// Validation types sent by VK with need_validation error
+const (
+	ValidationSMS = "2fa_sms"
+	ValidationApp = "2fa_app"
+)
+
+// Validation is two-factor authentication challenge
+type Validation struct {
+	// Type is either ValidationSMS or ValidationApp
+	Type string
+	SID  string
+	// PhoneMask is masked phone number code was sent to,
+	// i.e. "+7 *** *** ** 12"
+	PhoneMask string
+	// RedirectURI is page which can be used to validate
+	// in browser instead
+	RedirectURI string
+}
+
+// PasswordCredentials represents credentials and callbacks used by Password
+type PasswordCredentials struct {
+	// Required
+	Username string
+	// Required
+	Password string
+	// Optional: called when VK asks for two-factor authentication code,
+	// if nil, need_validation is returned as *Error
+	Validate func(ctx context.Context, v Validation) (string, error)
+	// Optional: if true, code is sent with SMS instead of authenticator app
+	ForceSMS bool
+	// Optional: if nil, need_captcha is returned as *Error
+	CaptchaSolver vk.CaptchaSolver
+}
+
+// Password obtains user token with direct authorization,
+// which is only available to trusted applications
+//
+// Two-factor authentication codes are requested with creds.Validate
+// (and requested again if code was wrong), captchas are
+// solved with creds.CaptchaSolver
+func (c *Client) Password(ctx context.Context, creds PasswordCredentials) (*Token, error) {
+	if creds.Username == "" || creds.Password == "" {
+		return nil, errors.New("vkauth: Username and Password are required")
+	}
+
+	q := url.Values{
+		"grant_type":    {"password"},
+		"username":      {creds.Username},
+		"password":      {creds.Password},
+		"2fa_supported": {"1"},
+	}
+	if c.scope != 0 {
+		q.Set("scope", strconv.Itoa(int(c.scope)))
+	}
+	if creds.ForceSMS {
+		q.Set("force_sms", "1")
+	}
+
+	var (
+		lastErr    error
+		validation *Validation
+	)
+
+	for attempt := 0; attempt < maxDirectAttempts; attempt++ {
+		token, err := c.requestToken(ctx, "/token", q)
+		if err == nil {
+			return token, nil
+		}
+		lastErr = err
+
+		var authErr *Error
+		if !errors.As(err, &authErr) {
+			return nil, err
+		}
+
+		q.Del("captcha_sid")
+		q.Del("captcha_key")
+
+		switch {
+		case authErr.Captcha != nil && creds.CaptchaSolver != nil:
+			key, err := creds.CaptchaSolver.SolveCaptcha(ctx, *authErr.Captcha)
+			if err != nil {
+				return nil, err
+			}
+
+			q.Set("captcha_sid", authErr.Captcha.SID)
+			q.Set("captcha_key", key)
+
+		case authErr.Validation != nil && creds.Validate != nil,
+			authErr.Type == "wrong_otp" && validation != nil:
+			// VK doesn't repeat validation details if code was wrong
+			if authErr.Validation != nil {
+				validation = authErr.Validation
+			}
+
+			code, err := creds.Validate(ctx, *validation)
+			if err != nil {
+				return nil, err
+			}
+
+			q.Set("code", code)
+
+		default:
+			return nil, err
+		}
+	}
+
+	return nil, lastErr
+}
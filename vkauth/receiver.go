@@ -0,0 +1,181 @@
+package vkauth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+)
+
+// receiverPath is path of Receiver's redirect URI
+const receiverPath = "/callback"
+
+// fragmentPage moves fragment to query, since fragment
+// of implicit flow redirect is not sent to server
+const fragmentPage = `<!DOCTYPE html>
+<html><body><script>
+if (location.hash.length > 1) {
+	location.replace(location.pathname + "?" + location.hash.substring(1));
+} else {
+	document.body.textContent = "Authorization has failed";
+}
+</script></body></html>
+`
+
+const donePage = `<!DOCTYPE html>
+<html><body>Authorization has finished, you can close this page</body></html>
+`
+
+// Receiver is a loopback HTTP server which captures result
+// of authorization when user is redirected to it
+type Receiver struct {
+	listener net.Listener
+	srv      *http.Server
+	results  chan url.Values
+}
+
+// NewReceiver starts a new Receiver listening on addr,
+// if addr is empty, random port on 127.0.0.1 is used
+func NewReceiver(addr string) (*Receiver, error) {
+	if addr == "" {
+		addr = "127.0.0.1:0"
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Receiver{
+		listener: listener,
+		results:  make(chan url.Values, 1),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(receiverPath, r.handle)
+	r.srv = &http.Server{Handler: mux}
+
+	go r.srv.Serve(listener)
+
+	return r, nil
+}
+
+// RedirectURI returns URI user should be redirected to after authorization
+func (r *Receiver) RedirectURI() string {
+	return "http://" + r.listener.Addr().String() + receiverPath
+}
+
+func (r *Receiver) handle(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+
+	if q.Get("code") == "" && q.Get("access_token") == "" && q.Get("error") == "" {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(fragmentPage))
+		return
+	}
+
+	select {
+	case r.results <- q:
+	default:
+		// result was already received
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(donePage))
+}
+
+// Wait waits for user to be redirected to Receiver, and returns
+// query params of redirect (fragment params for implicit flow)
+func (r *Receiver) Wait(ctx context.Context) (url.Values, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case q := <-r.results:
+		return q, nil
+	}
+}
+
+// Close stops Receiver
+func (r *Receiver) Close() error {
+	return r.srv.Close()
+}
+
+// ErrStateMismatch is returned when state of redirect doesn't
+// match one passed to authorization page
+var ErrStateMismatch = errors.New("vkauth: state mismatch")
+
+func newState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// authorize opens authorization page with open and waits
+// for redirect to loopback Receiver
+func (c *Client) authorize(ctx context.Context, responseType string, open func(authURL string) error) (url.Values, string, error) {
+	state, err := newState()
+	if err != nil {
+		return nil, "", err
+	}
+
+	r, err := NewReceiver(c.listenAddr)
+	if err != nil {
+		return nil, "", err
+	}
+	defer r.Close()
+
+	redirectURI := r.RedirectURI()
+
+	if err := open(c.authorizeURL(responseType, redirectURI, state)); err != nil {
+		return nil, "", err
+	}
+
+	q, err := r.Wait(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if q.Get("error") == "" && q.Get("state") != state {
+		return nil, "", ErrStateMismatch
+	}
+
+	return q, redirectURI, nil
+}
+
+// AuthorizeCode runs authorization code flow using loopback Receiver
+// as redirect URI, open should show authorization page to user
+// (i.e. print it or open it in browser)
+func (c *Client) AuthorizeCode(ctx context.Context, open func(authURL string) error) (*Token, error) {
+	q, redirectURI, err := c.authorize(ctx, "code", open)
+	if err != nil {
+		return nil, err
+	}
+
+	if q.Get("error") != "" {
+		return tokenFromValues(q)
+	}
+
+	return c.exchange(ctx, q.Get("code"), redirectURI)
+}
+
+// AuthorizeImplicit runs implicit flow using loopback Receiver
+// as redirect URI, see AuthorizeCode
+func (c *Client) AuthorizeImplicit(ctx context.Context, open func(authURL string) error) (*Token, error) {
+	q, _, err := c.authorize(ctx, "token", open)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := tokenFromValues(q)
+	if err != nil {
+		return nil, err
+	}
+
+	token.Scope = c.scope
+	return token, nil
+}
@@ -2,8 +2,11 @@ package vkbot
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/stek29/vk"
 	"github.com/stek29/vk/vkapi"
@@ -15,16 +18,25 @@ type BotConfig struct {
 	Poller Poller
 	// GroupID this bot is running as -- optional if group access token is used
 	GroupID int
+	// Optional: notified about requests made by Poller,
+	// i.e. long poll requests and received callbacks
+	Observer vk.Observer
+	// Optional: used to trace requests made by Poller
+	Tracer vk.Tracer
+	// Optional: time after which HandleMessageEvent answers event
+	// without action if handler hasn't finished yet, if 0, 50 seconds is used
+	MessageEventTimeout time.Duration
 }
 
 // Bot represents VK Bot instance
 //
-// Conforms to vk.API interface and can be used in vkapi
+// Conforms to vk.ContextAPI interface and can be used in vkapi
 type Bot struct {
 	vk.API
 	BotConfig
 
-	me *vk.Group
+	meMu sync.Mutex
+	me   *vk.Group
 }
 
 // NewBot tries to instantiate a bot which uses baseAPI for API requests
@@ -41,12 +53,22 @@ func NewBot(baseAPI vk.API, cfg BotConfig) (*Bot, error) {
 	return b, nil
 }
 
+// RequestContext conforms to vk.ContextAPI interface
+func (b *Bot) RequestContext(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	return vk.RequestContext(ctx, b.API, method, params)
+}
+
+// TokenType conforms to vk.TokenTyper interface, bots use community tokens
+func (b *Bot) TokenType() vk.TokenType {
+	return vk.GroupToken
+}
+
 // GetMe returns VK Group this bot is running as
 //
 // Result is cached, pass flush=true to force new request
 func (b *Bot) GetMe(flush bool) (*vk.Group, error) {
-	if b.me != nil && !flush {
-		return b.me, nil
+	if me := b.cachedMe(); me != nil && !flush {
+		return me, nil
 	}
 
 	groups, err := vkapi.Groups{API: b}.GetByID(vkapi.GroupsGetByIDParams{
@@ -61,10 +83,22 @@ func (b *Bot) GetMe(flush bool) (*vk.Group, error) {
 		return nil, errors.New("VK did not return group we needed")
 	}
 
-	b.me = &groups[0]
-	b.GroupID = b.me.ID
+	me := &groups[0]
+
+	b.meMu.Lock()
+	b.me = me
+	b.GroupID = me.ID
+	b.meMu.Unlock()
+
+	return me, nil
+}
+
+// cachedMe returns group cached by GetMe, or nil if there's none
+func (b *Bot) cachedMe() *vk.Group {
+	b.meMu.Lock()
+	defer b.meMu.Unlock()
 
-	return b.me, nil
+	return b.me
 }
 
 // StartPolling starts polling for events in background
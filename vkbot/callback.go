@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"log"
 	"net/http"
+	"sync"
 
 	"github.com/stek29/vk"
 )
@@ -29,12 +30,63 @@ type CallbackPoller struct {
 	// XXX: use map[int] instead of slice?
 	GroupConfigs []CallbackGroupConfig
 
+	mu   sync.Mutex
 	dest chan<- vk.CallbackEvent
 	ctx  context.Context
+	bot  *Bot
+}
+
+// callbackMethod is pseudo-method name used to observe received callbacks
+const callbackMethod = "callback"
+
+// observedResponseWriter records status and size of response
+type observedResponseWriter struct {
+	http.ResponseWriter
+	o *vk.RequestObservation
+}
+
+func (w *observedResponseWriter) WriteHeader(statusCode int) {
+	if w.o.Info.StatusCode == 0 {
+		w.o.Info.StatusCode = statusCode
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *observedResponseWriter) Write(p []byte) (int, error) {
+	if w.o.Info.StatusCode == 0 {
+		w.o.Info.StatusCode = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(p)
+	w.o.Info.ResponseSize += int64(n)
+	return n, err
 }
 
 // ServeHTTP confroms to http.Handler interface
 func (p *CallbackPoller) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	b := p.bot
+	p.mu.Unlock()
+
+	if b == nil || (b.Observer == nil && b.Tracer == nil) {
+		p.serve(w, r)
+		return
+	}
+
+	ctx, o := vk.StartRequestObservation(r.Context(), b.Observer, b.Tracer, callbackMethod)
+	if r.ContentLength > 0 {
+		o.Info.RequestSize = r.ContentLength
+	}
+
+	ow := &observedResponseWriter{ResponseWriter: w, o: o}
+	p.serve(ow, r.WithContext(ctx))
+
+	if o.Info.StatusCode == 0 {
+		o.Info.StatusCode = http.StatusOK
+	}
+	o.Finish(ctx, nil)
+}
+
+func (p *CallbackPoller) serve(w http.ResponseWriter, r *http.Request) {
 	event := vk.CallbackEvent{}
 	dec := json.NewDecoder(r.Body)
 	if err := dec.Decode(&event); err != nil {
@@ -65,11 +117,22 @@ func (p *CallbackPoller) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	p.mu.Lock()
+	ctx, dest := p.ctx, p.dest
+	p.mu.Unlock()
+
+	if dest == nil {
+		// VK will retry sending the event later
+		log.Printf("Poll is not running, dropping event for Group %v", event.GroupID)
+		http.Error(w, "not ready", http.StatusServiceUnavailable)
+		return
+	}
+
 	go func() {
 		select {
-		case <-p.ctx.Done():
+		case <-ctx.Done():
 			log.Printf("Warning: Event would be lost because it was not processed before ctx.Done, but ok was already sent to VK")
-		case p.dest <- event:
+		case dest <- event:
 		}
 	}()
 
@@ -78,8 +141,11 @@ func (p *CallbackPoller) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 // Poll conforms to Poller interface
 func (p *CallbackPoller) Poll(ctx context.Context, b *Bot, dest chan<- vk.CallbackEvent) {
+	p.mu.Lock()
 	p.ctx = ctx
 	p.dest = dest
+	p.bot = b
+	p.mu.Unlock()
 
 	if p.Listen == "" {
 		<-ctx.Done()
@@ -0,0 +1,637 @@
+package vkbot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/stek29/vk"
+	"github.com/stek29/vk/vkapi"
+)
+
+// ArgType is type of command argument
+type ArgType int
+
+// Possible ArgType values
+const (
+	// ArgString is a single word
+	ArgString ArgType = iota
+	// ArgInt is an integer
+	ArgInt
+	// ArgFloat is a floating point number
+	ArgFloat
+	// ArgBool is one of true/false, yes/no, on/off, 1/0
+	ArgBool
+	// ArgUser is ID of user or community (negative), which can be written
+	// as mention ("[id1|Name]", "[club1|Name]"), "id1", "club1" or just ID
+	ArgUser
+	// ArgText is the rest of message, it should be the last argument
+	ArgText
+)
+
+// Arg describes argument of Command
+type Arg struct {
+	Name string
+	Type ArgType
+	// Optional arguments may be omitted, they can be followed
+	// only by other optional arguments
+	Optional bool
+}
+
+// Args are parsed arguments of command by their names
+//
+// Values are string for ArgString and ArgText, int for ArgInt and ArgUser,
+// float64 for ArgFloat and bool for ArgBool.
+// Omitted optional arguments are absent, and getters return zero values for them.
+type Args map[string]interface{}
+
+// Has checks if argument is present
+func (a Args) Has(name string) bool {
+	_, ok := a[name]
+	return ok
+}
+
+// String returns value of ArgString or ArgText argument
+func (a Args) String(name string) string {
+	v, _ := a[name].(string)
+	return v
+}
+
+// Int returns value of ArgInt or ArgUser argument
+func (a Args) Int(name string) int {
+	v, _ := a[name].(int)
+	return v
+}
+
+// Float returns value of ArgFloat argument
+func (a Args) Float(name string) float64 {
+	v, _ := a[name].(float64)
+	return v
+}
+
+// Bool returns value of ArgBool argument
+func (a Args) Bool(name string) bool {
+	v, _ := a[name].(bool)
+	return v
+}
+
+// CommandRequest describes message handled by CommandHandler
+type CommandRequest struct {
+	Message *vk.MessageNew
+	// Command is nil if message is matched by pattern
+	Command *Command
+	// Name is name or alias command was called with
+	Name string
+	// Args are parsed arguments of command
+	Args Args
+	// Matches are submatches of pattern, see regexp.Regexp.FindStringSubmatch
+	Matches []string
+	// FromPayload is true if command was sent by keyboard button,
+	// its arguments are taken from "args" field of payload,
+	// i.e. {"command":"ban","args":{"user":1}}
+	FromPayload bool
+}
+
+// CommandHandler handles command
+type CommandHandler func(ctx context.Context, req *CommandRequest) error
+
+// Command is text command handled by Commands
+type Command struct {
+	// Name of command, without prefix, matched case insensitively
+	Name string
+	// Optional: alternative names of command
+	Aliases []string
+	// Optional: arguments of command, parsed before Handler is called
+	Args []Arg
+	// Optional: description shown in help
+	Description string
+	// Optional: hidden commands aren't shown in help
+	Hidden  bool
+	Handler CommandHandler
+}
+
+// Usage returns usage of command, i.e. "/ban <user> [reason]"
+func (cmd *Command) Usage(prefix string) string {
+	var sb strings.Builder
+	sb.WriteString(prefix)
+	sb.WriteString(cmd.Name)
+
+	for _, arg := range cmd.Args {
+		if arg.Optional {
+			fmt.Fprintf(&sb, " [%v]", arg.Name)
+		} else {
+			fmt.Fprintf(&sb, " <%v>", arg.Name)
+		}
+	}
+
+	return sb.String()
+}
+
+func (cmd *Command) validate() error {
+	if cmd.Name == "" {
+		return errors.New("name is required")
+	}
+
+	if cmd.Handler == nil {
+		return errors.New("handler is required")
+	}
+
+	optional := false
+	for i, arg := range cmd.Args {
+		if arg.Type == ArgText && i != len(cmd.Args)-1 {
+			return fmt.Errorf("text argument %q isn't the last one", arg.Name)
+		}
+
+		if optional && !arg.Optional {
+			return fmt.Errorf("required argument %q follows optional one", arg.Name)
+		}
+		optional = arg.Optional
+	}
+
+	return nil
+}
+
+// UsageError is returned when command is called with invalid arguments
+type UsageError struct {
+	Command *Command
+	// Usage of command, see Command.Usage
+	Usage string
+	Err   error
+}
+
+func (e *UsageError) Error() string {
+	return fmt.Sprintf("%v\nUsage: %v", e.Err, e.Usage)
+}
+
+func (e *UsageError) Unwrap() error {
+	return e.Err
+}
+
+// CommandsConfig represents configuration used for Commands creation
+type CommandsConfig struct {
+	// Optional: prefixes commands start with, if empty, "/" is used
+	//
+	// Commands mentioning bot in group chats ("[club1|@bot] help")
+	// don't need a prefix.
+	Prefixes []string
+	// Optional: name in commands like "/help@name", messages with
+	// other names are ignored, if empty, screen name of group is used
+	BotName string
+	// Optional: name of command which replies with Help, if empty,
+	// help command isn't added
+	HelpCommand string
+	// Optional: called when handler fails or arguments are invalid,
+	// by default, UsageError is sent as reply, and other errors are ignored
+	OnError func(ctx context.Context, msg *vk.MessageNew, err error)
+}
+
+type pattern struct {
+	re      *regexp.Regexp
+	handler CommandHandler
+}
+
+// Commands dispatches messages to handlers of text commands
+//
+// Message is matched in following order:
+// command in payload of keyboard button ({"command":"start"}),
+// command in text ("/help", "/help@bot", "[club1|@bot] help"),
+// patterns in order they were added.
+// Messages matching nothing are passed to Fallback handler, if any.
+//
+// Commands and patterns should be added before messages are handled.
+//
+// Usage:
+//
+//	commands := vkbot.NewCommands(bot, vkbot.CommandsConfig{HelpCommand: "help"})
+//	commands.Add(vkbot.Command{
+//		Name:        "roll",
+//		Aliases:     []string{"r"},
+//		Args:        []vkbot.Arg{{Name: "sides", Type: vkbot.ArgInt, Optional: true}},
+//		Description: "Roll a dice",
+//		Handler:     roll,
+//	})
+//	router.OnMessageNew(commands.Handle)
+type Commands struct {
+	bot *Bot
+	cfg CommandsConfig
+
+	commands []*Command
+	byName   map[string]*Command
+	patterns []pattern
+	fallback func(ctx context.Context, msg *vk.MessageNew)
+}
+
+// NewCommands creates a new Commands for messages received by bot
+func NewCommands(bot *Bot, cfg CommandsConfig) *Commands {
+	if len(cfg.Prefixes) == 0 {
+		cfg.Prefixes = []string{"/"}
+	}
+
+	if me := bot.cachedMe(); cfg.BotName == "" && me != nil {
+		cfg.BotName = me.ScreenName
+	}
+
+	if cfg.BotName == "" {
+		cfg.BotName = "club" + strconv.Itoa(bot.GroupID)
+	}
+
+	c := &Commands{
+		bot:    bot,
+		cfg:    cfg,
+		byName: make(map[string]*Command),
+	}
+
+	if cfg.HelpCommand != "" {
+		c.Add(Command{
+			Name:        cfg.HelpCommand,
+			Description: "Show list of commands",
+			Handler: func(ctx context.Context, req *CommandRequest) error {
+				return c.reply(ctx, req.Message, c.Help())
+			},
+		})
+	}
+
+	return c
+}
+
+// Add registers command
+//
+// Error is returned if command is invalid,
+// or its name or alias is already registered
+func (c *Commands) Add(cmd Command) error {
+	if err := cmd.validate(); err != nil {
+		return fmt.Errorf("vkbot: command %q: %w", cmd.Name, err)
+	}
+
+	names := append([]string{cmd.Name}, cmd.Aliases...)
+	for _, name := range names {
+		if _, ok := c.byName[strings.ToLower(name)]; ok {
+			return fmt.Errorf("vkbot: command %q is already registered", name)
+		}
+	}
+
+	c.commands = append(c.commands, &cmd)
+	for _, name := range names {
+		c.byName[strings.ToLower(name)] = &cmd
+	}
+
+	return nil
+}
+
+// Pattern registers handler for messages with text matching re
+//
+// Mention of bot is removed from text before it's matched
+func (c *Commands) Pattern(re *regexp.Regexp, handler CommandHandler) {
+	c.patterns = append(c.patterns, pattern{re, handler})
+}
+
+// Fallback registers handler for messages which aren't commands
+func (c *Commands) Fallback(handler func(ctx context.Context, msg *vk.MessageNew)) {
+	c.fallback = handler
+}
+
+// Help returns list of commands with their usage and descriptions
+func (c *Commands) Help() string {
+	var sb strings.Builder
+	prefix := c.cfg.Prefixes[0]
+
+	for _, cmd := range c.commands {
+		if cmd.Hidden {
+			continue
+		}
+
+		if sb.Len() != 0 {
+			sb.WriteByte('\n')
+		}
+
+		sb.WriteString(cmd.Usage(prefix))
+
+		if len(cmd.Aliases) != 0 {
+			sb.WriteString(" (")
+			for i, alias := range cmd.Aliases {
+				if i != 0 {
+					sb.WriteString(", ")
+				}
+				sb.WriteString(prefix + alias)
+			}
+			sb.WriteString(")")
+		}
+
+		if cmd.Description != "" {
+			sb.WriteString(" — ")
+			sb.WriteString(cmd.Description)
+		}
+	}
+
+	return sb.String()
+}
+
+// Handle passes msg to handler of command it contains,
+// it can be registered with Router.OnMessageNew
+func (c *Commands) Handle(ctx context.Context, msg *vk.MessageNew) {
+	req, raw, handler := c.match(msg)
+	if handler == nil {
+		if c.fallback != nil {
+			c.fallback(ctx, msg)
+		}
+		return
+	}
+
+	var err error
+	if req.Command != nil {
+		req.Args, err = raw.parse(req.Command.Args)
+		if err != nil {
+			err = &UsageError{
+				Command: req.Command,
+				Usage:   req.Command.Usage(c.cfg.Prefixes[0]),
+				Err:     err,
+			}
+		}
+	}
+
+	if err == nil {
+		err = handler(ctx, req)
+	}
+
+	if err == nil {
+		return
+	}
+
+	if c.cfg.OnError != nil {
+		c.cfg.OnError(ctx, msg, err)
+		return
+	}
+
+	var usageErr *UsageError
+	if errors.As(err, &usageErr) {
+		c.reply(ctx, msg, usageErr.Error())
+	}
+}
+
+var mentionRegexp = regexp.MustCompile(`^\[(?:club|public)(\d+)\|[^\]]*\][\s,:]*`)
+
+// rawArgs are unparsed arguments of command,
+// either text or args of button payload
+type rawArgs struct {
+	text    string
+	payload json.RawMessage
+}
+
+func (r rawArgs) parse(args []Arg) (Args, error) {
+	if r.payload != nil {
+		return parsePayloadArgs(args, r.payload)
+	}
+	return parseArgs(args, r.text)
+}
+
+// match finds handler of msg and unparsed arguments of its command
+func (c *Commands) match(msg *vk.MessageNew) (req *CommandRequest, raw rawArgs, handler CommandHandler) {
+	req = &CommandRequest{Message: msg}
+
+	if msg.Payload != "" {
+		var payload struct {
+			Command string          `json:"command"`
+			Args    json.RawMessage `json:"args"`
+		}
+
+		if json.Unmarshal([]byte(msg.Payload), &payload) == nil {
+			if cmd, ok := c.byName[strings.ToLower(payload.Command)]; ok {
+				req.Command = cmd
+				req.Name = payload.Command
+				req.FromPayload = true
+				return req, rawArgs{payload: payloadArgsOrNull(payload.Args)}, cmd.Handler
+			}
+		}
+	}
+
+	text := strings.TrimSpace(msg.Text)
+
+	mentioned := false
+	if m := mentionRegexp.FindStringSubmatch(text); m != nil {
+		if m[1] != strconv.Itoa(c.bot.GroupID) {
+			// message is addressed to another bot
+			return req, rawArgs{}, nil
+		}
+
+		text = text[len(m[0]):]
+		mentioned = true
+	}
+
+	if cmd, name, rest, ok := c.matchCommand(text, mentioned); ok {
+		req.Command = cmd
+		req.Name = name
+		return req, rawArgs{text: rest}, cmd.Handler
+	}
+
+	for _, p := range c.patterns {
+		if m := p.re.FindStringSubmatch(text); m != nil {
+			req.Matches = m
+			return req, rawArgs{}, p.handler
+		}
+	}
+
+	return req, rawArgs{}, nil
+}
+
+func (c *Commands) matchCommand(text string, mentioned bool) (cmd *Command, name, rest string, ok bool) {
+	prefixed := false
+	for _, prefix := range c.cfg.Prefixes {
+		if strings.HasPrefix(text, prefix) {
+			text = text[len(prefix):]
+			prefixed = true
+			break
+		}
+	}
+
+	if !prefixed && !mentioned {
+		return nil, "", "", false
+	}
+
+	name, rest = nextWord(text)
+
+	if i := strings.IndexByte(name, '@'); i != -1 {
+		if !strings.EqualFold(name[i+1:], c.cfg.BotName) {
+			return nil, "", "", false
+		}
+		name = name[:i]
+	}
+
+	cmd, ok = c.byName[strings.ToLower(name)]
+	return cmd, name, rest, ok
+}
+
+// nextWord splits s into first word and the rest
+func nextWord(s string) (word, rest string) {
+	s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	if i := strings.IndexFunc(s, unicode.IsSpace); i != -1 {
+		return s[:i], s[i:]
+	}
+	return s, ""
+}
+
+// payloadArgsOrNull returns raw, or JSON null if payload has no args
+func payloadArgsOrNull(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 {
+		return json.RawMessage("null")
+	}
+	return raw
+}
+
+// parsePayloadArgs parses args of button payload
+//
+// raw can be either a string ("id1 spam"), which is parsed like
+// arguments of text command, or an object with arguments
+// by their names ({"user":1,"reason":"spam"}), which are bound as is
+func parsePayloadArgs(args []Arg, raw json.RawMessage) (Args, error) {
+	var text string
+	if json.Unmarshal(raw, &text) == nil {
+		return parseArgs(args, text)
+	}
+
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, errors.New("args of payload should be a string or an object")
+	}
+
+	parsed := make(Args, len(args))
+	for _, arg := range args {
+		v, ok := values[arg.Name]
+		delete(values, arg.Name)
+
+		if !ok || string(v) == "null" {
+			if arg.Optional {
+				continue
+			}
+			return nil, fmt.Errorf("missing argument %v", arg.Name)
+		}
+
+		value, err := parsePayloadArg(arg.Type, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument %v: %w", arg.Name, err)
+		}
+		parsed[arg.Name] = value
+	}
+
+	if len(values) != 0 {
+		extra := make([]string, 0, len(values))
+		for name := range values {
+			extra = append(extra, name)
+		}
+		sort.Strings(extra)
+		return nil, fmt.Errorf("unexpected argument %q", extra[0])
+	}
+
+	return parsed, nil
+}
+
+// parsePayloadArg parses JSON value of argument: strings are taken
+// as is, numbers and booleans are parsed like words of text command
+func parsePayloadArg(typ ArgType, raw json.RawMessage) (interface{}, error) {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return parseArg(typ, s)
+	}
+
+	switch raw[0] {
+	case '{', '[':
+		return nil, fmt.Errorf("%s is not a single value", raw)
+	}
+	return parseArg(typ, string(raw))
+}
+
+var userRegexp = regexp.MustCompile(`^(?:\[(id|club|public)(\d+)\|[^\]]*\]|(id|club|public)?(-?\d+))$`)
+
+func parseArgs(args []Arg, text string) (Args, error) {
+	parsed := make(Args, len(args))
+	rest := text
+
+	for _, arg := range args {
+		var word string
+		if arg.Type == ArgText {
+			word, rest = strings.TrimSpace(rest), ""
+		} else {
+			word, rest = nextWord(rest)
+		}
+
+		if word == "" {
+			if arg.Optional {
+				break
+			}
+			return nil, fmt.Errorf("missing argument %v", arg.Name)
+		}
+
+		v, err := parseArg(arg.Type, word)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument %v: %w", arg.Name, err)
+		}
+		parsed[arg.Name] = v
+	}
+
+	if extra, _ := nextWord(rest); extra != "" {
+		return nil, fmt.Errorf("unexpected argument %q", extra)
+	}
+
+	return parsed, nil
+}
+
+func parseArg(typ ArgType, s string) (interface{}, error) {
+	switch typ {
+	case ArgString, ArgText:
+		return s, nil
+	case ArgInt:
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", s)
+		}
+		return v, nil
+	case ArgFloat:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", s)
+		}
+		return v, nil
+	case ArgBool:
+		switch strings.ToLower(s) {
+		case "true", "yes", "on", "1":
+			return true, nil
+		case "false", "no", "off", "0":
+			return false, nil
+		}
+		return nil, fmt.Errorf("%q is not a boolean", s)
+	case ArgUser:
+		m := userRegexp.FindStringSubmatch(s)
+		if m == nil {
+			return nil, fmt.Errorf("%q is not a user", s)
+		}
+
+		kind, id := m[1]+m[3], m[2]+m[4]
+		v, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a user", s)
+		}
+
+		if kind == "club" || kind == "public" {
+			v = -v
+		}
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("unknown argument type %v", typ)
+}
+
+func (c *Commands) reply(ctx context.Context, msg *vk.MessageNew, text string) error {
+	_, err := vkapi.Messages{API: c.bot}.SendContext(ctx, vkapi.MessagesSendParams{
+		PeerID:   msg.PeerID,
+		Message:  text,
+		RandomID: int(rand.Int31()),
+	})
+
+	return err
+}
@@ -0,0 +1,262 @@
+package vkbot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stek29/vk"
+)
+
+func nopHandler(ctx context.Context, req *CommandRequest) error {
+	return nil
+}
+
+var banArgs = []Arg{
+	{Name: "user", Type: ArgUser},
+	{Name: "days", Type: ArgInt, Optional: true},
+	{Name: "reason", Type: ArgText, Optional: true},
+}
+
+func TestParseArgs(t *testing.T) {
+	tests := []struct {
+		args     []Arg
+		text     string
+		expected string
+		err      string
+	}{
+		{banArgs, "id5 3 spam and flood", `map[days:3 reason:spam and flood user:5]`, ""},
+		{banArgs, "  [id5|Pavel]  ", `map[user:5]`, ""},
+		{banArgs, "", "", "missing argument user"},
+		{banArgs, "x", "", `invalid argument user: "x" is not a user`},
+		{banArgs, "5 three", "", `invalid argument days: "three" is not an integer`},
+		{[]Arg{{Name: "a", Type: ArgString}}, "one two", "", `unexpected argument "two"`},
+		{[]Arg{{Name: "f", Type: ArgFloat}, {Name: "b", Type: ArgBool}}, "1.5 Yes", `map[b:true f:1.5]`, ""},
+		{[]Arg{{Name: "b", Type: ArgBool}}, "maybe", "", "is not a boolean"},
+	}
+
+	for _, tt := range tests {
+		args, err := parseArgs(tt.args, tt.text)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("%q: expected error %q, got %v", tt.text, tt.err, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.text, err)
+		} else if got := fmt.Sprint(map[string]interface{}(args)); got != tt.expected {
+			t.Errorf("%q: expected %v, got %v", tt.text, tt.expected, got)
+		}
+	}
+}
+
+func TestParseUserArg(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected int
+	}{
+		{"1", 1},
+		{"-1", -1},
+		{"id1", 1},
+		{"club2", -2},
+		{"public3", -3},
+		{"[id4|Name]", 4},
+		{"[club5|@bot]", -5},
+		{"[id6|Name", 0},
+		{"durov", 0},
+		{"id", 0},
+	}
+
+	for _, tt := range tests {
+		v, err := parseArg(ArgUser, tt.in)
+		if tt.expected == 0 {
+			if err == nil {
+				t.Errorf("%q: expected error, got %v", tt.in, v)
+			}
+		} else if err != nil || v != tt.expected {
+			t.Errorf("%q: expected %v, got %v (%v)", tt.in, tt.expected, v, err)
+		}
+	}
+}
+
+// newTestCommands creates Commands of group 1 with "ban" and "start" commands
+func newTestCommands(t *testing.T, cfg CommandsConfig) *Commands {
+	c := NewCommands(&Bot{BotConfig: BotConfig{GroupID: 1}}, cfg)
+
+	if err := c.Add(Command{Name: "ban", Aliases: []string{"b"}, Args: banArgs, Description: "Ban user", Handler: nopHandler}); err != nil {
+		t.Fatalf("Cant add command: %v", err)
+	}
+	if err := c.Add(Command{Name: "start", Handler: nopHandler}); err != nil {
+		t.Fatalf("Cant add command: %v", err)
+	}
+
+	c.Pattern(regexp.MustCompile(`^(?i)hello, (\w+)$`), nopHandler)
+	return c
+}
+
+func TestParsePayloadArgs(t *testing.T) {
+	nameArgs := []Arg{{Name: "name", Type: ArgString}, {Name: "n", Type: ArgInt}}
+
+	tests := []struct {
+		args     []Arg
+		payload  string
+		expected string
+		err      string
+	}{
+		{banArgs, `{"user":5,"reason":"spam and flood"}`, `map[reason:spam and flood user:5]`, ""},
+		{banArgs, `{"user":"[club1|Club]","days":"3"}`, `map[days:3 user:-1]`, ""},
+		{banArgs, `"id5 3 spam"`, `map[days:3 reason:spam user:5]`, ""},
+		{nameArgs, `{"name":"John Smith","n":2}`, `map[n:2 name:John Smith]`, ""},
+		{nameArgs, `{"name":42,"n":2}`, `map[n:2 name:42]`, ""},
+		{banArgs, `null`, "", "missing argument user"},
+		{banArgs, `{"days":3}`, "", "missing argument user"},
+		{banArgs, `{"user":null}`, "", "missing argument user"},
+		{banArgs, `{"user":5,"days":1.5}`, "", `invalid argument days: "1.5" is not an integer`},
+		{banArgs, `{"user":[5]}`, "", "invalid argument user: [5] is not a single value"},
+		{banArgs, `{"user":5,"until":1,"extra":2}`, "", `unexpected argument "extra"`},
+		{banArgs, `5`, "", "should be a string or an object"},
+	}
+
+	for _, tt := range tests {
+		args, err := parsePayloadArgs(tt.args, json.RawMessage(tt.payload))
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("%s: expected error %q, got %v", tt.payload, tt.err, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.payload, err)
+		} else if got := fmt.Sprint(map[string]interface{}(args)); got != tt.expected {
+			t.Errorf("%s: expected %v, got %v", tt.payload, tt.expected, got)
+		}
+	}
+}
+
+func TestCommandsMatch(t *testing.T) {
+	c := newTestCommands(t, CommandsConfig{BotName: "testbot"})
+
+	tests := []struct {
+		text    string
+		payload string
+		// expected is name command was called with and its raw arguments,
+		// or pattern matches, empty if nothing matches
+		expected string
+	}{
+		{"/ban id5 3", "", "ban: id5 3"},
+		{"/B@TestBot 5", "", "B: 5"},
+		{"/ban@otherbot 5", "", ""},
+		{"[club1|@testbot], ban 9", "", "ban: 9"},
+		{"[public1|bot] /start", "", "start: "},
+		{"[club2|@otherbot] ban 9", "", ""},
+		{"ban 9", "", ""},
+		{"/unknown", "", ""},
+		{"Start", `{"command":"start"}`, "payload start: null"},
+		{"Ban", `{"command":"ban","args":{"user":5,"days":3}}`, `payload ban: {"user":5,"days":3}`},
+		{"Ban", `{"command":"ban","args":"id5 spam"}`, `payload ban: "id5 spam"`},
+		{"/start", `{"command":"unknown"}`, "start: "},
+		{"Hello, bot", "", "[Hello, bot bot]"},
+		{"[club1|@testbot] hello, bot", "", "[hello, bot bot]"},
+	}
+
+	for _, tt := range tests {
+		msg := &vk.MessageNew{Message: vk.Message{Text: tt.text, Payload: tt.payload}}
+		req, raw, handler := c.match(msg)
+
+		got := ""
+		if handler != nil && req.Command != nil {
+			got = req.Name + ": " + strings.TrimSpace(raw.text)
+			if req.FromPayload {
+				got = "payload " + req.Name + ": " + string(raw.payload)
+			}
+		} else if handler != nil {
+			got = fmt.Sprint(req.Matches)
+		}
+
+		if got != tt.expected {
+			t.Errorf("%q %v: expected %q, got %q", tt.text, tt.payload, tt.expected, got)
+		}
+	}
+}
+
+func TestCommandsHandlePayloadArgs(t *testing.T) {
+	var (
+		args Args
+		errs []error
+	)
+
+	c := NewCommands(&Bot{BotConfig: BotConfig{GroupID: 1}}, CommandsConfig{
+		OnError: func(ctx context.Context, msg *vk.MessageNew, err error) {
+			errs = append(errs, err)
+		},
+	})
+	c.Add(Command{Name: "ban", Args: banArgs, Handler: func(ctx context.Context, req *CommandRequest) error {
+		args = req.Args
+		return nil
+	}})
+
+	c.Handle(context.Background(), &vk.MessageNew{Message: vk.Message{Payload: `{"command":"ban","args":{"user":5}}`}})
+	if args.Int("user") != 5 || args.Has("days") {
+		t.Errorf("Unexpected args: %v", args)
+	}
+
+	// required arguments are checked for buttons too
+	args = nil
+	c.Handle(context.Background(), &vk.MessageNew{Message: vk.Message{Payload: `{"command":"ban"}`}})
+
+	var usageErr *UsageError
+	if args != nil || len(errs) != 1 || !errors.As(errs[0], &usageErr) || usageErr.Usage != "/ban <user> [days] [reason]" {
+		t.Errorf("Expected UsageError, got %v, args %v", errs, args)
+	}
+}
+
+func TestCommandsAdd(t *testing.T) {
+	c := newTestCommands(t, CommandsConfig{})
+
+	tests := []struct {
+		cmd Command
+		err string
+	}{
+		{Command{Name: "kick", Handler: nopHandler}, ""},
+		{Command{Handler: nopHandler}, "name is required"},
+		{Command{Name: "mute"}, "handler is required"},
+		{Command{Name: "BAN", Handler: nopHandler}, `"BAN" is already registered`},
+		{Command{Name: "warn", Aliases: []string{"b"}, Handler: nopHandler}, `"b" is already registered`},
+		{Command{Name: "say", Args: []Arg{{Name: "text", Type: ArgText}, {Name: "to", Type: ArgUser}}, Handler: nopHandler}, "isn't the last one"},
+		{Command{Name: "pin", Args: []Arg{{Name: "a", Optional: true}, {Name: "b"}}, Handler: nopHandler}, "follows optional one"},
+	}
+
+	for _, tt := range tests {
+		err := c.Add(tt.cmd)
+		if tt.err == "" && err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.cmd.Name, err)
+		} else if tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+			t.Errorf("%q: expected error %q, got %v", tt.cmd.Name, tt.err, err)
+		}
+	}
+
+	// failed command doesn't register its aliases
+	if _, ok := c.byName["warn"]; ok {
+		t.Errorf("Command with duplicate alias was registered")
+	}
+}
+
+func TestCommandsHelp(t *testing.T) {
+	c := newTestCommands(t, CommandsConfig{Prefixes: []string{"!", "/"}, HelpCommand: "help"})
+	c.Add(Command{Name: "secret", Hidden: true, Handler: nopHandler})
+
+	expected := "!help — Show list of commands\n" +
+		"!ban <user> [days] [reason] (!b) — Ban user\n" +
+		"!start"
+
+	if got := c.Help(); got != expected {
+		t.Errorf("Unexpected help:\n%v\nexpected:\n%v", got, expected)
+	}
+}
@@ -0,0 +1,102 @@
+//go:build ignore
+// +build ignore
+
+// gen_router generates Router.On* methods for every event type
+// parsed by vk.CallbackEvent.UnmarshalJSON
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+)
+
+const (
+	eventsFile = "../events.go"
+	outputFile = "router_events.go"
+)
+
+// eventTypes returns names of types assigned to CallbackEvent.Event
+// in cases of CallbackEvent.UnmarshalJSON switch, in order of cases
+func eventTypes(f *ast.File) []string {
+	var types []string
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		fn, ok := n.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != "UnmarshalJSON" || fn.Recv == nil {
+			return true
+		}
+
+		if star, ok := fn.Recv.List[0].Type.(*ast.StarExpr); !ok || star.X.(*ast.Ident).Name != "CallbackEvent" {
+			return false
+		}
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			clause, ok := n.(*ast.CaseClause)
+			if !ok {
+				return true
+			}
+
+			for _, stmt := range clause.Body {
+				assign, ok := stmt.(*ast.AssignStmt)
+				if !ok {
+					continue
+				}
+
+				if lit, ok := assign.Rhs[0].(*ast.CompositeLit); ok {
+					types = append(types, lit.Type.(*ast.Ident).Name)
+					break
+				}
+			}
+			return false
+		})
+
+		return false
+	})
+
+	return types
+}
+
+func main() {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, eventsFile, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen_router.go. DO NOT EDIT.\n\n")
+	b.WriteString("package vkbot\n\n")
+	b.WriteString("import (\n\t\"context\"\n\n\t\"github.com/stek29/vk\"\n)\n")
+
+	for _, name := range eventTypes(f) {
+		// confirmation is answered by poller
+		if name == "Confirmation" {
+			continue
+		}
+
+		fmt.Fprintf(&b, `
+// On%[1]s registers handler for vk.%[1]s events
+func (r *Router) On%[1]s(handler func(ctx context.Context, event *vk.%[1]s)) {
+	r.on(vk.%[1]s{}, func(ctx context.Context, event interface{}) {
+		evt := event.(vk.%[1]s)
+		handler(ctx, &evt)
+	})
+}
+`, name)
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(outputFile, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"net/url"
@@ -23,8 +24,8 @@ type LongPoller struct {
 	ts     string
 }
 
-func (p *LongPoller) getServer(b *Bot) error {
-	srv, err := vkapi.Groups{API: b}.GetLongPollServer(vkapi.GroupsGetLongPollServerParams{
+func (p *LongPoller) getServer(ctx context.Context, b *Bot) error {
+	srv, err := vkapi.Groups{API: b}.GetLongPollServerContext(ctx, vkapi.GroupsGetLongPollServerParams{
 		GroupID: b.GroupID,
 	})
 	if err != nil {
@@ -60,7 +61,7 @@ func (p *LongPoller) getUpdates(ctx context.Context, b *Bot) ([]vk.CallbackEvent
 	if p.server == nil || p.key == "" || p.ts == "" {
 		oldTS := p.ts
 
-		if err := p.getServer(b); err != nil {
+		if err := p.getServer(ctx, b); err != nil {
 			return nil, err
 		}
 
@@ -69,29 +70,11 @@ func (p *LongPoller) getUpdates(ctx context.Context, b *Bot) ([]vk.CallbackEvent
 		}
 	}
 
-	u := *p.server
-	u.RawQuery = fmt.Sprintf("act=a_check&key=%v&ts=%v&wait=%v", p.key, p.ts, int(p.Wait/time.Second))
-
-	req, err := http.NewRequest("GET", u.String(), nil)
+	resp, err := p.check(ctx, b)
 	if err != nil {
 		return nil, err
 	}
 
-	req = req.WithContext(ctx)
-
-	r, err := b.HTTPClient().Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer r.Body.Close()
-
-	resp := longPollResponse{}
-
-	dec := json.NewDecoder(r.Body)
-	if err := dec.Decode(&resp); err != nil {
-		return nil, err
-	}
-
 	switch resp.Failed {
 	case longPollErrorOk:
 		p.ts = resp.TS
@@ -111,6 +94,44 @@ func (p *LongPoller) getUpdates(ctx context.Context, b *Bot) ([]vk.CallbackEvent
 	}
 }
 
+// longPollMethod is pseudo-method name used to observe long poll requests
+const longPollMethod = "longpoll.check"
+
+func (p *LongPoller) check(ctx context.Context, b *Bot) (resp longPollResponse, err error) {
+	var o *vk.RequestObservation
+	if b.Observer != nil || b.Tracer != nil {
+		ctx, o = vk.StartRequestObservation(ctx, b.Observer, b.Tracer, longPollMethod)
+		defer func() { o.Finish(ctx, err) }()
+	}
+
+	u := *p.server
+	u.RawQuery = fmt.Sprintf("act=a_check&key=%v&ts=%v&wait=%v", p.key, p.ts, int(p.Wait/time.Second))
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return resp, err
+	}
+
+	req = req.WithContext(ctx)
+
+	r, err := b.HTTPClient().Do(req)
+	if err != nil {
+		return resp, err
+	}
+	defer r.Body.Close()
+
+	var body io.Reader = r.Body
+	if o != nil {
+		o.Info.StatusCode = r.StatusCode
+		body = o.CountingReader(body)
+	}
+
+	dec := json.NewDecoder(body)
+	err = dec.Decode(&resp)
+
+	return resp, err
+}
+
 // Poll conforms to Poller interface
 func (p *LongPoller) Poll(ctx context.Context, b *Bot, dest chan<- vk.CallbackEvent) {
 	for {
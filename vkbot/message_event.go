@@ -0,0 +1,76 @@
+package vkbot
+
+import (
+	"context"
+	"time"
+
+	"github.com/stek29/vk"
+	"github.com/stek29/vk/vkapi"
+)
+
+// defaultMessageEventTimeout is less than a minute VK waits for answer
+const defaultMessageEventTimeout = 50 * time.Second
+
+// AnswerMessageEvent answers message_event sent when callback button
+// is pressed, data is action to be performed by client, and can be nil
+func (b *Bot) AnswerMessageEvent(ctx context.Context, evt vk.MessageEvent, data *vkapi.EventData) error {
+	_, err := vkapi.Messages{API: b}.SendMessageEventAnswerContext(ctx, vkapi.MessagesSendMessageEventAnswerParams{
+		EventID:   evt.EventID,
+		UserID:    evt.UserID,
+		PeerID:    evt.PeerID,
+		EventData: data,
+	})
+
+	return err
+}
+
+// HandleMessageEvent calls handler and answers evt with action it returns
+//
+// If handler doesn't finish within MessageEventTimeout, evt is answered
+// without action so button stops loading, and action returned later is dropped.
+// If handler fails, evt is answered without action and its error is returned.
+// If ctx is done while waiting for handler after timeout, ctx.Err() is returned.
+func (b *Bot) HandleMessageEvent(ctx context.Context, evt vk.MessageEvent, handler func(ctx context.Context) (*vkapi.EventData, error)) error {
+	timeout := b.MessageEventTimeout
+	if timeout <= 0 {
+		timeout = defaultMessageEventTimeout
+	}
+
+	type result struct {
+		data *vkapi.EventData
+		err  error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		data, err := handler(ctx)
+		done <- result{data, err}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case res := <-done:
+		if res.err != nil {
+			res.data = nil
+		}
+
+		if err := b.AnswerMessageEvent(ctx, evt, res.data); err != nil && res.err == nil {
+			return err
+		}
+		return res.err
+
+	case <-timer.C:
+		if err := b.AnswerMessageEvent(ctx, evt, nil); err != nil {
+			return err
+		}
+
+		select {
+		case res := <-done:
+			return res.err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package vkbot
+
+//go:generate go run gen_router.go
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/stek29/vk"
+)
+
+type contextKey int
+
+const (
+	botKey contextKey = iota
+	eventKey
+)
+
+// BotFromContext returns Bot which has received event handled by Router
+func BotFromContext(ctx context.Context) *Bot {
+	b, _ := ctx.Value(botKey).(*Bot)
+	return b
+}
+
+// EventFromContext returns event handled by Router, including its metadata
+func EventFromContext(ctx context.Context) *vk.CallbackEvent {
+	e, _ := ctx.Value(eventKey).(*vk.CallbackEvent)
+	return e
+}
+
+// GroupIDFromContext returns ID of group event handled by Router has occured in
+func GroupIDFromContext(ctx context.Context) int {
+	if e := EventFromContext(ctx); e != nil {
+		return e.GroupID
+	}
+	return 0
+}
+
+// Router dispatches events to handlers registered for their types
+//
+// Every handler receives context carrying Bot and event being handled,
+// see BotFromContext, EventFromContext and GroupIDFromContext.
+// Events without handler are passed to Fallback handler, if any.
+//
+// On* methods are generated for every event parsed by vk.CallbackEvent,
+// run go generate after adding new event type.
+//
+// Usage:
+//
+//	r := vkbot.NewRouter(bot)
+//	r.OnMessageNew(func(ctx context.Context, msg *vk.MessageNew) {
+//		// handle message here
+//	})
+//	r.Run(ctx)
+type Router struct {
+	bot      *Bot
+	handlers map[reflect.Type]func(ctx context.Context, event interface{})
+	fallback func(ctx context.Context, event vk.CallbackEvent)
+}
+
+// NewRouter creates a new Router for events received by bot
+func NewRouter(bot *Bot) *Router {
+	return &Router{
+		bot:      bot,
+		handlers: make(map[reflect.Type]func(ctx context.Context, event interface{})),
+	}
+}
+
+// Fallback registers handler for events which have no handler
+func (r *Router) Fallback(handler func(ctx context.Context, event vk.CallbackEvent)) {
+	r.fallback = handler
+}
+
+// Handle passes event to handler registered for its type
+func (r *Router) Handle(ctx context.Context, event vk.CallbackEvent) {
+	ctx = context.WithValue(ctx, botKey, r.bot)
+	ctx = context.WithValue(ctx, eventKey, &event)
+
+	if handler, ok := r.handlers[reflect.TypeOf(event.Event)]; ok {
+		handler(ctx, event.Event)
+	} else if r.fallback != nil {
+		r.fallback(ctx, event)
+	}
+}
+
+// Serve handles events from channel one by one,
+// until it's closed or ctx is Done
+func (r *Router) Serve(ctx context.Context, events <-chan vk.CallbackEvent) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			r.Handle(ctx, event)
+		}
+	}
+}
+
+// Run starts polling with bot and handles received events,
+// until ctx is Done
+func (r *Router) Run(ctx context.Context) error {
+	events, err := r.bot.StartPolling(ctx, 0)
+	if err != nil {
+		return err
+	}
+
+	r.Serve(ctx, events)
+	return nil
+}
+
+func (r *Router) on(event interface{}, handler func(ctx context.Context, event interface{})) {
+	r.handlers[reflect.TypeOf(event)] = handler
+}
@@ -0,0 +1,73 @@
+package vkbot
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stek29/vk"
+)
+
+// newEvent parses Callback API event like poller does
+func newEvent(t *testing.T, data string) vk.CallbackEvent {
+	var e vk.CallbackEvent
+	if err := json.Unmarshal([]byte(data), &e); err != nil {
+		t.Fatalf("Cant parse event: %v", err)
+	}
+	return e
+}
+
+func TestRouterHandle(t *testing.T) {
+	bot := &Bot{BotConfig: BotConfig{GroupID: 1}}
+	router := NewRouter(bot)
+
+	var handled []string
+	router.OnMessageNew(func(ctx context.Context, msg *vk.MessageNew) {
+		if BotFromContext(ctx) != bot || GroupIDFromContext(ctx) != 1 || EventFromContext(ctx).EventID != "e1" {
+			t.Errorf("Unexpected context of event")
+		}
+		handled = append(handled, "message_new: "+msg.Text)
+	})
+	router.OnGroupLeave(func(ctx context.Context, evt *vk.GroupLeave) {
+		handled = append(handled, "group_leave")
+	})
+
+	events := []string{
+		`{"type":"message_new","group_id":1,"event_id":"e1","object":{"text":"hello"}}`,
+		`{"type":"group_join","group_id":1,"object":{"user_id":100,"join_type":"join"}}`,
+		`{"type":"group_leave","group_id":1,"object":{"user_id":100}}`,
+	}
+
+	// unhandled events are dropped without fallback
+	router.Handle(context.Background(), newEvent(t, events[1]))
+
+	router.Fallback(func(ctx context.Context, e vk.CallbackEvent) {
+		handled = append(handled, "fallback: "+e.Type)
+	})
+
+	ch := make(chan vk.CallbackEvent, len(events))
+	for _, e := range events {
+		ch <- newEvent(t, e)
+	}
+	close(ch)
+
+	// Serve returns once channel is closed
+	router.Serve(context.Background(), ch)
+
+	expected := []string{"message_new: hello", "fallback: group_join", "group_leave"}
+	if len(handled) != len(expected) {
+		t.Fatalf("Expected %q, got %q", expected, handled)
+	}
+	for i := range expected {
+		if handled[i] != expected[i] {
+			t.Errorf("Expected %q, got %q", expected[i], handled[i])
+		}
+	}
+}
+
+func TestRouterContextWithoutEvent(t *testing.T) {
+	ctx := context.Background()
+	if BotFromContext(ctx) != nil || EventFromContext(ctx) != nil || GroupIDFromContext(ctx) != 0 {
+		t.Errorf("Expected zero values for context without event")
+	}
+}
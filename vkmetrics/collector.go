@@ -0,0 +1,258 @@
+// Package vkmetrics collects per-method metrics of VK API requests
+//
+// Usage:
+//
+//	metrics := vkmetrics.NewCollector(vkmetrics.CollectorConfig{})
+//	metrics.Publish("vk")
+//	http.Handle("/metrics", metrics)
+//
+//	api, _ := vk.NewBaseAPI(vk.BaseAPIConfig{AccessToken: token, Observer: metrics})
+package vkmetrics
+
+import (
+	"bufio"
+	"context"
+	"expvar"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/stek29/vk"
+)
+
+// DefaultBuckets are upper bounds of latency histogram buckets in seconds
+var DefaultBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// CollectorConfig represents configuration used for Collector creation
+type CollectorConfig struct {
+	// Optional: prefix of metric names, if empty, "vk" is used
+	Namespace string
+	// Optional: latency histogram buckets in seconds,
+	// if nil, DefaultBuckets are used
+	Buckets []float64
+}
+
+// MethodStats represents statistics of one method
+type MethodStats struct {
+	// Requests is number of requests made
+	Requests int64
+	// Errors is number of failed requests
+	Errors int64
+	// StatusCodes is number of requests by HTTP status code,
+	// 0 is used for requests without response
+	StatusCodes map[int]int64
+	// ErrorCodes is number of requests by VK API error code
+	ErrorCodes map[int]int64
+	// DurationSeconds is total duration of requests
+	DurationSeconds float64
+	// RequestBytes is total size of request bodies
+	RequestBytes int64
+	// ResponseBytes is total size of response bodies
+	ResponseBytes int64
+}
+
+// Collector is vk.Observer which collects per-method statistics
+//
+// They can be exported in Prometheus text format with WritePrometheus
+// or ServeHTTP, or published to expvar with Publish
+type Collector struct {
+	namespace string
+	buckets   []float64
+
+	mu      sync.Mutex
+	methods map[string]*methodStats
+}
+
+type requestKey struct {
+	statusCode int
+	errorCode  int
+}
+
+type methodStats struct {
+	MethodStats
+
+	requests map[requestKey]int64
+	// buckets are cumulative counts of requests per bucket
+	buckets []int64
+}
+
+// NewCollector creates a new Collector
+func NewCollector(cfg CollectorConfig) *Collector {
+	namespace := cfg.Namespace
+	if namespace == "" {
+		namespace = "vk"
+	}
+
+	buckets := cfg.Buckets
+	if buckets == nil {
+		buckets = DefaultBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+
+	return &Collector{
+		namespace: namespace,
+		buckets:   buckets,
+		methods:   make(map[string]*methodStats),
+	}
+}
+
+// ObserveRequest conforms to vk.Observer interface
+func (c *Collector) ObserveRequest(ctx context.Context, info vk.RequestInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	m, ok := c.methods[info.Method]
+	if !ok {
+		m = &methodStats{
+			MethodStats: MethodStats{
+				StatusCodes: make(map[int]int64),
+				ErrorCodes:  make(map[int]int64),
+			},
+			requests: make(map[requestKey]int64),
+			buckets:  make([]int64, len(c.buckets)),
+		}
+		c.methods[info.Method] = m
+	}
+
+	seconds := info.Duration.Seconds()
+
+	m.Requests++
+	if info.Err != nil {
+		m.Errors++
+	}
+	m.StatusCodes[info.StatusCode]++
+	if info.ErrorCode != 0 {
+		m.ErrorCodes[info.ErrorCode]++
+	}
+	m.DurationSeconds += seconds
+	m.RequestBytes += info.RequestSize
+	m.ResponseBytes += info.ResponseSize
+
+	m.requests[requestKey{statusCode: info.StatusCode, errorCode: info.ErrorCode}]++
+	for i, le := range c.buckets {
+		if seconds <= le {
+			m.buckets[i]++
+		}
+	}
+}
+
+// Snapshot returns copy of statistics for every observed method
+func (c *Collector) Snapshot() map[string]MethodStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	snapshot := make(map[string]MethodStats, len(c.methods))
+	for method, m := range c.methods {
+		stats := m.MethodStats
+		stats.StatusCodes = copyCounts(m.StatusCodes)
+		stats.ErrorCodes = copyCounts(m.ErrorCodes)
+		snapshot[method] = stats
+	}
+
+	return snapshot
+}
+
+func copyCounts(src map[int]int64) map[int]int64 {
+	dst := make(map[int]int64, len(src))
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
+
+// Publish publishes Snapshot to expvar with name
+//
+// Like expvar.Publish, it panics if name is already registered
+func (c *Collector) Publish(name string) {
+	expvar.Publish(name, expvar.Func(func() interface{} {
+		return c.Snapshot()
+	}))
+}
+
+// ServeHTTP conforms to http.Handler interface, it serves
+// metrics in Prometheus text format
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	c.WritePrometheus(w)
+}
+
+// WritePrometheus writes metrics to w in Prometheus text format
+func (c *Collector) WritePrometheus(w io.Writer) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	methods := make([]string, 0, len(c.methods))
+	for method := range c.methods {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	bw := bufio.NewWriter(w)
+	ns := c.namespace
+
+	fmt.Fprintf(bw, "# HELP %s_requests_total Total number of VK API requests.\n", ns)
+	fmt.Fprintf(bw, "# TYPE %s_requests_total counter\n", ns)
+	for _, method := range methods {
+		m := c.methods[method]
+
+		keys := make([]requestKey, 0, len(m.requests))
+		for k := range m.requests {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if keys[i].statusCode != keys[j].statusCode {
+				return keys[i].statusCode < keys[j].statusCode
+			}
+			return keys[i].errorCode < keys[j].errorCode
+		})
+
+		for _, k := range keys {
+			fmt.Fprintf(bw, "%s_requests_total{method=%s,status=\"%d\",error_code=\"%d\"} %d\n",
+				ns, quoteLabel(method), k.statusCode, k.errorCode, m.requests[k])
+		}
+	}
+
+	fmt.Fprintf(bw, "# HELP %s_request_duration_seconds Duration of VK API requests.\n", ns)
+	fmt.Fprintf(bw, "# TYPE %s_request_duration_seconds histogram\n", ns)
+	for _, method := range methods {
+		m := c.methods[method]
+		label := quoteLabel(method)
+
+		for i, le := range c.buckets {
+			fmt.Fprintf(bw, "%s_request_duration_seconds_bucket{method=%s,le=\"%s\"} %d\n",
+				ns, label, formatFloat(le), m.buckets[i])
+		}
+		fmt.Fprintf(bw, "%s_request_duration_seconds_bucket{method=%s,le=\"+Inf\"} %d\n", ns, label, m.Requests)
+		fmt.Fprintf(bw, "%s_request_duration_seconds_sum{method=%s} %s\n", ns, label, formatFloat(m.DurationSeconds))
+		fmt.Fprintf(bw, "%s_request_duration_seconds_count{method=%s} %d\n", ns, label, m.Requests)
+	}
+
+	fmt.Fprintf(bw, "# HELP %s_request_size_bytes_total Total size of VK API request bodies.\n", ns)
+	fmt.Fprintf(bw, "# TYPE %s_request_size_bytes_total counter\n", ns)
+	for _, method := range methods {
+		fmt.Fprintf(bw, "%s_request_size_bytes_total{method=%s} %d\n", ns, quoteLabel(method), c.methods[method].RequestBytes)
+	}
+
+	fmt.Fprintf(bw, "# HELP %s_response_size_bytes_total Total size of VK API response bodies.\n", ns)
+	fmt.Fprintf(bw, "# TYPE %s_response_size_bytes_total counter\n", ns)
+	for _, method := range methods {
+		fmt.Fprintf(bw, "%s_response_size_bytes_total{method=%s} %d\n", ns, quoteLabel(method), c.methods[method].ResponseBytes)
+	}
+
+	return bw.Flush()
+}
+
+var labelReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func quoteLabel(v string) string {
+	return `"` + labelReplacer.Replace(v) + `"`
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,87 @@
+package vkmetrics
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stek29/vk"
+)
+
+func TestCollectorPrometheus(t *testing.T) {
+	c := NewCollector(CollectorConfig{Buckets: []float64{0.5, 0.1}})
+	ctx := context.Background()
+
+	c.ObserveRequest(ctx, vk.RequestInfo{
+		Method:       "users.get",
+		Duration:     50 * time.Millisecond,
+		StatusCode:   200,
+		RequestSize:  10,
+		ResponseSize: 100,
+	})
+	c.ObserveRequest(ctx, vk.RequestInfo{
+		Method:       "users.get",
+		Duration:     200 * time.Millisecond,
+		StatusCode:   200,
+		ErrorCode:    vk.ErrorCodeTooManyRequests,
+		Err:          vk.ErrTooManyRequests,
+		RequestSize:  10,
+		ResponseSize: 50,
+	})
+	c.ObserveRequest(ctx, vk.RequestInfo{
+		Method:   "longpoll.check",
+		Duration: time.Second,
+		Err:      errors.New("connection reset"),
+	})
+
+	var b strings.Builder
+	if err := c.WritePrometheus(&b); err != nil {
+		t.Fatalf("WritePrometheus failed: %v", err)
+	}
+	out := b.String()
+
+	expected := []string{
+		`vk_requests_total{method="longpoll.check",status="0",error_code="0"} 1`,
+		`vk_requests_total{method="users.get",status="200",error_code="0"} 1`,
+		`vk_requests_total{method="users.get",status="200",error_code="6"} 1`,
+		`vk_request_duration_seconds_bucket{method="users.get",le="0.1"} 1`,
+		`vk_request_duration_seconds_bucket{method="users.get",le="0.5"} 2`,
+		`vk_request_duration_seconds_bucket{method="longpoll.check",le="0.5"} 0`,
+		`vk_request_duration_seconds_bucket{method="users.get",le="+Inf"} 2`,
+		`vk_request_duration_seconds_sum{method="users.get"} 0.25`,
+		`vk_request_duration_seconds_count{method="users.get"} 2`,
+		`vk_request_size_bytes_total{method="users.get"} 20`,
+		`vk_response_size_bytes_total{method="users.get"} 150`,
+	}
+
+	for _, line := range expected {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("Expected %q in output:\n%s", line, out)
+		}
+	}
+}
+
+func TestCollectorExpvar(t *testing.T) {
+	c := NewCollector(CollectorConfig{})
+	c.Publish("vkmetrics_test")
+
+	c.ObserveRequest(context.Background(), vk.RequestInfo{
+		Method:     "messages.send",
+		StatusCode: 200,
+		ErrorCode:  vk.ErrorCodeFlood,
+		Err:        vk.ErrFlood,
+	})
+
+	v := expvar.Get("vkmetrics_test")
+	if v == nil {
+		t.Fatalf("Collector was not published")
+	}
+
+	out := v.String()
+	if !strings.Contains(out, `"messages.send":{"Requests":1,"Errors":1,"StatusCodes":{"200":1},"ErrorCodes":{"9":1}`) {
+		t.Errorf("Unexpected expvar output: %v", out)
+	}
+}
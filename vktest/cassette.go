@@ -0,0 +1,184 @@
+// Package vktest provides helpers for testing code which uses VK API
+// without access to real VK API
+package vktest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/stek29/vk"
+)
+
+// DefaultScrubParams are params which are never written to cassettes
+var DefaultScrubParams = []string{
+	"access_token",
+	"client_secret",
+	"password",
+	"code",
+	"captcha_key",
+}
+
+const scrubbedValue = "[scrubbed]"
+
+// Interaction is a single recorded API call
+type Interaction struct {
+	Method string     `json:"method"`
+	Params url.Values `json:"params,omitempty"`
+	// Response is set if call has succeeded
+	Response json.RawMessage `json:"response,omitempty"`
+	// APIError is set if call has failed with *vk.APIError
+	APIError *vk.APIError `json:"api_error,omitempty"`
+	// HTTPError is set if call has failed with *vk.HTTPError
+	HTTPError *vk.HTTPError `json:"http_error,omitempty"`
+	// ExecuteErrors are set if call has failed with *vk.ExecuteError,
+	// Response is its partial response
+	ExecuteErrors []vk.APIError `json:"execute_errors,omitempty"`
+	// Error is set if call has failed with any other error
+	Error string `json:"error,omitempty"`
+}
+
+// Cassette is a list of recorded API calls
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// LoadCassette reads cassette from file at path
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cassette{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Save writes cassette to file at path
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// scrub returns copy of params with values of keys replaced
+func scrub(params url.Values, keys []string) url.Values {
+	scrubbed := make(url.Values, len(params))
+	for k, v := range params {
+		scrubbed[k] = append([]string(nil), v...)
+	}
+
+	for _, key := range keys {
+		if _, ok := scrubbed[key]; ok {
+			scrubbed.Set(key, scrubbedValue)
+		}
+	}
+
+	return scrubbed
+}
+
+// scrubbedValues returns values of keys in params
+func scrubbedValues(params url.Values, keys []string) []string {
+	var values []string
+	for _, key := range keys {
+		values = append(values, params[key]...)
+	}
+	return values
+}
+
+// scrubAPIError returns copy of e with values of keys
+// in its request params replaced
+func scrubAPIError(e vk.APIError, keys []string) vk.APIError {
+	params := e.RequestParams
+	e.RequestParams = nil
+
+	for _, p := range params {
+		for _, key := range keys {
+			if p.Key == key {
+				p.Value = scrubbedValue
+				break
+			}
+		}
+		e.RequestParams = append(e.RequestParams, p)
+	}
+
+	return e
+}
+
+// scrubText replaces values of keys in text, which might be
+// found in it either as key=value pairs, or as is
+func scrubText(text string, keys []string, values []string) string {
+	for _, v := range values {
+		if v != "" {
+			text = strings.Replace(text, v, scrubbedValue, -1)
+		}
+	}
+
+	for _, key := range keys {
+		re := regexp.MustCompile(`\b` + regexp.QuoteMeta(key) + `=[^&\s"']*`)
+		text = re.ReplaceAllString(text, key+"="+scrubbedValue)
+	}
+
+	return text
+}
+
+// paramsMatch compares params ignoring scrubbed keys
+func paramsMatch(recorded, actual url.Values, scrubbed []string) bool {
+	ignored := make(map[string]bool, len(scrubbed))
+	for _, key := range scrubbed {
+		ignored[key] = true
+	}
+
+	count := func(q url.Values) int {
+		n := 0
+		for k := range q {
+			if !ignored[k] {
+				n++
+			}
+		}
+		return n
+	}
+
+	if count(recorded) != count(actual) {
+		return false
+	}
+
+	for k, v := range actual {
+		if ignored[k] {
+			continue
+		}
+		if strings.Join(recorded[k], "\x00") != strings.Join(v, "\x00") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// formatParams formats params in stable order, hiding scrubbed ones
+func formatParams(params url.Values, scrubbed []string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	hidden := scrub(params, scrubbed)
+
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + strings.Join(hidden[k], ",")
+	}
+
+	return "{" + strings.Join(parts, " ") + "}"
+}
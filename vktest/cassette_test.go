@@ -0,0 +1,179 @@
+package vktest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stek29/vk"
+)
+
+func TestRecordReplay(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "users.get") {
+			w.Write([]byte(`{"response":[{"id":1,"first_name":"Pavel"}]}`))
+		} else {
+			w.Write([]byte(`{"error":{"error_code":15,"error_msg":"Access denied"}}`))
+		}
+	}))
+	defer srv.Close()
+
+	base, _ := vk.NewBaseAPI(vk.BaseAPIConfig{AccessToken: "very-secret-token"})
+	base.BaseURL = srv.URL + "/method/"
+
+	rec := NewRecorder(vk.Chain(base, vk.AccessTokenMiddleware("another-secret-token")), RecorderConfig{})
+
+	if _, err := rec.Request("users.get", url.Values{"user_ids": {"1"}}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	rec.Request("groups.getMembers", url.Values{"group_id": {"1"}})
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	if err := rec.Save(path); err != nil {
+		t.Fatalf("Cant save cassette: %v", err)
+	}
+
+	data, _ := ioutil.ReadFile(path)
+	if strings.Contains(string(data), "secret-token") {
+		t.Errorf("Cassette contains access token: %s", data)
+	}
+
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		t.Fatalf("Cant load cassette: %v", err)
+	}
+
+	replayer := NewReplayer(cassette, ReplayerConfig{})
+
+	resp, err := replayer.Request("users.get", url.Values{"user_ids": {"1"}, "access_token": {"whatever"}})
+	if err != nil || string(resp) != `[{"id":1,"first_name":"Pavel"}]` {
+		t.Errorf("Unexpected replayed result: %s, %v", resp, err)
+	}
+
+	_, err = replayer.Request("groups.getMembers", url.Values{"group_id": {"1"}})
+	if !errors.Is(err, vk.ErrAccessDenied) {
+		t.Errorf("Expected replayed ErrAccessDenied, got %v", err)
+	}
+
+	_, err = replayer.Request("users.get", url.Values{"user_ids": {"1"}})
+	var mismatch *MismatchError
+	if !errors.As(err, &mismatch) {
+		t.Errorf("Expected MismatchError for already used interaction, got %v", err)
+	}
+
+	if unused := replayer.Unused(); len(unused) != 0 {
+		t.Errorf("Expected all interactions to be used, got %v", unused)
+	}
+}
+
+// leakyAPI fails every request with error containing its params
+type leakyAPI struct{}
+
+func (leakyAPI) Request(method string, params interface{}) (json.RawMessage, error) {
+	q, _ := vk.BuildRequestParams(params)
+	return nil, fmt.Errorf("Post %v?%v: connection refused", method, q.Encode())
+}
+
+func (leakyAPI) HTTPClient() *http.Client {
+	return http.DefaultClient
+}
+
+func TestRecordReplayErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "execute"):
+			w.Write([]byte(`{"response":[1,false],"execute_errors":[{"method":"groups.isMember","error_code":15,"error_msg":"Access denied",` +
+				`"request_params":[{"key":"access_token","value":"very-secret-token"}]}]}`))
+		case strings.HasSuffix(r.URL.Path, "wall.get"):
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.Write([]byte(`{"error":{"error_code":15,"error_msg":"Access denied",` +
+				`"request_params":[{"key":"access_token","value":"very-secret-token"},{"key":"group_id","value":"1"}]}}`))
+		}
+	}))
+	defer srv.Close()
+
+	base, _ := vk.NewBaseAPI(vk.BaseAPIConfig{AccessToken: "very-secret-token"})
+	base.BaseURL = srv.URL + "/method/"
+
+	rec := NewRecorder(base, RecorderConfig{})
+	rec.RequestContext(vk.WithExecuteErrors(context.Background()), "execute", url.Values{"code": {"return 1;"}})
+	rec.Request("wall.get", nil)
+	rec.Request("groups.getMembers", url.Values{"group_id": {"1"}})
+
+	// token is added below recorder
+	leaky := NewRecorder(vk.Chain(leakyAPI{}, vk.AccessTokenMiddleware("another-secret-token")), RecorderConfig{})
+	leaky.Request("users.get", nil)
+
+	cassette := rec.Cassette()
+	cassette.Interactions = append(cassette.Interactions, leaky.Cassette().Interactions...)
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	if err := cassette.Save(path); err != nil {
+		t.Fatalf("Cant save cassette: %v", err)
+	}
+
+	data, _ := ioutil.ReadFile(path)
+	if strings.Contains(string(data), "secret-token") {
+		t.Errorf("Cassette contains access token: %s", data)
+	}
+
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		t.Fatalf("Cant load cassette: %v", err)
+	}
+	replayer := NewReplayer(cassette, ReplayerConfig{})
+
+	resp, err := replayer.Request("execute", url.Values{"code": {"return 1;"}})
+	var execErr *vk.ExecuteError
+	if !errors.As(err, &execErr) || len(execErr.Errors) != 1 || execErr.Errors[0].Code != 15 ||
+		string(execErr.Response) != `[1,false]` || string(resp) != `[1,false]` {
+		t.Errorf("Expected replayed ExecuteError with response, got %s, %v", resp, err)
+	}
+
+	_, err = replayer.Request("wall.get", nil)
+	var httpErr *vk.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("Expected replayed HTTPError, got %v", err)
+	}
+
+	_, err = replayer.Request("groups.getMembers", url.Values{"group_id": {"1"}})
+	var apiErr *vk.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.RequestParams) != 2 || apiErr.RequestParams[1].Value != "1" {
+		t.Errorf("Expected replayed APIError with request params, got %v", err)
+	}
+
+	_, err = replayer.Request("users.get", nil)
+	if err == nil || !strings.Contains(err.Error(), "access_token=[scrubbed]") {
+		t.Errorf("Expected replayed error with scrubbed token, got %v", err)
+	}
+}
+
+func TestReplayerMismatch(t *testing.T) {
+	replayer := NewReplayer(&Cassette{Interactions: []Interaction{
+		{Method: "users.get", Params: url.Values{"user_ids": {"1"}}, Response: []byte(`[]`)},
+	}}, ReplayerConfig{})
+
+	_, err := replayer.Request("users.get", url.Values{"user_ids": {"2"}, "access_token": {"secret"}})
+
+	var mismatch *MismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("Expected MismatchError, got %v", err)
+	}
+
+	msg := err.Error()
+	if !strings.Contains(msg, "user_ids=2") || !strings.Contains(msg, "user_ids=1") {
+		t.Errorf("Expected error to mention actual and recorded params, got %q", msg)
+	}
+	if strings.Contains(msg, "secret") {
+		t.Errorf("Error message contains access token: %q", msg)
+	}
+}
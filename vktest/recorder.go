@@ -0,0 +1,117 @@
+package vktest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/stek29/vk"
+)
+
+// RecorderConfig represents configuration used for Recorder creation
+type RecorderConfig struct {
+	// Optional: params to scrub, if nil, DefaultScrubParams are used
+	ScrubParams []string
+}
+
+// Recorder is an API which records every call made with it
+// to be replayed later by Replayer
+type Recorder struct {
+	api   vk.API
+	scrub []string
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder creates a new Recorder which performs requests using api
+func NewRecorder(api vk.API, cfg RecorderConfig) *Recorder {
+	scrub := cfg.ScrubParams
+	if scrub == nil {
+		scrub = DefaultScrubParams
+	}
+
+	return &Recorder{
+		api:   api,
+		scrub: scrub,
+	}
+}
+
+// HTTPClient conforms to vk.API interface
+func (r *Recorder) HTTPClient() *http.Client {
+	return r.api.HTTPClient()
+}
+
+// Request conforms to vk.API interface
+func (r *Recorder) Request(method string, params interface{}) (json.RawMessage, error) {
+	return r.RequestContext(context.Background(), method, params)
+}
+
+// RequestContext conforms to vk.ContextAPI interface
+func (r *Recorder) RequestContext(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	q, err := vk.BuildRequestParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := vk.RequestContext(ctx, r.api, method, q)
+
+	interaction := Interaction{
+		Method:   method,
+		Params:   scrub(q, r.scrub),
+		Response: resp,
+	}
+
+	var (
+		apiErr  *vk.APIError
+		httpErr *vk.HTTPError
+		execErr *vk.ExecuteError
+	)
+
+	switch {
+	case err == nil:
+	case errors.As(err, &execErr):
+		for _, e := range execErr.Errors {
+			interaction.ExecuteErrors = append(interaction.ExecuteErrors, scrubAPIError(e, r.scrub))
+		}
+		if interaction.Response == nil {
+			interaction.Response = execErr.Response
+		}
+	case errors.As(err, &apiErr):
+		scrubbed := scrubAPIError(*apiErr, r.scrub)
+		interaction.APIError = &scrubbed
+	case errors.As(err, &httpErr):
+		interaction.HTTPError = httpErr
+	default:
+		// access token might be added below Recorder, so besides known
+		// secret values, every key=value pair of secret params is scrubbed
+		secrets := scrubbedValues(q, r.scrub)
+		if base, ok := r.api.(*vk.BaseAPI); ok {
+			secrets = append(secrets, base.AccessToken)
+		}
+		interaction.Error = scrubText(err.Error(), r.scrub, secrets)
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+
+	return resp, err
+}
+
+// Cassette returns copy of everything recorded so far
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return &Cassette{
+		Interactions: append([]Interaction(nil), r.cassette.Interactions...),
+	}
+}
+
+// Save writes everything recorded so far to file at path
+func (r *Recorder) Save(path string) error {
+	return r.Cassette().Save(path)
+}
@@ -0,0 +1,201 @@
+package vktest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/stek29/vk"
+)
+
+// MismatchError is returned by Replayer when there's no recorded
+// interaction for a call
+type MismatchError struct {
+	Method string
+	Params url.Values
+	// Candidates are unused interactions with the same method
+	Candidates []Interaction
+
+	scrubbed []string
+}
+
+// Error implements error interface
+func (e *MismatchError) Error() string {
+	msg := fmt.Sprintf("vktest: no recorded interaction for %v %v", e.Method, formatParams(e.Params, e.scrubbed))
+
+	if len(e.Candidates) == 0 {
+		return msg + ", and no unused interactions for this method"
+	}
+
+	msg += ", unused interactions for this method:"
+	for _, c := range e.Candidates {
+		msg += "\n\t" + formatParams(c.Params, e.scrubbed)
+	}
+
+	return msg
+}
+
+// ReplayerConfig represents configuration used for Replayer creation
+type ReplayerConfig struct {
+	// Optional: params ignored while matching calls,
+	// if nil, DefaultScrubParams are used
+	ScrubParams []string
+	// Optional: if set, calls must be made in the same order they were recorded
+	Ordered bool
+	// Optional: client returned by HTTPClient, if nil, http.DefaultClient is used
+	Client *http.Client
+}
+
+// Replayer is an API which serves responses from Cassette
+// without performing any real requests
+//
+// Every recorded interaction is used only once. Calls are matched
+// by method and params, scrubbed params are ignored.
+type Replayer struct {
+	scrub   []string
+	ordered bool
+	client  *http.Client
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewReplayer creates a new Replayer serving responses from c
+func NewReplayer(c *Cassette, cfg ReplayerConfig) *Replayer {
+	scrub := cfg.ScrubParams
+	if scrub == nil {
+		scrub = DefaultScrubParams
+	}
+
+	client := cfg.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &Replayer{
+		scrub:        scrub,
+		ordered:      cfg.Ordered,
+		client:       client,
+		interactions: c.Interactions,
+		used:         make([]bool, len(c.Interactions)),
+	}
+}
+
+// HTTPClient conforms to vk.API interface
+func (r *Replayer) HTTPClient() *http.Client {
+	return r.client
+}
+
+// Request conforms to vk.API interface
+func (r *Replayer) Request(method string, params interface{}) (json.RawMessage, error) {
+	return r.RequestContext(context.Background(), method, params)
+}
+
+// RequestContext conforms to vk.ContextAPI interface
+func (r *Replayer) RequestContext(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	q, err := vk.BuildRequestParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var candidates []Interaction
+
+	for i, interaction := range r.interactions {
+		if r.used[i] {
+			continue
+		}
+
+		if interaction.Method == method && paramsMatch(interaction.Params, q, r.scrub) {
+			r.used[i] = true
+			return interaction.result()
+		}
+
+		if r.ordered {
+			candidates = append(candidates, interaction)
+			break
+		}
+
+		if interaction.Method == method {
+			candidates = append(candidates, interaction)
+		}
+	}
+
+	return nil, &MismatchError{
+		Method:     method,
+		Params:     q,
+		Candidates: candidates,
+		scrubbed:   r.scrub,
+	}
+}
+
+func (i Interaction) result() (json.RawMessage, error) {
+	switch {
+	case i.APIError != nil:
+		apiErr := *i.APIError
+		return nil, &apiErr
+	case i.HTTPError != nil:
+		httpErr := *i.HTTPError
+		return nil, &httpErr
+	case i.Error == context.Canceled.Error():
+		return nil, context.Canceled
+	case i.Error == context.DeadlineExceeded.Error():
+		return nil, context.DeadlineExceeded
+	case i.Error != "":
+		return nil, errors.New(i.Error)
+	}
+
+	resp, err := i.response()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(i.ExecuteErrors) != 0 {
+		return resp, &vk.ExecuteError{
+			Response: resp,
+			Errors:   append([]vk.APIError(nil), i.ExecuteErrors...),
+		}
+	}
+
+	return resp, nil
+}
+
+func (i Interaction) response() (json.RawMessage, error) {
+	if len(i.Response) == 0 {
+		return nil, nil
+	}
+
+	// cassettes are indented when saved
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, i.Response); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unused returns interactions which were not replayed yet
+func (r *Replayer) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []Interaction
+	for i, interaction := range r.interactions {
+		if !r.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+
+	return unused
+}
@@ -0,0 +1,797 @@
+package vktest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stek29/vk"
+)
+
+// Group is a community known to Server
+type Group struct {
+	ID         int
+	Name       string
+	ScreenName string
+	// Token is group access token which can be used to act as this group
+	Token string
+}
+
+// User is a user known to Server
+type User struct {
+	ID        int
+	FirstName string
+	LastName  string
+	// Token is user access token which can be used to act as this user
+	Token string
+}
+
+// Message is a message stored by Server
+type Message struct {
+	ID int
+	// GroupID is ID of group in which conversation this message is
+	GroupID        int
+	ConversationID int
+	Date           int
+	PeerID         int
+	FromID         int
+	Text           string
+	RandomID       int
+	Payload        string
+	Keyboard       string
+	// Template is JSON-encoded carousel, if any
+	Template string
+}
+
+// MessageEventAnswer is answer to message_event received by Server
+type MessageEventAnswer struct {
+	// GroupID is ID of group which has answered
+	GroupID int
+	EventID string
+	UserID  int
+	PeerID  int
+	// EventData is JSON-encoded action, empty if event was answered without it
+	EventData string
+}
+
+// Event is an event sent to Bots Long Poll API or Callback API clients
+type Event struct {
+	Type    string      `json:"type"`
+	Object  interface{} `json:"object,omitempty"`
+	GroupID int         `json:"group_id"`
+	Secret  string      `json:"secret,omitempty"`
+}
+
+// Server is a fake VK API server running in process
+//
+// It serves method endpoint (use BaseURL as vk.BaseAPI.BaseURL),
+// Bots Long Poll API and keeps track of groups, users and messages.
+// Supported methods are groups.getById, groups.getLongPollServer,
+// users.get, messages.send, messages.getHistory,
+// messages.getConversations and messages.sendMessageEventAnswer;
+// other methods fail with ErrorCodeUnknownMethod.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	groups   map[int]*Group
+	users    map[int]*User
+	messages []*Message
+	// messageSeq is the highest message ID stored so far
+	messageSeq int
+	// eventIDs are IDs of message_event events which weren't answered yet
+	eventIDs     map[string]bool
+	eventSeq     int
+	eventAnswers []MessageEventAnswer
+	// failures are error codes to be returned by next calls of method
+	failures map[string][]int
+
+	// events are Long Poll events of every group, ts is index in slice
+	events     map[int][]Event
+	lpKeys     map[string]int
+	lpKeySeq   int
+	lpFailures map[int][]int
+	// eventsPushed is closed and replaced every time an event is pushed
+	eventsPushed chan struct{}
+}
+
+// NewServer creates and starts a new Server
+//
+// Server should be closed by caller when it's not needed anymore
+func NewServer() *Server {
+	s := &Server{
+		groups:       make(map[int]*Group),
+		users:        make(map[int]*User),
+		eventIDs:     make(map[string]bool),
+		failures:     make(map[string][]int),
+		events:       make(map[int][]Event),
+		lpKeys:       make(map[string]int),
+		lpFailures:   make(map[int][]int),
+		eventsPushed: make(chan struct{}),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/method/", s.serveMethod)
+	mux.HandleFunc("/longpoll", s.serveLongPoll)
+
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+// BaseURL returns URL to be used as vk.BaseAPI.BaseURL
+func (s *Server) BaseURL() string {
+	return s.URL + "/method/"
+}
+
+// NewBaseAPI creates vk.BaseAPI which makes requests to s with token
+func (s *Server) NewBaseAPI(token string) *vk.BaseAPI {
+	api, _ := vk.NewBaseAPI(vk.BaseAPIConfig{
+		AccessToken: token,
+		Client:      s.Client(),
+	})
+	api.BaseURL = s.BaseURL()
+
+	return api
+}
+
+// AddGroup adds group to server state
+func (s *Server) AddGroup(g Group) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.groups[g.ID] = &g
+}
+
+// AddUser adds user to server state
+func (s *Server) AddUser(u User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.users[u.ID] = &u
+}
+
+// FailNext makes next call of method fail with VK API error code
+//
+// Can be called several times to fail several calls
+func (s *Server) FailNext(method string, code int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures[method] = append(s.failures[method], code)
+}
+
+// FailLongPoll makes next Long Poll request of group return failed value
+//
+// With failed=2 and failed=3 current keys of the group are invalidated
+func (s *Server) FailLongPoll(groupID int, failed int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lpFailures[groupID] = append(s.lpFailures[groupID], failed)
+	s.notifyLocked()
+}
+
+// PushEvent sends event of type with object to Long Poll clients of group
+//
+// object is marshalled to JSON
+func (s *Server) PushEvent(groupID int, eventType string, object interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pushEventLocked(Event{
+		Type:    eventType,
+		Object:  object,
+		GroupID: groupID,
+	})
+}
+
+func (s *Server) pushEventLocked(e Event) {
+	s.events[e.GroupID] = append(s.events[e.GroupID], e)
+	s.notifyLocked()
+}
+
+func (s *Server) notifyLocked() {
+	close(s.eventsPushed)
+	s.eventsPushed = make(chan struct{})
+}
+
+// PushMessageNew stores message sent by user to group
+// and sends message_new event to Long Poll clients of group
+//
+// ID, ConversationID and Date of msg are filled if not set
+func (s *Server) PushMessageNew(groupID int, msg Message) Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if msg.FromID == 0 {
+		msg.FromID = msg.PeerID
+	}
+	msg.GroupID = groupID
+
+	stored := s.storeMessageLocked(msg)
+	s.pushEventLocked(Event{
+		Type:    "message_new",
+		Object:  stored.encode(),
+		GroupID: groupID,
+	})
+
+	return *stored
+}
+
+func (s *Server) storeMessageLocked(msg Message) *Message {
+	// IDs might be set by caller, so new ones are
+	// always higher than any of stored ones
+	if msg.ID == 0 {
+		msg.ID = s.messageSeq + 1
+	}
+	if msg.ID > s.messageSeq {
+		s.messageSeq = msg.ID
+	}
+
+	if msg.ConversationID == 0 {
+		for _, m := range s.messages {
+			if m.GroupID == msg.GroupID && m.PeerID == msg.PeerID && m.ConversationID > msg.ConversationID {
+				msg.ConversationID = m.ConversationID
+			}
+		}
+		msg.ConversationID++
+	}
+
+	if msg.Date == 0 {
+		msg.Date = int(time.Now().Unix())
+	}
+
+	s.messages = append(s.messages, &msg)
+	return &msg
+}
+
+// PushMessageEvent sends message_event event to Long Poll clients of group,
+// as if user has pressed callback button with payload in conversation with peerID
+//
+// Returns ID of event, which can be answered once
+func (s *Server) PushMessageEvent(groupID, userID, peerID int, payload string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.eventSeq++
+	eventID := fmt.Sprintf("event-%d", s.eventSeq)
+	s.eventIDs[eventID] = true
+
+	object := map[string]interface{}{
+		"user_id":  userID,
+		"peer_id":  peerID,
+		"event_id": eventID,
+	}
+	if payload != "" {
+		object["payload"] = json.RawMessage(payload)
+	}
+
+	s.pushEventLocked(Event{
+		Type:    "message_event",
+		Object:  object,
+		GroupID: groupID,
+	})
+
+	return eventID
+}
+
+// MessageEventAnswers returns answers to message_event events sent by group
+func (s *Server) MessageEventAnswers(groupID int) []MessageEventAnswer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var answers []MessageEventAnswer
+	for _, a := range s.eventAnswers {
+		if a.GroupID == groupID {
+			answers = append(answers, a)
+		}
+	}
+
+	return answers
+}
+
+// Messages returns messages in conversation of group with peer,
+// oldest first
+func (s *Server) Messages(groupID, peerID int) []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var msgs []Message
+	for _, m := range s.messages {
+		if m.GroupID == groupID && m.PeerID == peerID {
+			msgs = append(msgs, *m)
+		}
+	}
+
+	return msgs
+}
+
+// PostCallback sends event to Callback API server at url,
+// i.e. to vkbot.CallbackPoller, and returns its response body
+func (s *Server) PostCallback(ctx context.Context, url string, e Event) (string, error) {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(ctx)
+
+	r, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer r.Body.Close()
+
+	resp, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if r.StatusCode != http.StatusOK {
+		return string(resp), fmt.Errorf("vktest: callback server returned %v", r.Status)
+	}
+
+	return string(resp), nil
+}
+
+func (m *Message) encode() map[string]interface{} {
+	encoded := map[string]interface{}{
+		"id":                      m.ID,
+		"conversation_message_id": m.ConversationID,
+		"date":                    m.Date,
+		"peer_id":                 m.PeerID,
+		"from_id":                 m.FromID,
+		"text":                    m.Text,
+		"random_id":               m.RandomID,
+		"payload":                 m.Payload,
+		"attachments":             []interface{}{},
+		"fwd_messages":            []interface{}{},
+	}
+
+	if m.Template != "" {
+		encoded["template"] = json.RawMessage(m.Template)
+	}
+
+	return encoded
+}
+
+func (g *Group) encode() map[string]interface{} {
+	return map[string]interface{}{
+		"id":          g.ID,
+		"name":        g.Name,
+		"screen_name": g.ScreenName,
+		"is_closed":   0,
+		"type":        "group",
+	}
+}
+
+func (u *User) encode() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         u.ID,
+		"first_name": u.FirstName,
+		"last_name":  u.LastName,
+	}
+}
+
+// methodError is returned by method handlers to fail with VK API error
+type methodError struct {
+	code    int
+	message string
+}
+
+type methodHandler func(s *Server, caller int, form methodForm) (interface{}, *methodError)
+
+var methodHandlers = map[string]methodHandler{
+	"groups.getById":            (*Server).groupsGetByID,
+	"groups.getLongPollServer":  (*Server).groupsGetLongPollServer,
+	"users.get":                 (*Server).usersGet,
+	"messages.send":             (*Server).messagesSend,
+	"messages.getHistory":       (*Server).messagesGetHistory,
+	"messages.getConversations": (*Server).messagesGetConversations,
+
+	"messages.sendMessageEventAnswer": (*Server).messagesSendMessageEventAnswer,
+}
+
+type methodForm struct {
+	values map[string][]string
+}
+
+func (f methodForm) get(key string) string {
+	if v := f.values[key]; len(v) != 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (f methodForm) int(key string) int {
+	v, _ := strconv.Atoi(f.get(key))
+	return v
+}
+
+func (f methodForm) ints(key string) []int {
+	var ids []int
+	for _, part := range strings.Split(f.get(key), ",") {
+		if id, err := strconv.Atoi(strings.TrimSpace(part)); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeMethodError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, map[string]interface{}{
+		"error": map[string]interface{}{
+			"error_code":     code,
+			"error_msg":      message,
+			"request_params": []interface{}{},
+		},
+	})
+}
+
+func (s *Server) serveMethod(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	method := strings.TrimPrefix(r.URL.Path, "/method/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if codes := s.failures[method]; len(codes) != 0 {
+		s.failures[method] = codes[1:]
+		writeMethodError(w, codes[0], "vktest: injected error")
+		return
+	}
+
+	caller, ok := s.callerLocked(r.Form.Get("access_token"))
+	if !ok {
+		writeMethodError(w, vk.ErrorCodeAuthFailed, "User authorization failed: invalid access_token")
+		return
+	}
+
+	handler, ok := methodHandlers[method]
+	if !ok {
+		writeMethodError(w, vk.ErrorCodeUnknownMethod, "Unknown method passed")
+		return
+	}
+
+	resp, mErr := handler(s, caller, methodForm{r.Form})
+	if mErr != nil {
+		writeMethodError(w, mErr.code, mErr.message)
+		return
+	}
+
+	writeJSON(w, map[string]interface{}{"response": resp})
+}
+
+// callerLocked returns owner of token: user ID or minus group ID
+func (s *Server) callerLocked(token string) (int, bool) {
+	if token == "" {
+		return 0, false
+	}
+
+	for _, g := range s.groups {
+		if g.Token == token {
+			return -g.ID, true
+		}
+	}
+
+	for _, u := range s.users {
+		if u.Token == token {
+			return u.ID, true
+		}
+	}
+
+	return 0, false
+}
+
+func (s *Server) groupsGetByID(caller int, form methodForm) (interface{}, *methodError) {
+	ids := form.ints("group_ids")
+	ids = append(ids, form.ints("group_id")...)
+
+	if len(ids) == 0 {
+		if caller > 0 {
+			return nil, &methodError{vk.ErrorCodeParam, "One of the parameters specified was missing or invalid: group_ids is undefined"}
+		}
+		ids = []int{-caller}
+	}
+
+	groups := []interface{}{}
+	for _, id := range ids {
+		// vkbot passes 0 when GroupID is unknown
+		if id == 0 && caller < 0 {
+			id = -caller
+		}
+
+		if g, ok := s.groups[id]; ok {
+			groups = append(groups, g.encode())
+		}
+	}
+
+	if len(groups) == 0 {
+		return nil, &methodError{vk.ErrorCodeParam, "One of the parameters specified was missing or invalid: group_ids is undefined"}
+	}
+
+	return groups, nil
+}
+
+func (s *Server) groupsGetLongPollServer(caller int, form methodForm) (interface{}, *methodError) {
+	groupID := form.int("group_id")
+	if caller != -groupID {
+		return nil, &methodError{vk.ErrorCodeAccessDenied, "Access denied: no access to call this method"}
+	}
+
+	s.lpKeySeq++
+	key := fmt.Sprintf("key-%d-%d", groupID, s.lpKeySeq)
+	s.lpKeys[key] = groupID
+
+	return map[string]interface{}{
+		"key":    key,
+		"server": s.URL + "/longpoll",
+		"ts":     strconv.Itoa(len(s.events[groupID])),
+	}, nil
+}
+
+func (s *Server) usersGet(caller int, form methodForm) (interface{}, *methodError) {
+	ids := form.ints("user_ids")
+	if len(ids) == 0 && caller > 0 {
+		ids = []int{caller}
+	}
+
+	users := []interface{}{}
+	for _, id := range ids {
+		if u, ok := s.users[id]; ok {
+			users = append(users, u.encode())
+		}
+	}
+
+	return users, nil
+}
+
+func (s *Server) messagesSend(caller int, form methodForm) (interface{}, *methodError) {
+	if caller > 0 {
+		return nil, &methodError{vk.ErrorCodeNotImplemented, "vktest: only group tokens can send messages"}
+	}
+
+	peerID := form.int("peer_id")
+	if peerID == 0 {
+		peerID = form.int("user_id")
+	}
+	if peerID == 0 {
+		return nil, &methodError{vk.ErrorCodeParam, "One of the parameters specified was missing or invalid: peer_id is undefined"}
+	}
+
+	msg := Message{
+		GroupID:  -caller,
+		PeerID:   peerID,
+		FromID:   caller,
+		Text:     form.get("message"),
+		RandomID: form.int("random_id"),
+		Payload:  form.get("payload"),
+		Keyboard: form.get("keyboard"),
+		Template: form.get("template"),
+	}
+
+	if msg.Text == "" && form.get("attachment") == "" {
+		return nil, &methodError{vk.ErrorCodeParam, "One of the parameters specified was missing or invalid: message is empty or invalid"}
+	}
+
+	// VK doesn't send message twice if random_id is the same
+	if msg.RandomID != 0 {
+		for _, m := range s.messages {
+			if m.GroupID == msg.GroupID && m.FromID == msg.FromID && m.RandomID == msg.RandomID {
+				return m.ID, nil
+			}
+		}
+	}
+
+	return s.storeMessageLocked(msg).ID, nil
+}
+
+func (s *Server) messagesGetHistory(caller int, form methodForm) (interface{}, *methodError) {
+	if caller > 0 {
+		return nil, &methodError{vk.ErrorCodeNotImplemented, "vktest: only group tokens can read messages"}
+	}
+
+	peerID := form.int("peer_id")
+	if peerID == 0 {
+		peerID = form.int("user_id")
+	}
+
+	var history []*Message
+	for i := len(s.messages) - 1; i >= 0; i-- {
+		if m := s.messages[i]; m.GroupID == -caller && m.PeerID == peerID {
+			history = append(history, m)
+		}
+	}
+
+	offset, count := form.int("offset"), form.int("count")
+	if count == 0 {
+		count = 20
+	}
+
+	items := []interface{}{}
+	for i := offset; i < len(history) && i < offset+count; i++ {
+		items = append(items, history[i].encode())
+	}
+
+	return map[string]interface{}{
+		"count": len(history),
+		"items": items,
+	}, nil
+}
+
+func (s *Server) messagesGetConversations(caller int, form methodForm) (interface{}, *methodError) {
+	if caller > 0 {
+		return nil, &methodError{vk.ErrorCodeNotImplemented, "vktest: only group tokens can read messages"}
+	}
+
+	last := make(map[int]*Message)
+	for _, m := range s.messages {
+		if m.GroupID == -caller {
+			last[m.PeerID] = m
+		}
+	}
+
+	peers := make([]int, 0, len(last))
+	for peer := range last {
+		peers = append(peers, peer)
+	}
+	// most recent conversations first
+	sort.Slice(peers, func(i, j int) bool {
+		return last[peers[i]].ID > last[peers[j]].ID
+	})
+
+	items := []interface{}{}
+	for _, peer := range peers {
+		peerType := "user"
+		switch {
+		case peer > 2000000000:
+			peerType = "chat"
+		case peer < 0:
+			peerType = "group"
+		}
+
+		items = append(items, map[string]interface{}{
+			"conversation": map[string]interface{}{
+				"peer": map[string]interface{}{
+					"id":       peer,
+					"type":     peerType,
+					"local_id": peer,
+				},
+			},
+			"last_message": last[peer].encode(),
+		})
+	}
+
+	return map[string]interface{}{
+		"count": len(items),
+		"items": items,
+	}, nil
+}
+
+func (s *Server) serveLongPoll(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	if q.Get("act") != "a_check" {
+		http.Error(w, "act must be a_check", http.StatusBadRequest)
+		return
+	}
+
+	ts, err := strconv.Atoi(q.Get("ts"))
+	if err != nil {
+		writeJSON(w, map[string]interface{}{"failed": 1, "ts": "0"})
+		return
+	}
+
+	wait, _ := strconv.Atoi(q.Get("wait"))
+	deadline := time.After(time.Duration(wait) * time.Second)
+
+	for {
+		s.mu.Lock()
+
+		groupID, ok := s.lpKeys[q.Get("key")]
+		if !ok {
+			s.mu.Unlock()
+			writeJSON(w, map[string]interface{}{"failed": 2})
+			return
+		}
+
+		events := s.events[groupID]
+
+		if failures := s.lpFailures[groupID]; len(failures) != 0 {
+			s.lpFailures[groupID] = failures[1:]
+			if failures[0] == 2 || failures[0] == 3 {
+				s.invalidateKeysLocked(groupID)
+			}
+			s.mu.Unlock()
+
+			writeJSON(w, map[string]interface{}{
+				"failed": failures[0],
+				"ts":     strconv.Itoa(len(events)),
+			})
+			return
+		}
+
+		if ts > len(events) {
+			s.mu.Unlock()
+			writeJSON(w, map[string]interface{}{"failed": 1, "ts": strconv.Itoa(len(events))})
+			return
+		}
+
+		pushed := s.eventsPushed
+		s.mu.Unlock()
+
+		if ts < len(events) {
+			writeJSON(w, map[string]interface{}{
+				"ts":      strconv.Itoa(len(events)),
+				"updates": events[ts:],
+			})
+			return
+		}
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-deadline:
+			writeJSON(w, map[string]interface{}{
+				"ts":      strconv.Itoa(ts),
+				"updates": []interface{}{},
+			})
+			return
+		case <-pushed:
+		}
+	}
+}
+
+func (s *Server) invalidateKeysLocked(groupID int) {
+	for key, id := range s.lpKeys {
+		if id == groupID {
+			delete(s.lpKeys, key)
+		}
+	}
+}
+
+func (s *Server) messagesSendMessageEventAnswer(caller int, form methodForm) (interface{}, *methodError) {
+	if caller > 0 {
+		return nil, &methodError{vk.ErrorCodeNotImplemented, "vktest: only group tokens can answer events"}
+	}
+
+	eventID := form.get("event_id")
+	if !s.eventIDs[eventID] {
+		return nil, &methodError{vk.ErrorCodeParam, "One of the parameters specified was missing or invalid: event_id is invalid"}
+	}
+	delete(s.eventIDs, eventID)
+
+	s.eventAnswers = append(s.eventAnswers, MessageEventAnswer{
+		GroupID:   -caller,
+		EventID:   eventID,
+		UserID:    form.int("user_id"),
+		PeerID:    form.int("peer_id"),
+		EventData: form.get("event_data"),
+	})
+
+	return 1, nil
+}
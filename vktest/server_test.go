@@ -0,0 +1,369 @@
+package vktest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stek29/vk"
+	"github.com/stek29/vk/vkapi"
+	"github.com/stek29/vk/vkbot"
+)
+
+const (
+	testGroupID    = 1
+	testGroupToken = "group-token"
+	testUserID     = 100
+)
+
+func newTestServer(t *testing.T) *Server {
+	s := NewServer()
+	t.Cleanup(s.Close)
+
+	s.AddGroup(Group{ID: testGroupID, Name: "Test Group", Token: testGroupToken})
+	s.AddUser(User{ID: testUserID, FirstName: "Test", LastName: "User"})
+
+	return s
+}
+
+// waitLongPoll waits for Long Poll client to get server,
+// since events pushed before that are lost, like with real VK
+func waitLongPoll(s *Server) {
+	for {
+		s.mu.Lock()
+		started := len(s.lpKeys) != 0
+		s.mu.Unlock()
+		if started {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestServerLongPollBot(t *testing.T) {
+	s := newTestServer(t)
+
+	bot, err := vkbot.NewBot(s.NewBaseAPI(testGroupToken), vkbot.BotConfig{
+		Poller: &vkbot.LongPoller{Wait: time.Second},
+	})
+	if err != nil {
+		t.Fatalf("Cant create bot: %v", err)
+	}
+
+	if bot.GroupID != testGroupID {
+		t.Errorf("Expected bot to run as group %v, got %v", testGroupID, bot.GroupID)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events, err := bot.StartPolling(ctx, 0)
+	if err != nil {
+		t.Fatalf("Cant start polling: %v", err)
+	}
+
+	waitLongPoll(s)
+
+	// poller should recover from expired key
+	s.FailLongPoll(testGroupID, 2)
+	s.PushMessageNew(testGroupID, Message{PeerID: testUserID, Text: "ping"})
+
+	var msg vk.MessageNew
+	select {
+	case e := <-events:
+		var ok bool
+		if msg, ok = e.Event.(vk.MessageNew); !ok {
+			t.Fatalf("Expected MessageNew, got %T", e.Event)
+		}
+	case <-ctx.Done():
+		t.Fatalf("No event received")
+	}
+
+	if msg.Text != "ping" || msg.PeerID != testUserID {
+		t.Errorf("Unexpected message: %+v", msg)
+	}
+
+	_, err = vkapi.Messages{API: bot}.SendContext(ctx, vkapi.MessagesSendParams{
+		PeerID:   msg.PeerID,
+		Message:  "pong",
+		RandomID: 42,
+	})
+	if err != nil {
+		t.Fatalf("Cant send message: %v", err)
+	}
+
+	history := s.Messages(testGroupID, testUserID)
+	if len(history) != 2 || history[1].Text != "pong" || history[1].FromID != -testGroupID {
+		t.Errorf("Unexpected conversation state: %+v", history)
+	}
+}
+
+func TestServerMessageIDs(t *testing.T) {
+	s := newTestServer(t)
+
+	var ids, convIDs []int
+	for _, id := range []int{5, 0, 2, 0} {
+		msg := s.PushMessageNew(testGroupID, Message{ID: id, PeerID: testUserID, Text: "hi"})
+		ids = append(ids, msg.ID)
+		convIDs = append(convIDs, msg.ConversationID)
+	}
+
+	if fmt.Sprint(ids) != "[5 6 2 7]" {
+		t.Errorf("Unexpected message IDs: %v", ids)
+	}
+	if fmt.Sprint(convIDs) != "[1 2 3 4]" {
+		t.Errorf("Unexpected conversation message IDs: %v", convIDs)
+	}
+}
+
+func TestServerFailNext(t *testing.T) {
+	s := newTestServer(t)
+	api := s.NewBaseAPI(testGroupToken)
+
+	s.FailNext("messages.send", vk.ErrorCodeTooManyRequests)
+
+	params := vkapi.MessagesSendParams{PeerID: testUserID, Message: "hi"}
+	if _, err := (vkapi.Messages{API: api}).Send(params); !errors.Is(err, vk.ErrTooManyRequests) {
+		t.Errorf("Expected ErrTooManyRequests, got %v", err)
+	}
+
+	if _, err := (vkapi.Messages{API: api}).Send(params); err != nil {
+		t.Errorf("Expected second call to succeed, got %v", err)
+	}
+
+	if _, err := s.NewBaseAPI("invalid").Request("users.get", nil); !errors.Is(err, vk.ErrAuthFailed) {
+		t.Errorf("Expected ErrAuthFailed for unknown token, got %v", err)
+	}
+}
+
+func TestServerCallback(t *testing.T) {
+	s := newTestServer(t)
+
+	poller := &vkbot.CallbackPoller{
+		GroupConfigs: []vkbot.CallbackGroupConfig{
+			{GroupID: testGroupID, Secret: "secret", Confirmation: "confirm-me"},
+		},
+	}
+	callbackSrv := httptest.NewServer(poller)
+	defer callbackSrv.Close()
+
+	bot, err := vkbot.NewBot(s.NewBaseAPI(testGroupToken), vkbot.BotConfig{Poller: poller})
+	if err != nil {
+		t.Fatalf("Cant create bot: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := s.PostCallback(ctx, callbackSrv.URL, Event{Type: "confirmation", GroupID: testGroupID, Secret: "secret"})
+	if err != nil || resp != "confirm-me" {
+		t.Errorf("Unexpected confirmation response: %q, %v", resp, err)
+	}
+
+	events, _ := bot.StartPolling(ctx, 0)
+
+	msg := Message{ID: 1, PeerID: testUserID, FromID: testUserID, Text: "hello"}
+	for {
+		// poller might not be running yet, VK would retry in that case too
+		resp, err = s.PostCallback(ctx, callbackSrv.URL, Event{
+			Type:    "message_new",
+			Object:  msg.encode(),
+			GroupID: testGroupID,
+			Secret:  "secret",
+		})
+		if err == nil || ctx.Err() != nil {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if err != nil || resp != "ok\n" {
+		t.Errorf("Unexpected event response: %q, %v", resp, err)
+	}
+
+	select {
+	case e := <-events:
+		if m, ok := e.Event.(vk.MessageNew); !ok || m.Text != "hello" {
+			t.Errorf("Unexpected event: %+v", e)
+		}
+	case <-ctx.Done():
+		t.Fatalf("No event received")
+	}
+}
+
+func TestServerMessageEvent(t *testing.T) {
+	s := newTestServer(t)
+
+	bot, err := vkbot.NewBot(s.NewBaseAPI(testGroupToken), vkbot.BotConfig{
+		Poller:              &vkbot.LongPoller{Wait: time.Second},
+		MessageEventTimeout: 50 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Cant create bot: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events, _ := bot.StartPolling(ctx, 0)
+	waitLongPoll(s)
+
+	s.PushMessageEvent(testGroupID, testUserID, testUserID, `{"command":"like"}`)
+	s.PushMessageEvent(testGroupID, testUserID, testUserID, `{"command":"slow"}`)
+
+	for i := 0; i < 2; i++ {
+		var evt vk.MessageEvent
+		select {
+		case e := <-events:
+			var ok bool
+			if evt, ok = e.Event.(vk.MessageEvent); !ok {
+				t.Fatalf("Expected MessageEvent, got %T", e.Event)
+			}
+		case <-ctx.Done():
+			t.Fatalf("No event received")
+		}
+
+		err := bot.HandleMessageEvent(ctx, evt, func(ctx context.Context) (*vkapi.EventData, error) {
+			if string(evt.Payload) == `{"command":"slow"}` {
+				time.Sleep(100 * time.Millisecond)
+			}
+			return vkapi.ShowSnackbar("Liked"), nil
+		})
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	}
+
+	answers := s.MessageEventAnswers(testGroupID)
+	if len(answers) != 2 {
+		t.Fatalf("Expected 2 answers, got %+v", answers)
+	}
+
+	if answers[0].EventData != `{"type":"show_snackbar","text":"Liked"}` || answers[0].UserID != testUserID {
+		t.Errorf("Unexpected answer: %+v", answers[0])
+	}
+
+	// slow handler's event is answered without action
+	if answers[1].EventData != "" {
+		t.Errorf("Unexpected answer to slow event: %+v", answers[1])
+	}
+
+	// stuck handler doesn't block caller once ctx is done
+	s.PushMessageEvent(testGroupID, testUserID, testUserID, `{"command":"stuck"}`)
+
+	var evt vk.MessageEvent
+	select {
+	case e := <-events:
+		evt, _ = e.Event.(vk.MessageEvent)
+	case <-ctx.Done():
+		t.Fatalf("No event received")
+	}
+
+	release := make(chan struct{})
+	defer close(release)
+
+	stuckCtx, stuckCancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer stuckCancel()
+
+	err = bot.HandleMessageEvent(stuckCtx, evt, func(ctx context.Context) (*vkapi.EventData, error) {
+		<-release
+		return nil, nil
+	})
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+
+	if answers := s.MessageEventAnswers(testGroupID); len(answers) != 3 {
+		t.Errorf("Expected stuck event to be answered, got %+v", answers)
+	}
+}
+
+func TestServerCarousel(t *testing.T) {
+	s := newTestServer(t)
+	messages := vkapi.Messages{API: s.NewBaseAPI(testGroupToken)}
+
+	carousel := vk.NewCarousel().
+		AddElement(vk.TemplateElement{Title: "Cat", Description: "Meows", PhotoID: "-1_2"}).
+		AddCallback("Buy", map[string]int{"buy": 1}, vk.ButtonPositive).
+		AddElement(vk.TemplateElement{Title: "Dog", Description: "Barks", PhotoID: "-1_3"}).
+		AddCallback("Buy", map[string]int{"buy": 2}, vk.ButtonPositive)
+
+	_, err := messages.Send(vkapi.MessagesSendParams{PeerID: testUserID, Message: "Pets", Template: carousel})
+	if err != nil {
+		t.Fatalf("Cant send carousel: %v", err)
+	}
+
+	history, err := messages.GetHistory(vkapi.MessagesGetHistoryParams{PeerID: testUserID})
+	if err != nil {
+		t.Fatalf("Cant get history: %v", err)
+	}
+
+	if len(history.Items) != 1 {
+		t.Fatalf("Unexpected history: %+v", history)
+	}
+
+	tmpl := history.Items[0].Template
+	if tmpl == nil || tmpl.Type != vk.TemplateCarousel || len(tmpl.Elements) != 2 ||
+		tmpl.Elements[1].Title != "Dog" || tmpl.Elements[1].Buttons[0].Action.Payload != `{"buy":2}` {
+		t.Errorf("Unexpected template: %+v", tmpl)
+	}
+
+	// invalid carousel isn't sent
+	_, err = messages.Send(vkapi.MessagesSendParams{PeerID: testUserID, Message: "Empty", Template: vk.NewCarousel()})
+	if err == nil {
+		t.Errorf("Expected empty carousel to fail")
+	}
+}
+
+func TestServerCommands(t *testing.T) {
+	s := newTestServer(t)
+
+	bot, err := vkbot.NewBot(s.NewBaseAPI(testGroupToken), vkbot.BotConfig{
+		Poller: &vkbot.LongPoller{Wait: time.Second},
+	})
+	if err != nil {
+		t.Fatalf("Cant create bot: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	commands := vkbot.NewCommands(bot, vkbot.CommandsConfig{HelpCommand: "help"})
+	commands.Add(vkbot.Command{
+		Name:        "ban",
+		Args:        []vkbot.Arg{{Name: "user", Type: vkbot.ArgUser}},
+		Description: "Ban user",
+		Handler: func(ctx context.Context, req *vkbot.CommandRequest) error {
+			return nil
+		},
+	})
+
+	router := vkbot.NewRouter(bot)
+	router.OnMessageNew(commands.Handle)
+
+	go router.Run(ctx)
+	waitLongPoll(s)
+
+	// help is sent as reply by bot
+	s.PushMessageNew(testGroupID, Message{PeerID: 2000000001, FromID: testUserID, Text: "[club1|@bot] help"})
+
+	expected := "/help — Show list of commands\n/ban <user> — Ban user"
+	for {
+		for _, m := range s.Messages(testGroupID, 2000000001) {
+			if m.FromID == -testGroupID {
+				if m.Text != expected {
+					t.Errorf("Expected reply %q, got %q", expected, m.Text)
+				}
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			t.Fatalf("Bot didn't reply")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package vkupload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/stek29/vk"
+	"github.com/stek29/vk/vkapi"
+)
+
+// Doc is uploaded document or voice message
+type Doc struct {
+	vk.Document
+	// Attachment is attachment string, i.e. "doc1_2_key"
+	Attachment string
+}
+
+// docSaveResponse is docs.save response
+//
+// Since 5.78 it's an object with type and field named after it,
+// older versions return array of documents
+type docSaveResponse struct {
+	Type         string       `json:"type"`
+	Doc          *vk.Document `json:"doc"`
+	AudioMessage *vk.Document `json:"audio_message"`
+	Graffiti     *vk.Document `json:"graffiti"`
+}
+
+func decodeDocSaveResponse(r json.RawMessage) (*Doc, error) {
+	var doc *vk.Document
+
+	if len(r) != 0 && r[0] == '[' {
+		var docs []vk.Document
+		if err := json.Unmarshal(r, &docs); err != nil {
+			return nil, err
+		}
+		if len(docs) != 0 {
+			doc = &docs[0]
+		}
+	} else {
+		var resp docSaveResponse
+		if err := json.Unmarshal(r, &resp); err != nil {
+			return nil, err
+		}
+		switch {
+		case resp.Doc != nil:
+			doc = resp.Doc
+		case resp.AudioMessage != nil:
+			doc = resp.AudioMessage
+		case resp.Graffiti != nil:
+			doc = resp.Graffiti
+		}
+	}
+
+	if doc == nil {
+		return nil, errors.New("vkupload: VK did not return saved document")
+	}
+
+	// voice messages and graffiti are attached as documents too
+	return &Doc{
+		Document:   *doc,
+		Attachment: attachment("doc", doc.OwnerID, doc.ID, doc.AccessKey),
+	}, nil
+}
+
+func (u *Uploader) postDoc(ctx context.Context, uploadURL string, params vkapi.DocsSaveParams, name string, r io.Reader) (*Doc, error) {
+	var resp struct {
+		File string `json:"file"`
+	}
+	if err := u.post(ctx, uploadURL, "file", name, r, &resp); err != nil {
+		return nil, err
+	}
+	if resp.File == "" {
+		return nil, &UploadError{Message: "document was not uploaded"}
+	}
+
+	params.File = resp.File
+
+	// docs.save is called directly, since its response differs
+	// between API versions, see docSaveResponse
+	saved, err := vk.RequestContext(ctx, u.api, "docs.save", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeDocSaveResponse(saved)
+}
+
+// Doc uploads document to documents of current user,
+// or of community with groupID if it's not 0
+//
+// params.Title and params.Tags are passed to docs.save,
+// params.File is set by Doc
+func (u *Uploader) Doc(ctx context.Context, groupID int, params vkapi.DocsSaveParams, name string, r io.Reader) (*Doc, error) {
+	srv, err := vkapi.Docs{API: u.api}.GetUploadServerContext(ctx, vkapi.DocsGetUploadServerParams{
+		GroupID: groupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.postDoc(ctx, srv.UploadURL, params, name, r)
+}
+
+// WallDoc uploads document which can be posted on wall
+// of current user, or of community with groupID if it's not 0
+func (u *Uploader) WallDoc(ctx context.Context, groupID int, params vkapi.DocsSaveParams, name string, r io.Reader) (*Doc, error) {
+	srv, err := vkapi.Docs{API: u.api}.GetWallUploadServerContext(ctx, vkapi.DocsGetWallUploadServerParams{
+		GroupID: groupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.postDoc(ctx, srv.UploadURL, params, name, r)
+}
+
+// MessageDoc uploads document which can be sent in message to peerID
+func (u *Uploader) MessageDoc(ctx context.Context, peerID int, params vkapi.DocsSaveParams, name string, r io.Reader) (*Doc, error) {
+	srv, err := vkapi.Docs{API: u.api}.GetMessagesUploadServerContext(ctx, vkapi.DocsGetMessagesUploadServerParams{
+		Type:   "doc",
+		PeerID: peerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.postDoc(ctx, srv.UploadURL, params, name, r)
+}
+
+// VoiceMessage uploads voice message which can be sent to peerID
+//
+// VK expects it to be mono OGG file encoded with Opus, 16kHz
+func (u *Uploader) VoiceMessage(ctx context.Context, peerID int, name string, r io.Reader) (*Doc, error) {
+	srv, err := vkapi.Docs{API: u.api}.GetMessagesUploadServerContext(ctx, vkapi.DocsGetMessagesUploadServerParams{
+		Type:   "audio_message",
+		PeerID: peerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.postDoc(ctx, srv.UploadURL, vkapi.DocsSaveParams{}, name, r)
+}
@@ -0,0 +1,210 @@
+package vkupload
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/stek29/vk"
+	"github.com/stek29/vk/vkapi"
+)
+
+// Photo is uploaded photo
+type Photo struct {
+	vk.Photo
+	// Attachment is attachment string, i.e. "photo1_2_key"
+	Attachment string
+}
+
+func newPhoto(photos []vk.Photo) (*Photo, error) {
+	if len(photos) == 0 {
+		return nil, errors.New("vkupload: VK did not return saved photo")
+	}
+
+	p := photos[0]
+	return &Photo{
+		Photo:      p,
+		Attachment: attachment("photo", p.OwnerID, p.ID, p.AccessKey),
+	}, nil
+}
+
+// photoUploadResponse is upload server response for photos
+type photoUploadResponse struct {
+	Server   int    `json:"server"`
+	Photo    string `json:"photo"`
+	Hash     string `json:"hash"`
+	CropData string `json:"crop_data"`
+	CropHash string `json:"crop_hash"`
+}
+
+func (r photoUploadResponse) check() error {
+	// upload server returns empty list if file wasn't a photo
+	if r.Photo == "" || r.Photo == "[]" {
+		return &UploadError{Message: "photo was not uploaded"}
+	}
+	return nil
+}
+
+func (u *Uploader) postPhoto(ctx context.Context, uploadURL, field, name string, r io.Reader) (photoUploadResponse, error) {
+	var resp photoUploadResponse
+	if err := u.post(ctx, uploadURL, field, name, r, &resp); err != nil {
+		return resp, err
+	}
+	return resp, resp.check()
+}
+
+// MessagePhoto uploads photo which can be sent in message to peerID
+func (u *Uploader) MessagePhoto(ctx context.Context, peerID int, name string, r io.Reader) (*Photo, error) {
+	photos := vkapi.Photos{API: u.api}
+
+	srv, err := photos.GetMessagesUploadServerContext(ctx, vkapi.PhotosGetMessagesUploadServerParams{
+		PeerID: peerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := u.postPhoto(ctx, srv.UploadURL, "photo", name, r)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := photos.SaveMessagesPhotoContext(ctx, vkapi.PhotosSaveMessagesPhotoParams{
+		Server: resp.Server,
+		Photo:  resp.Photo,
+		Hash:   resp.Hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newPhoto(saved)
+}
+
+// WallPhoto uploads photo which can be posted on wall
+//
+// params.GroupID (or params.UserID) is wall owner, params.Photo,
+// params.Server and params.Hash are set by WallPhoto
+func (u *Uploader) WallPhoto(ctx context.Context, params vkapi.PhotosSaveWallPhotoParams, name string, r io.Reader) (*Photo, error) {
+	photos := vkapi.Photos{API: u.api}
+
+	srv, err := photos.GetWallUploadServerContext(ctx, vkapi.PhotosGetWallUploadServerParams{
+		GroupID: params.GroupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := u.postPhoto(ctx, srv.UploadURL, "photo", name, r)
+	if err != nil {
+		return nil, err
+	}
+
+	params.Server = resp.Server
+	params.Photo = resp.Photo
+	params.Hash = resp.Hash
+
+	saved, err := photos.SaveWallPhotoContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPhoto(saved)
+}
+
+// MarketPhoto uploads photo of market item
+func (u *Uploader) MarketPhoto(ctx context.Context, params vkapi.PhotosGetMarketUploadServerParams, name string, r io.Reader) (*Photo, error) {
+	photos := vkapi.Photos{API: u.api}
+
+	srv, err := photos.GetMarketUploadServerContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := u.postPhoto(ctx, srv.UploadURL, "file", name, r)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := photos.SaveMarketPhotoContext(ctx, vkapi.PhotosSaveMarketPhotoParams{
+		GroupID:  params.GroupID,
+		Server:   resp.Server,
+		Photo:    resp.Photo,
+		Hash:     resp.Hash,
+		CropData: resp.CropData,
+		CropHash: resp.CropHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newPhoto(saved)
+}
+
+// OwnerPhoto uploads and sets main photo of user or community
+//
+// ownerID is negative for communities, 0 means current user
+func (u *Uploader) OwnerPhoto(ctx context.Context, ownerID int, name string, r io.Reader) (*vkapi.PhotosSaveOwnerPhotoResponse, error) {
+	photos := vkapi.Photos{API: u.api}
+
+	srv, err := photos.GetOwnerPhotoUploadServerContext(ctx, vkapi.PhotosGetOwnerPhotoUploadServerParams{
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := u.postPhoto(ctx, srv.UploadURL, "photo", name, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return photos.SaveOwnerPhotoContext(ctx, vkapi.PhotosSaveOwnerPhotoParams{
+		Server: strconv.Itoa(resp.Server),
+		Photo:  resp.Photo,
+		Hash:   resp.Hash,
+	})
+}
+
+// OwnerCoverPhoto uploads and sets cover photo of community
+func (u *Uploader) OwnerCoverPhoto(ctx context.Context, params vkapi.PhotosGetOwnerCoverPhotoUploadServerParams, name string, r io.Reader) (vkapi.PhotosSaveOwnerCoverPhotoResponse, error) {
+	photos := vkapi.Photos{API: u.api}
+
+	srv, err := photos.GetOwnerCoverPhotoUploadServerContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := u.postPhoto(ctx, srv.UploadURL, "photo", name, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return photos.SaveOwnerCoverPhotoContext(ctx, vkapi.PhotosSaveOwnerCoverPhotoParams{
+		Photo: resp.Photo,
+		Hash:  resp.Hash,
+	})
+}
+
+// ChatPhoto uploads and sets photo of chat
+func (u *Uploader) ChatPhoto(ctx context.Context, params vkapi.PhotosGetChatUploadServerParams, name string, r io.Reader) (*vkapi.MessagesSetChatPhotoResponse, error) {
+	srv, err := vkapi.Photos{API: u.api}.GetChatUploadServerContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Response string `json:"response"`
+	}
+	if err := u.post(ctx, srv.UploadURL, "file", name, r, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Response == "" {
+		return nil, &UploadError{Message: "photo was not uploaded"}
+	}
+
+	return vkapi.Messages{API: u.api}.SetChatPhotoContext(ctx, vkapi.MessagesSetChatPhotoParams{
+		File: resp.Response,
+	})
+}
@@ -0,0 +1,93 @@
+package vkupload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/stek29/vk"
+	"github.com/stek29/vk/vkapi"
+)
+
+// Story is uploaded story
+type Story struct {
+	vk.Story
+	// Attachment is attachment string, i.e. "story1_2_key"
+	Attachment string
+}
+
+// storyUploadResponse is upload server response for stories
+//
+// Older API versions return story right away, newer ones return
+// upload_result which should be passed to stories.save
+type storyUploadResponse struct {
+	Response struct {
+		Story        *vk.Story `json:"story"`
+		UploadResult string    `json:"upload_result"`
+	} `json:"response"`
+}
+
+type storiesSaveParams struct {
+	UploadResults vkapi.CSVStringSlice `url:"upload_results"`
+}
+
+type storiesSaveResponse struct {
+	Count int        `json:"count"`
+	Items []vk.Story `json:"items"`
+}
+
+func (u *Uploader) postStory(ctx context.Context, uploadURL, field, name string, r io.Reader) (*Story, error) {
+	var resp storyUploadResponse
+	if err := u.post(ctx, uploadURL, field, name, r, &resp); err != nil {
+		return nil, err
+	}
+
+	story := resp.Response.Story
+
+	if story == nil && resp.Response.UploadResult != "" {
+		raw, err := vk.RequestContext(ctx, u.api, "stories.save", storiesSaveParams{
+			UploadResults: vkapi.CSVStringSlice{resp.Response.UploadResult},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var saved storiesSaveResponse
+		if err := json.Unmarshal(raw, &saved); err != nil {
+			return nil, err
+		}
+		if len(saved.Items) != 0 {
+			story = &saved.Items[0]
+		}
+	}
+
+	if story == nil {
+		return nil, errors.New("vkupload: VK did not return saved story")
+	}
+
+	return &Story{
+		Story:      *story,
+		Attachment: attachment("story", story.OwnerID, story.ID, story.AccessKey),
+	}, nil
+}
+
+// PhotoStory uploads photo story
+func (u *Uploader) PhotoStory(ctx context.Context, params vkapi.StoriesGetPhotoUploadServerParams, name string, r io.Reader) (*Story, error) {
+	srv, err := vkapi.Stories{API: u.api}.GetPhotoUploadServerContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.postStory(ctx, srv.UploadURL, "file", name, r)
+}
+
+// VideoStory uploads video story
+func (u *Uploader) VideoStory(ctx context.Context, params vkapi.StoriesGetVideoUploadServerParams, name string, r io.Reader) (*Story, error) {
+	srv, err := vkapi.Stories{API: u.api}.GetVideoUploadServerContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.postStory(ctx, srv.UploadURL, "video_file", name, r)
+}
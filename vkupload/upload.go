@@ -0,0 +1,201 @@
+// Package vkupload implements uploading files to VK
+//
+// Every upload is done in three steps: upload server is requested with API,
+// file is sent to it with multipart POST request, and then uploaded file
+// is saved with API. Uploader does all of them at once.
+//
+// Files are streamed to upload server, so they're never
+// buffered in memory as a whole.
+//
+// Usage:
+//
+//	u := vkupload.NewUploader(api, vkupload.UploaderConfig{})
+//	f, _ := os.Open("cat.jpg")
+//	photo, _ := u.MessagePhoto(ctx, peerID, "cat.jpg", f)
+//	vkapi.Messages{API: api}.Send(vkapi.MessagesSendParams{
+//		PeerID:     peerID,
+//		Attachment: vkapi.CSVStringSlice{photo.Attachment},
+//	})
+package vkupload
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"github.com/stek29/vk"
+)
+
+// UploaderConfig represents configuration used for Uploader creation
+type UploaderConfig struct {
+	// Optional: client used for requests to upload servers,
+	// if nil, HTTPClient of API is used
+	Client *http.Client
+	// Optional: size of chunks videos are uploaded with, if 0, 8MB is used;
+	// if negative, videos are uploaded with one multipart request
+	VideoChunkSize int64
+	// Optional: how many times failed chunk is retried, if 0, 5 is used
+	MaxRetries int
+	// Optional: how often imported videos are checked for being processed,
+	// if 0, 5 seconds is used
+	PollInterval time.Duration
+	// Optional: how long VideoLink waits for imported video
+	// to be processed, if 0, 10 minutes is used
+	MaxProcessingWait time.Duration
+}
+
+// Uploader uploads files to VK
+type Uploader struct {
+	api    vk.API
+	client *http.Client
+
+	chunkSize         int64
+	maxRetries        int
+	pollInterval      time.Duration
+	maxProcessingWait time.Duration
+}
+
+// NewUploader creates a new Uploader which uses api for API requests
+func NewUploader(api vk.API, cfg UploaderConfig) *Uploader {
+	client := cfg.Client
+	if client == nil {
+		client = api.HTTPClient()
+	}
+
+	chunkSize := cfg.VideoChunkSize
+	if chunkSize == 0 {
+		chunkSize = defaultVideoChunkSize
+	}
+
+	maxRetries := cfg.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultMaxRetries
+	}
+
+	pollInterval := cfg.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+
+	maxProcessingWait := cfg.MaxProcessingWait
+	if maxProcessingWait <= 0 {
+		maxProcessingWait = defaultMaxProcessingWait
+	}
+
+	return &Uploader{
+		api:    api,
+		client: client,
+
+		chunkSize:         chunkSize,
+		maxRetries:        maxRetries,
+		pollInterval:      pollInterval,
+		maxProcessingWait: maxProcessingWait,
+	}
+}
+
+// UploadError is error returned by upload server
+type UploadError struct {
+	Message string
+}
+
+// Error implements error interface
+func (e *UploadError) Error() string {
+	return fmt.Sprintf("vkupload.UploadError: %s", e.Message)
+}
+
+// uploadErrorResponse is part of upload server response with error
+type uploadErrorResponse struct {
+	Error      json.RawMessage `json:"error"`
+	ErrorDescr string          `json:"error_descr"`
+}
+
+func (r uploadErrorResponse) err() error {
+	if len(r.Error) == 0 || string(r.Error) == "null" {
+		return nil
+	}
+
+	msg := string(r.Error)
+
+	var s string
+	if json.Unmarshal(r.Error, &s) == nil {
+		msg = s
+	}
+
+	if r.ErrorDescr != "" {
+		msg += ": " + r.ErrorDescr
+	}
+
+	return &UploadError{Message: msg}
+}
+
+// post sends file from r to uploadURL as multipart form field,
+// and decodes upload server response into dest
+//
+// File is streamed through pipe, so it's not buffered in memory
+func (u *Uploader) post(ctx context.Context, uploadURL, field, name string, r io.Reader, dest interface{}) error {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+
+	go func() {
+		part, err := mw.CreateFormFile(field, name)
+		if err == nil {
+			_, err = io.Copy(part, r)
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	req, err := http.NewRequest("POST", uploadURL, pr)
+	if err != nil {
+		pr.Close()
+		return err
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req = req.WithContext(ctx)
+
+	resp, err := u.client.Do(req)
+	// unblock writer if request has failed before reading whole body
+	pr.Close()
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return &vk.HTTPError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+		}
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var errResp uploadErrorResponse
+	if err := json.Unmarshal(body, &errResp); err != nil {
+		return err
+	}
+	if err := errResp.err(); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, dest)
+}
+
+// attachment formats attachment string, i.e. "photo1_2_key"
+func attachment(kind string, ownerID, id int, accessKey string) string {
+	s := fmt.Sprintf("%s%d_%d", kind, ownerID, id)
+	if accessKey != "" {
+		s += "_" + accessKey
+	}
+	return s
+}
@@ -0,0 +1,145 @@
+package vkupload
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stek29/vk"
+	"github.com/stek29/vk/vkapi"
+)
+
+// newTestUploader creates Uploader which makes API requests to methods
+// and uploads files to upload
+func newTestUploader(t *testing.T, methods map[string]http.HandlerFunc, upload http.HandlerFunc) (*Uploader, string) {
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	for method, handler := range methods {
+		mux.HandleFunc("/method/"+method, handler)
+	}
+	mux.HandleFunc("/upload", upload)
+
+	api, err := vk.NewBaseAPI(vk.BaseAPIConfig{AccessToken: "test-token"})
+	if err != nil {
+		t.Fatalf("Cant create BaseAPI: %v", err)
+	}
+	api.BaseURL = srv.URL + "/method/"
+
+	return NewUploader(api, UploaderConfig{}), srv.URL + "/upload"
+}
+
+func respond(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}
+}
+
+func TestMessagePhoto(t *testing.T) {
+	var uploadURL string
+
+	u, uploadURL := newTestUploader(t, map[string]http.HandlerFunc{
+		"photos.getMessagesUploadServer": func(w http.ResponseWriter, r *http.Request) {
+			if r.FormValue("peer_id") != "42" {
+				t.Errorf("Unexpected peer_id: %v", r.FormValue("peer_id"))
+			}
+			w.Write([]byte(`{"response":{"upload_url":"` + uploadURL + `"}}`))
+		},
+		"photos.saveMessagesPhoto": func(w http.ResponseWriter, r *http.Request) {
+			if r.FormValue("server") != "7" || r.FormValue("photo") != `[{"photo":"abc"}]` || r.FormValue("hash") != "h" {
+				t.Errorf("Unexpected save params: %v", r.Form)
+			}
+			w.Write([]byte(`{"response":[{"id":2,"owner_id":1,"access_key":"key"}]}`))
+		},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		f, hdr, err := r.FormFile("photo")
+		if err != nil {
+			t.Errorf("Cant get uploaded file: %v", err)
+			return
+		}
+		data, _ := ioutil.ReadAll(f)
+		if hdr.Filename != "cat.jpg" || string(data) != "meow" {
+			t.Errorf("Unexpected file %q: %q", hdr.Filename, data)
+		}
+		w.Write([]byte(`{"server":7,"photo":"[{\"photo\":\"abc\"}]","hash":"h"}`))
+	})
+
+	photo, err := u.MessagePhoto(context.Background(), 42, "cat.jpg", strings.NewReader("meow"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if photo.Attachment != "photo1_2_key" {
+		t.Errorf("Unexpected attachment: %v", photo.Attachment)
+	}
+}
+
+func TestVoiceMessage(t *testing.T) {
+	var uploadURL string
+
+	u, uploadURL := newTestUploader(t, map[string]http.HandlerFunc{
+		"docs.getMessagesUploadServer": func(w http.ResponseWriter, r *http.Request) {
+			if r.FormValue("type") != "audio_message" {
+				t.Errorf("Unexpected type: %v", r.FormValue("type"))
+			}
+			w.Write([]byte(`{"response":{"upload_url":"` + uploadURL + `"}}`))
+		},
+		"docs.save": respond(`{"response":{"type":"audio_message","audio_message":{"id":3,"owner_id":1}}}`),
+	}, respond(`{"file":"uploaded"}`))
+
+	doc, err := u.VoiceMessage(context.Background(), 42, "voice.ogg", strings.NewReader("ogg"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if doc.Attachment != "doc1_3" {
+		t.Errorf("Unexpected attachment: %v", doc.Attachment)
+	}
+}
+
+func TestPhotoStory(t *testing.T) {
+	var uploadURL string
+
+	u, uploadURL := newTestUploader(t, map[string]http.HandlerFunc{
+		"stories.getPhotoUploadServer": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"response":{"upload_url":"` + uploadURL + `"}}`))
+		},
+		"stories.save": func(w http.ResponseWriter, r *http.Request) {
+			if r.FormValue("upload_results") != "result" {
+				t.Errorf("Unexpected upload_results: %v", r.FormValue("upload_results"))
+			}
+			w.Write([]byte(`{"response":{"count":1,"items":[{"id":4,"owner_id":-1}]}}`))
+		},
+	}, respond(`{"response":{"upload_result":"result"}}`))
+
+	story, err := u.PhotoStory(context.Background(), vkapi.StoriesGetPhotoUploadServerParams{AddToNews: true}, "story.jpg", strings.NewReader("jpg"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if story.Attachment != "story-1_4" {
+		t.Errorf("Unexpected attachment: %v", story.Attachment)
+	}
+}
+
+func TestUploadError(t *testing.T) {
+	var uploadURL string
+
+	u, uploadURL := newTestUploader(t, map[string]http.HandlerFunc{
+		"photos.getWallUploadServer": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"response":{"upload_url":"` + uploadURL + `"}}`))
+		},
+	}, respond(`{"error":"ERR_UPLOAD_BAD_IMAGE_SIZE: market photo min size 400x400"}`))
+
+	_, err := u.WallPhoto(context.Background(), vkapi.PhotosSaveWallPhotoParams{}, "small.jpg", strings.NewReader("jpg"))
+
+	var uploadErr *UploadError
+	if !errors.As(err, &uploadErr) || !strings.HasPrefix(uploadErr.Message, "ERR_UPLOAD_BAD_IMAGE_SIZE") {
+		t.Errorf("Expected UploadError, got %v", err)
+	}
+}
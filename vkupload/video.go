@@ -0,0 +1,382 @@
+package vkupload
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stek29/vk"
+	"github.com/stek29/vk/vkapi"
+)
+
+const (
+	defaultVideoChunkSize = 8 << 20
+	defaultMaxRetries     = 5
+	defaultPollInterval   = 5 * time.Second
+
+	defaultMaxProcessingWait = 10 * time.Minute
+
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 30 * time.Second
+)
+
+// ProgressFunc is called after every uploaded chunk
+// with number of bytes uploaded so far and total size
+type ProgressFunc func(uploaded, total int64)
+
+// Video is uploaded video
+type Video struct {
+	vk.Video
+	// Attachment is attachment string, i.e. "video1_2"
+	Attachment string
+}
+
+func newVideo(v vk.Video) *Video {
+	return &Video{
+		Video:      v,
+		Attachment: attachment("video", v.OwnerID, v.ID, v.AccessKey),
+	}
+}
+
+// Video uploads video of size bytes read from r
+//
+// Video is uploaded in chunks with Content-Range header, and chunks failed
+// because of network errors are retried, so upload is resumed from where
+// it has stopped. If upload server doesn't accept chunks, whole video is
+// streamed in one multipart request instead.
+//
+// progress might be nil. params.Link should be empty, see VideoLink.
+// Video is returned right after upload, before VK has processed it
+func (u *Uploader) Video(ctx context.Context, params vkapi.VideoSaveParams, name string, r io.ReaderAt, size int64, progress ProgressFunc) (*Video, error) {
+	if params.Link != "" {
+		return nil, errors.New("vkupload: use VideoLink to import videos by link")
+	}
+
+	if size <= 0 {
+		return nil, errors.New("vkupload: video is empty")
+	}
+
+	if progress == nil {
+		progress = func(uploaded, total int64) {}
+	}
+
+	saved, err := vkapi.Video{API: u.api}.SaveContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.chunkSize < 0 {
+		err = u.postVideo(ctx, saved.UploadURL, name, r, size, progress)
+	} else {
+		err = u.postVideoChunks(ctx, saved.UploadURL, name, r, size, progress)
+		if err == errChunksNotSupported {
+			err = u.postVideo(ctx, saved.UploadURL, name, r, size, progress)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newVideo(vk.Video{
+		ID:          saved.VideoID,
+		OwnerID:     saved.OwnerID,
+		Title:       saved.Title,
+		Description: saved.Description,
+	}), nil
+}
+
+// VideoLink imports video from params.Link, i.e. YouTube video,
+// and waits until VK has processed it
+//
+// Error is returned if video disappears (i.e. import has failed),
+// or isn't processed within MaxProcessingWait
+func (u *Uploader) VideoLink(ctx context.Context, params vkapi.VideoSaveParams) (*Video, error) {
+	if params.Link == "" {
+		return nil, errors.New("vkupload: Link is required")
+	}
+
+	videos := vkapi.Video{API: u.api}
+
+	saved, err := videos.SaveContext(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// import is started by request to upload URL
+	req, err := http.NewRequest("GET", saved.UploadURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := u.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &vk.HTTPError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+		}
+	}
+
+	var errResp uploadErrorResponse
+	if json.Unmarshal(body, &errResp) == nil {
+		if err := errResp.err(); err != nil {
+			return nil, err
+		}
+	}
+
+	id := fmt.Sprintf("%d_%d", saved.OwnerID, saved.VideoID)
+	deadline := time.Now().Add(u.maxProcessingWait)
+
+	for {
+		got, err := videos.GetContext(ctx, vkapi.VideoGetParams{
+			Videos: vkapi.CSVStringSlice{id},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		normal, ok := got.(*vkapi.VideoGetResponseNormal)
+		if !ok {
+			return nil, fmt.Errorf("vkupload: unexpected video.get response %T", got)
+		}
+
+		if len(normal.Items) == 0 {
+			return nil, fmt.Errorf("vkupload: video %v is not found, it was deleted or its import has failed", id)
+		}
+
+		if !normal.Items[0].Processing {
+			return newVideo(normal.Items[0]), nil
+		}
+
+		if time.Now().Add(u.pollInterval).After(deadline) {
+			return nil, fmt.Errorf("vkupload: video %v is still processing after %v", id, u.maxProcessingWait)
+		}
+
+		if err := sleep(ctx, u.pollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// postVideo uploads whole video with one multipart request
+func (u *Uploader) postVideo(ctx context.Context, uploadURL, name string, r io.ReaderAt, size int64, progress ProgressFunc) error {
+	body := &progressReader{
+		r:        io.NewSectionReader(r, 0, size),
+		total:    size,
+		progress: progress,
+	}
+
+	var resp json.RawMessage
+	return u.post(ctx, uploadURL, "video_file", name, body, &resp)
+}
+
+// errChunksNotSupported is returned by postVideoChunks if
+// upload server has rejected first chunk
+var errChunksNotSupported = errors.New("vkupload: chunked upload is not supported")
+
+// postVideoChunks uploads video in chunks, retrying failed ones
+func (u *Uploader) postVideoChunks(ctx context.Context, uploadURL, name string, r io.ReaderAt, size int64, progress ProgressFunc) error {
+	sessionID, err := newSessionID()
+	if err != nil {
+		return err
+	}
+
+	var offset int64
+	retries := 0
+
+	for {
+		end := offset + u.chunkSize
+		if end > size {
+			end = size
+		}
+
+		next, done, err := u.postChunk(ctx, uploadURL, sessionID, name, io.NewSectionReader(r, offset, end-offset), offset, end, size)
+
+		// chunk which didn't advance offset is retried like failed one,
+		// so server which never accepts it can't loop upload forever
+		if err == nil && !done && next <= offset {
+			err = fmt.Errorf("vkupload: upload server didn't accept chunk at offset %d", offset)
+		}
+
+		if err != nil {
+			var uploadErr *UploadError
+			if errors.As(err, &uploadErr) {
+				return err
+			}
+
+			var httpErr *vk.HTTPError
+			rejected := errors.As(err, &httpErr) && httpErr.StatusCode >= 400 && httpErr.StatusCode < 500 &&
+				httpErr.StatusCode != http.StatusRequestTimeout && httpErr.StatusCode != http.StatusTooManyRequests
+
+			if rejected && offset == 0 {
+				return errChunksNotSupported
+			}
+
+			if rejected || ctx.Err() != nil || retries >= u.maxRetries {
+				return err
+			}
+
+			if err := sleep(ctx, retryDelay(retries)); err != nil {
+				return err
+			}
+
+			retries++
+			continue
+		}
+
+		retries = 0
+
+		if done {
+			progress(size, size)
+			return nil
+		}
+
+		offset = next
+		progress(offset, size)
+	}
+}
+
+// postChunk sends bytes [start, end) of video from chunk
+//
+// It returns offset of first byte upload server is missing,
+// or done if it has received whole video
+func (u *Uploader) postChunk(ctx context.Context, uploadURL, sessionID, name string, chunk io.Reader, start, end, size int64) (next int64, done bool, err error) {
+	req, err := http.NewRequest("POST", uploadURL, chunk)
+	if err != nil {
+		return 0, false, err
+	}
+
+	req.ContentLength = end - start
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end-1, size))
+	req.Header.Set("Session-ID", sessionID)
+
+	resp, err := u.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, false, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, false, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusCreated:
+		// body is list of received ranges, i.e. "0-1023,4096-8191/10000"
+		next, err := parseReceivedRanges(string(body))
+		return next, false, err
+	case http.StatusOK:
+		var errResp uploadErrorResponse
+		if json.Unmarshal(body, &errResp) == nil {
+			if err := errResp.err(); err != nil {
+				return 0, false, err
+			}
+		}
+		return size, true, nil
+	default:
+		return 0, false, &vk.HTTPError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+		}
+	}
+}
+
+// parseReceivedRanges returns offset of first byte missing in ranges
+func parseReceivedRanges(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexByte(s, '/'); i != -1 {
+		s = s[:i]
+	}
+
+	var next int64
+	for _, rng := range strings.Split(s, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(rng), "-", 2)
+		if len(bounds) != 2 {
+			return 0, fmt.Errorf("vkupload: invalid range %q", rng)
+		}
+
+		start, err := strconv.ParseInt(bounds[0], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		end, err := strconv.ParseInt(bounds[1], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+
+		if start > next {
+			break
+		}
+		if end+1 > next {
+			next = end + 1
+		}
+	}
+
+	return next, nil
+}
+
+func newSessionID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func retryDelay(retry int) time.Duration {
+	delay := retryBaseDelay << uint(retry)
+	if delay <= 0 || delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	return delay
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// progressReader reports progress of reading from r
+type progressReader struct {
+	r        io.Reader
+	read     int64
+	total    int64
+	progress ProgressFunc
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.read += int64(n)
+		p.progress(p.read, p.total)
+	}
+	return n, err
+}
@@ -0,0 +1,230 @@
+package vkupload
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stek29/vk"
+	"github.com/stek29/vk/vkapi"
+)
+
+// chunkServer is upload server which accepts chunks with Content-Range
+type chunkServer struct {
+	t *testing.T
+
+	mu       sync.Mutex
+	data     []byte
+	received int64
+	// failAt is chunk offset at which connection is dropped once
+	failAt int64
+}
+
+func (s *chunkServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var start, end, size int64
+	if _, err := fmt.Sscanf(r.Header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &size); err != nil {
+		s.t.Errorf("Invalid Content-Range: %q", r.Header.Get("Content-Range"))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if start == s.failAt {
+		s.failAt = -1
+		conn, _, _ := w.(http.Hijacker).Hijack()
+		conn.Close()
+		return
+	}
+
+	chunk, _ := ioutil.ReadAll(r.Body)
+	if s.data == nil {
+		s.data = make([]byte, size)
+	}
+	copy(s.data[start:], chunk)
+	if start <= s.received && end+1 > s.received {
+		s.received = end + 1
+	}
+
+	if s.received == size {
+		w.Write([]byte(`{"video_id":1,"size":` + fmt.Sprint(size) + `}`))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, "0-%d/%d", s.received-1, size)
+}
+
+func videoSaveHandler(uploadURL *string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"upload_url":"` + *uploadURL + `","video_id":2,"owner_id":1,"title":"cat"}}`))
+	}
+}
+
+func TestVideoChunked(t *testing.T) {
+	video := bytes.Repeat([]byte("0123456789"), 100)
+	srv := &chunkServer{t: t, failAt: 300}
+
+	var uploadURL string
+	u, uploadURL := newTestUploader(t, map[string]http.HandlerFunc{
+		"video.save": videoSaveHandler(&uploadURL),
+	}, srv.ServeHTTP)
+	u.chunkSize = 300
+
+	var progress []int64
+	got, err := u.Video(context.Background(), vkapi.VideoSaveParams{Name: "cat"}, "cat.mp4", bytes.NewReader(video), int64(len(video)), func(uploaded, total int64) {
+		progress = append(progress, uploaded)
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got.Attachment != "video1_2" {
+		t.Errorf("Unexpected attachment: %v", got.Attachment)
+	}
+
+	if !bytes.Equal(srv.data, video) {
+		t.Errorf("Uploaded video differs from original")
+	}
+
+	if fmt.Sprint(progress) != "[300 600 900 1000]" {
+		t.Errorf("Unexpected progress: %v", progress)
+	}
+}
+
+func TestVideoChunksNotSupported(t *testing.T) {
+	var uploadURL string
+	u, uploadURL := newTestUploader(t, map[string]http.HandlerFunc{
+		"video.save": videoSaveHandler(&uploadURL),
+	}, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Range") != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		f, _, err := r.FormFile("video_file")
+		if err != nil {
+			t.Errorf("Cant get uploaded file: %v", err)
+			return
+		}
+		data, _ := ioutil.ReadAll(f)
+		if string(data) != "video" {
+			t.Errorf("Unexpected video: %q", data)
+		}
+		w.Write([]byte(`{"video_id":2}`))
+	})
+
+	_, err := u.Video(context.Background(), vkapi.VideoSaveParams{}, "cat.mp4", strings.NewReader("video"), 5, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func TestVideoLink(t *testing.T) {
+	var (
+		uploadURL string
+		imported  bool
+		checks    int
+	)
+
+	u, uploadURL := newTestUploader(t, map[string]http.HandlerFunc{
+		"video.save": func(w http.ResponseWriter, r *http.Request) {
+			if r.FormValue("link") != "https://youtu.be/cat" {
+				t.Errorf("Unexpected link: %v", r.FormValue("link"))
+			}
+			videoSaveHandler(&uploadURL)(w, r)
+		},
+		"video.get": func(w http.ResponseWriter, r *http.Request) {
+			if r.FormValue("videos") != "1_2" {
+				t.Errorf("Unexpected videos: %v", r.FormValue("videos"))
+			}
+			checks++
+			processing := 1
+			if checks > 1 {
+				processing = 0
+			}
+			fmt.Fprintf(w, `{"response":{"count":1,"items":[{"id":2,"owner_id":1,"processing":%d}]}}`, processing)
+		},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		imported = true
+		w.Write([]byte(`{"response":1}`))
+	})
+	u.pollInterval = time.Millisecond
+
+	got, err := u.VideoLink(context.Background(), vkapi.VideoSaveParams{Link: "https://youtu.be/cat"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !imported || checks != 2 {
+		t.Errorf("Expected import to be started and checked twice, got %v, %v", imported, checks)
+	}
+
+	if got.Processing != vk.BoolInt(false) || got.Attachment != "video1_2" {
+		t.Errorf("Unexpected video: %+v", got)
+	}
+}
+
+func TestVideoChunksNotAdvancing(t *testing.T) {
+	var (
+		uploadURL string
+		requests  int
+	)
+
+	u, uploadURL := newTestUploader(t, map[string]http.HandlerFunc{
+		"video.save": videoSaveHandler(&uploadURL),
+	}, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// server keeps saying only first chunk is received
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte("0-299/1000"))
+	})
+	u.chunkSize = 300
+	u.maxRetries = 1
+
+	_, err := u.Video(context.Background(), vkapi.VideoSaveParams{}, "cat.mp4", bytes.NewReader(make([]byte, 1000)), 1000, nil)
+	if err == nil || !strings.Contains(err.Error(), "didn't accept chunk at offset 300") {
+		t.Errorf("Expected error for chunk which isn't accepted, got %v", err)
+	}
+
+	// first chunk, and second one with one retry
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %v", requests)
+	}
+}
+
+func TestVideoLinkFailed(t *testing.T) {
+	tests := []struct {
+		items string
+		err   string
+	}{
+		{`[]`, "is not found"},
+		{`[{"id":2,"owner_id":1,"processing":1}]`, "is still processing"},
+	}
+
+	for _, tt := range tests {
+		var uploadURL string
+		u, uploadURL := newTestUploader(t, map[string]http.HandlerFunc{
+			"video.save": videoSaveHandler(&uploadURL),
+			"video.get": func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"response":{"count":1,"items":%s}}`, tt.items)
+			},
+		}, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"response":1}`))
+		})
+		u.pollInterval = time.Millisecond
+		u.maxProcessingWait = 10 * time.Millisecond
+
+		_, err := u.VideoLink(context.Background(), vkapi.VideoSaveParams{Link: "https://youtu.be/cat"})
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Expected error %q, got %v", tt.err, err)
+		}
+	}
+}